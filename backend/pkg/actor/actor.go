@@ -0,0 +1,58 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package actor carries the identity of whoever issued a request through the
+// request context, so that changes can be attributed to them.
+package actor
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Unknown is returned if no actor has been set in the context.
+const Unknown = "unknown"
+
+type ctxKey struct{}
+
+// WithName returns a copy of ctx that carries the given actor name. Setting the
+// name again overrides the previously set name for the returned context only.
+func WithName(ctx context.Context, name string) context.Context {
+	holder := &atomic.Pointer[string]{}
+	holder.Store(&name)
+	return context.WithValue(ctx, ctxKey{}, holder)
+}
+
+// SetName replaces the actor name that has been set via WithName in place, so
+// that the name is also visible to middlewares that hold a parent context and
+// read the actor once the request has been handled, for instance to record an
+// audit event. Authentication is usually performed by nested middlewares or
+// interceptors, so that the authenticated identity must be propagated upwards.
+// If ctx does not carry an actor yet, SetName behaves like WithName.
+func SetName(ctx context.Context, name string) context.Context {
+	holder, ok := ctx.Value(ctxKey{}).(*atomic.Pointer[string])
+	if !ok {
+		return WithName(ctx, name)
+	}
+	holder.Store(&name)
+	return ctx
+}
+
+// FromContext returns the actor name stored in the context or Unknown.
+func FromContext(ctx context.Context) string {
+	holder, ok := ctx.Value(ctxKey{}).(*atomic.Pointer[string])
+	if !ok {
+		return Unknown
+	}
+	name := holder.Load()
+	if name == nil || *name == "" {
+		return Unknown
+	}
+	return *name
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package actor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor(t *testing.T) {
+	assert.Equal(t, Unknown, FromContext(context.Background()))
+
+	parent := WithName(context.Background(), "anonymous@127.0.0.1")
+	child := context.WithValue(parent, struct{}{}, "nested")
+	assert.Equal(t, "anonymous@127.0.0.1", FromContext(child))
+
+	// SetName propagates the name to the parent context
+	SetName(child, "User:alice")
+	assert.Equal(t, "User:alice", FromContext(parent))
+
+	// WithName only overrides the name for the returned context
+	overridden := WithName(parent, "User:bob")
+	assert.Equal(t, "User:bob", FromContext(overridden))
+	assert.Equal(t, "User:alice", FromContext(parent))
+
+	// Without an actor, SetName behaves like WithName
+	assert.Equal(t, "User:carol", FromContext(SetName(context.Background(), "User:carol")))
+}
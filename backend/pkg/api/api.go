@@ -28,6 +28,7 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c" //nolint:staticcheck // TODO migrate to http.Server.Protocols (Go 1.24+); follow-up after Snyk dep bump.
 
+	"github.com/redpanda-data/console/backend/pkg/audit"
 	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/connect"
 	"github.com/redpanda-data/console/backend/pkg/console"
@@ -50,6 +51,9 @@ type API struct {
 	ConnectSvc *connect.Service
 	GitSvc     *git.Service
 
+	// Auditor records all mutating API calls. It is nil if the audit log is disabled.
+	Auditor *audit.Recorder
+
 	RedpandaClientProvider redpandafactory.ClientFactory
 	KafkaClientProvider    kafkafactory.ClientFactory
 	SchemaClientProvider   schemafactory.ClientFactory
@@ -130,6 +134,47 @@ func New(cfg *config.Config, inputOpts ...Option) (*API, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Kafka connect service: %w", err)
 	}
+	if historyCfg := cfg.KafkaConnect.ConfigHistory; historyCfg.Enabled {
+		switch historyCfg.Storage {
+		case config.KafkaConnectConfigHistoryStorageFilesystem:
+			store, err := connect.NewFileConfigHistoryStore(historyCfg.Directory, historyCfg.MaxVersions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create connector config history: %w", err)
+			}
+			connectSvc.ConfigHistory = store
+		default:
+			connectSvc.ConfigHistory = connect.NewKafkaConfigHistoryStore(historyCfg.Topic, historyCfg.MaxVersions, opts.kafkaClientProvider.GetKafkaClient)
+		}
+	}
+	if secretsCfg := cfg.KafkaConnect.Secrets; secretsCfg.Enabled {
+		key, err := secretsCfg.DecodedEncryptionKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connector secret store: %w", err)
+		}
+		fileStore, err := connect.NewFileSecretStore(secretsCfg.Filepath, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connector secret store: %w", err)
+		}
+		store, err := connect.NewWorkerExportSecretStore(context.Background(), fileStore, secretsCfg.WorkerFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connector secret store: %w", err)
+		}
+		connectSvc.Secrets = store
+	}
+	if cfg.KafkaConnect.AutoRemediation.Enabled {
+		supervisor, err := connect.NewRemediationSupervisor(connectSvc, cfg.KafkaConnect.AutoRemediation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Kafka connect auto remediation: %w", err)
+		}
+		connectSvc.Remediation = supervisor
+	}
+	if cfg.KafkaConnect.Enabled {
+		registry, err := connect.NewClusterRegistry(connectSvc, cfg.KafkaConnect.Registry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Kafka connect cluster registry: %w", err)
+		}
+		connectSvc.Registry = registry
+	}
 
 	consoleSvc, err := console.NewService(
 		cfg,
@@ -139,17 +184,27 @@ func New(cfg *config.Config, inputOpts ...Option) (*API, error) {
 		opts.redpandaClientProvider,
 		opts.cacheNamespaceFn,
 		connectSvc,
+		opts.prometheusRegistry,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create console service: %w", err)
 	}
 
+	var auditor *audit.Recorder
+	if cfg.Console.Audit.Enabled {
+		auditor, err = audit.NewRecorder(cfg.Console.Audit, loggerpkg.Named(logger, "audit"), opts.kafkaClientProvider.GetKafkaClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create audit log: %w", err)
+		}
+	}
+
 	year := 24 * time.Hour * 365
 	return &API{
 		Cfg:                    cfg,
 		Logger:                 logger,
 		ConsoleSvc:             consoleSvc,
 		ConnectSvc:             connectSvc,
+		Auditor:                auditor,
 		KafkaClientProvider:    opts.kafkaClientProvider,
 		SchemaClientProvider:   opts.schemaClientProvider,
 		RedpandaClientProvider: opts.redpandaClientProvider,
@@ -198,6 +253,12 @@ func (api *API) Start(ctx context.Context) error {
 	if err := api.ConsoleSvc.Start(ctx); err != nil {
 		return fmt.Errorf("start console service: %w", err)
 	}
+	if api.ConnectSvc.Remediation != nil {
+		api.ConnectSvc.Remediation.Start(ctx)
+	}
+	if api.ConnectSvc.Registry != nil {
+		api.ConnectSvc.Registry.Start(ctx)
+	}
 
 	mux := api.routes()
 	srv, err := rest.NewServer(&api.Cfg.REST.Config, api.Logger, mux)
@@ -227,5 +288,16 @@ func (api *API) Stop(ctx context.Context) error {
 		return fmt.Errorf("shutdown HTTP server: %w", err)
 	}
 	api.ConsoleSvc.Stop()
+	if api.ConnectSvc.Remediation != nil {
+		api.ConnectSvc.Remediation.Stop()
+	}
+	if api.ConnectSvc.Registry != nil {
+		api.ConnectSvc.Registry.Stop()
+	}
+	if api.Auditor != nil {
+		if err := api.Auditor.Close(); err != nil {
+			return fmt.Errorf("close audit log: %w", err)
+		}
+	}
 	return nil
 }
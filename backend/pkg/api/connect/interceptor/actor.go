@@ -0,0 +1,62 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/redpanda-data/console/backend/pkg/actor"
+)
+
+var _ connect.Interceptor = &ActorInterceptor{}
+
+// ActorInterceptor attributes each call to the authenticated principal, so that
+// changes are not recorded as anonymous. It must run after all interceptors
+// that authenticate the request. If the request is not authenticated, the
+// default actor that is set by the HTTP middleware is kept.
+type ActorInterceptor struct {
+	principal func(ctx context.Context) string
+}
+
+// NewActorInterceptor creates a new ActorInterceptor that resolves the
+// authenticated principal via the given function.
+func NewActorInterceptor(principal func(ctx context.Context) string) *ActorInterceptor {
+	return &ActorInterceptor{principal: principal}
+}
+
+// WrapUnary creates an interceptor to set the actor of unary Connect requests.
+func (in *ActorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(in.setActor(ctx), req)
+	}
+}
+
+// WrapStreamingClient is the middleware handler for bidirectional requests from
+// the client perspective.
+func (*ActorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler is the middleware handler for bidirectional requests from
+// the server handling perspective.
+func (in *ActorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(in.setActor(ctx), conn)
+	}
+}
+
+func (in *ActorInterceptor) setActor(ctx context.Context) context.Context {
+	if name := in.principal(ctx); name != "" {
+		return actor.SetName(ctx, name)
+	}
+	return ctx
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/redpanda-data/console/backend/pkg/actor"
+	"github.com/redpanda-data/console/backend/pkg/audit"
+)
+
+var _ connect.Interceptor = &AuditInterceptor{}
+
+// AuditInterceptor records an audit event for every mutating unary call,
+// including calls that are rejected by subsequent interceptors. Streaming
+// procedures only read data and are not audited.
+type AuditInterceptor struct {
+	recorder *audit.Recorder
+}
+
+// NewAuditInterceptor creates a new AuditInterceptor.
+func NewAuditInterceptor(recorder *audit.Recorder) *AuditInterceptor {
+	return &AuditInterceptor{recorder: recorder}
+}
+
+// WrapUnary creates an interceptor to audit mutating Connect requests.
+func (in *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		// For HTTP paths invoked via gRPC gateway procedure is expected not to be set.
+		procedure := req.Spec().Procedure
+		if procedure == "" {
+			path, ok := runtime.RPCMethod(ctx)
+			if !ok {
+				procedure = "unknown"
+			} else {
+				procedure = path
+			}
+		}
+		if !audit.IsMutatingProcedure(procedure) {
+			return next(ctx, req)
+		}
+
+		protocol := req.Peer().Protocol
+		if protocol == "" {
+			protocol = "http"
+		}
+
+		start := time.Now()
+		response, err := next(ctx, req)
+
+		event := audit.Event{
+			Time:          start,
+			Actor:         actor.FromContext(ctx),
+			SourceAddress: req.Peer().Addr,
+			Protocol:      protocol,
+			Procedure:     procedure,
+			Outcome:       audit.OutcomeSuccess,
+			Status:        "ok",
+			LatencyMs:     time.Since(start).Milliseconds(),
+		}
+		if msg, ok := req.Any().(proto.Message); ok {
+			event.Resources = in.recorder.ProtoResources(msg)
+			event.RequestDigest = in.recorder.ProtoDigest(msg)
+		}
+		if err != nil {
+			event.Outcome = audit.OutcomeFailure
+			event.Status = connect.CodeOf(err).String()
+			event.Error = err.Error()
+		}
+		in.recorder.Record(ctx, event)
+
+		return response, err
+	}
+}
+
+// WrapStreamingClient is the middleware handler for bidirectional requests from
+// the client perspective.
+func (*AuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler is the middleware handler for bidirectional requests from
+// the server handling perspective.
+func (*AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package acl
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
+)
+
+// Prefixes of the proto enum value names, which are stripped in ACL documents
+// so that documents use the well known Kafka names (e.g. TOPIC or PREFIXED).
+const (
+	enumPrefixResourceType   = "RESOURCE_TYPE_"
+	enumPrefixPatternType    = "RESOURCE_PATTERN_TYPE_"
+	enumPrefixOperation      = "OPERATION_"
+	enumPrefixPermissionType = "PERMISSION_TYPE_"
+)
+
+// aclDocument is the declarative, human-editable representation of all Kafka
+// and Schema Registry ACLs in a cluster, grouped by principal. It is used for
+// exporting and importing ACLs as YAML.
+type aclDocument struct {
+	Principals []aclDocumentPrincipal `yaml:"principals" json:"principals"`
+}
+
+// aclDocumentPrincipal holds all ACLs of a single principal.
+type aclDocumentPrincipal struct {
+	Principal string             `yaml:"principal" json:"principal"`
+	ACLs      []aclDocumentEntry `yaml:"acls" json:"acls"`
+}
+
+// aclDocumentEntry is a single ACL of a principal. Enum values use their Kafka
+// names, e.g. resourceType TOPIC, patternType LITERAL, operation READ and
+// permission ALLOW.
+type aclDocumentEntry struct {
+	ResourceType string `yaml:"resourceType" json:"resourceType"`
+	ResourceName string `yaml:"resourceName,omitempty" json:"resourceName,omitempty"`
+	PatternType  string `yaml:"patternType" json:"patternType"`
+	Host         string `yaml:"host" json:"host"`
+	Operation    string `yaml:"operation" json:"operation"`
+	Permission   string `yaml:"permission" json:"permission"`
+}
+
+// aclBinding is a single flattened ACL, which can be compared for equality.
+type aclBinding struct {
+	ResourceType   v1.ACL_ResourceType
+	ResourceName   string
+	PatternType    v1.ACL_ResourcePatternType
+	Principal      string
+	Host           string
+	Operation      v1.ACL_Operation
+	PermissionType v1.ACL_PermissionType
+}
+
+// aclBindingChange is an ACL that is part of an import plan.
+type aclBindingChange struct {
+	Principal string `json:"principal"`
+	aclDocumentEntry
+}
+
+func (b aclBinding) toDocumentEntry() aclDocumentEntry {
+	return aclDocumentEntry{
+		ResourceType: strings.TrimPrefix(b.ResourceType.String(), enumPrefixResourceType),
+		ResourceName: b.ResourceName,
+		PatternType:  strings.TrimPrefix(b.PatternType.String(), enumPrefixPatternType),
+		Host:         b.Host,
+		Operation:    strings.TrimPrefix(b.Operation.String(), enumPrefixOperation),
+		Permission:   strings.TrimPrefix(b.PermissionType.String(), enumPrefixPermissionType),
+	}
+}
+
+func (b aclBinding) toChange() aclBindingChange {
+	return aclBindingChange{Principal: b.Principal, aclDocumentEntry: b.toDocumentEntry()}
+}
+
+func (b aclBinding) toCreateACLRequest() *v1.CreateACLRequest {
+	return &v1.CreateACLRequest{
+		ResourceType:        b.ResourceType,
+		ResourceName:        b.ResourceName,
+		ResourcePatternType: b.PatternType,
+		Principal:           b.Principal,
+		Host:                b.Host,
+		Operation:           b.Operation,
+		PermissionType:      b.PermissionType,
+	}
+}
+
+// toDeleteACLsRequest returns a delete request whose filter exactly matches this ACL.
+func (b aclBinding) toDeleteACLsRequest() *v1.DeleteACLsRequest {
+	return &v1.DeleteACLsRequest{
+		Filter: &v1.DeleteACLsRequest_Filter{
+			ResourceType:        b.ResourceType,
+			ResourceName:        &b.ResourceName,
+			ResourcePatternType: b.PatternType,
+			Principal:           &b.Principal,
+			Host:                &b.Host,
+			Operation:           b.Operation,
+			PermissionType:      b.PermissionType,
+		},
+	}
+}
+
+// aclResourcesToBindings flattens the listed ACL resources into single ACLs.
+func aclResourcesToBindings(resources []*v1.ListACLsResponse_Resource) []aclBinding {
+	bindings := make([]aclBinding, 0, len(resources))
+	for _, res := range resources {
+		for _, policy := range res.GetAcls() {
+			bindings = append(bindings, aclBinding{
+				ResourceType:   res.GetResourceType(),
+				ResourceName:   res.GetResourceName(),
+				PatternType:    res.GetResourcePatternType(),
+				Principal:      policy.GetPrincipal(),
+				Host:           policy.GetHost(),
+				Operation:      policy.GetOperation(),
+				PermissionType: policy.GetPermissionType(),
+			})
+		}
+	}
+	return bindings
+}
+
+// aclBindingsToDocument groups the ACLs by principal. Principals and their ACLs
+// are sorted so that exported documents are stable and diff well in git.
+func aclBindingsToDocument(bindings []aclBinding) *aclDocument {
+	byPrincipal := make(map[string][]aclBinding)
+	for _, b := range bindings {
+		byPrincipal[b.Principal] = append(byPrincipal[b.Principal], b)
+	}
+
+	doc := &aclDocument{Principals: make([]aclDocumentPrincipal, 0, len(byPrincipal))}
+	for principal, principalBindings := range byPrincipal {
+		slices.SortFunc(principalBindings, compareACLBindings)
+		entries := make([]aclDocumentEntry, len(principalBindings))
+		for i, b := range principalBindings {
+			entries[i] = b.toDocumentEntry()
+		}
+		doc.Principals = append(doc.Principals, aclDocumentPrincipal{Principal: principal, ACLs: entries})
+	}
+	slices.SortFunc(doc.Principals, func(a, b aclDocumentPrincipal) int {
+		return strings.Compare(a.Principal, b.Principal)
+	})
+
+	return doc
+}
+
+func compareACLBindings(a, b aclBinding) int {
+	return cmp.Or(
+		cmp.Compare(a.Principal, b.Principal),
+		cmp.Compare(a.ResourceType, b.ResourceType),
+		cmp.Compare(a.ResourceName, b.ResourceName),
+		cmp.Compare(a.PatternType, b.PatternType),
+		cmp.Compare(a.Host, b.Host),
+		cmp.Compare(a.Operation, b.Operation),
+		cmp.Compare(a.PermissionType, b.PermissionType),
+	)
+}
+
+// toBindings validates the document and returns all ACLs it declares. Host defaults
+// to "*", the pattern type to LITERAL and the cluster resource name to "kafka-cluster".
+// Filter-only enum values such as ANY or MATCH are rejected.
+func (doc *aclDocument) toBindings() ([]aclBinding, error) {
+	var errs []error
+	bindings := make([]aclBinding, 0)
+	seen := make(map[aclBinding]struct{})
+	for i, p := range doc.Principals {
+		if !strings.Contains(p.Principal, ":") {
+			errs = append(errs, fmt.Errorf("principals[%d]: principal %q must be prefixed with its type, e.g. User:%v", i, p.Principal, p.Principal))
+			continue
+		}
+		for j, entry := range p.ACLs {
+			b, err := entry.toBinding(p.Principal)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("principals[%d].acls[%d]: %w", i, j, err))
+				continue
+			}
+			if _, exists := seen[b]; !exists {
+				seen[b] = struct{}{}
+				bindings = append(bindings, b)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return bindings, nil
+}
+
+func (e aclDocumentEntry) toBinding(principal string) (aclBinding, error) {
+	patternType := e.PatternType
+	if patternType == "" {
+		patternType = "LITERAL"
+	}
+	host := e.Host
+	if host == "" {
+		host = "*"
+	}
+
+	resourceType, err := parseEnumName[v1.ACL_ResourceType](v1.ACL_ResourceType_value, enumPrefixResourceType, "resourceType", e.ResourceType)
+	if err != nil {
+		return aclBinding{}, err
+	}
+	pattern, err := parseEnumName[v1.ACL_ResourcePatternType](v1.ACL_ResourcePatternType_value, enumPrefixPatternType, "patternType", patternType)
+	if err != nil {
+		return aclBinding{}, err
+	}
+	operation, err := parseEnumName[v1.ACL_Operation](v1.ACL_Operation_value, enumPrefixOperation, "operation", e.Operation)
+	if err != nil {
+		return aclBinding{}, err
+	}
+	permission, err := parseEnumName[v1.ACL_PermissionType](v1.ACL_PermissionType_value, enumPrefixPermissionType, "permission", e.Permission)
+	if err != nil {
+		return aclBinding{}, err
+	}
+
+	switch {
+	case resourceType == v1.ACL_RESOURCE_TYPE_ANY:
+		return aclBinding{}, errors.New("resourceType ANY is only valid in filters")
+	case pattern == v1.ACL_RESOURCE_PATTERN_TYPE_ANY || pattern == v1.ACL_RESOURCE_PATTERN_TYPE_MATCH:
+		return aclBinding{}, fmt.Errorf("patternType %v is only valid in filters", patternType)
+	case operation == v1.ACL_OPERATION_ANY:
+		return aclBinding{}, errors.New("operation ANY is only valid in filters")
+	case permission == v1.ACL_PERMISSION_TYPE_ANY:
+		return aclBinding{}, errors.New("permission ANY is only valid in filters")
+	}
+
+	resourceName := e.ResourceName
+	if resourceType == v1.ACL_RESOURCE_TYPE_CLUSTER && resourceName == "" {
+		resourceName = "kafka-cluster"
+	}
+	if resourceName == "" && resourceType != v1.ACL_RESOURCE_TYPE_REGISTRY {
+		return aclBinding{}, fmt.Errorf("resourceName must be set for resourceType %v", e.ResourceType)
+	}
+
+	return aclBinding{
+		ResourceType:   resourceType,
+		ResourceName:   resourceName,
+		PatternType:    pattern,
+		Principal:      principal,
+		Host:           host,
+		Operation:      operation,
+		PermissionType: permission,
+	}, nil
+}
+
+// parseEnumName parses the Kafka name of an ACL enum (e.g. "TOPIC") into the
+// respective proto enum. UNSPECIFIED values are rejected.
+func parseEnumName[T ~int32](values map[string]int32, prefix, field, name string) (T, error) {
+	v, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		valid := make([]string, 0, len(values))
+		for k, v := range values {
+			if v != 0 {
+				valid = append(valid, strings.TrimPrefix(k, prefix))
+			}
+		}
+		slices.Sort(valid)
+		return 0, fmt.Errorf("%v %q is invalid, must be one of %v", field, name, strings.Join(valid, ", "))
+	}
+	return T(v), nil
+}
+
+// diffACLBindings compares the ACLs in the cluster with the desired state. ACLs
+// that only exist in the cluster are returned for deletion only if prune is set.
+func diffACLBindings(current, desired []aclBinding, prune bool) (toCreate, toDelete []aclBinding, unchanged int) {
+	currentSet := make(map[aclBinding]struct{}, len(current))
+	for _, b := range current {
+		currentSet[b] = struct{}{}
+	}
+	desiredSet := make(map[aclBinding]struct{}, len(desired))
+	for _, b := range desired {
+		desiredSet[b] = struct{}{}
+	}
+
+	toCreate = make([]aclBinding, 0)
+	toDelete = make([]aclBinding, 0)
+	for _, b := range desired {
+		if _, exists := currentSet[b]; exists {
+			unchanged++
+			continue
+		}
+		toCreate = append(toCreate, b)
+	}
+	if prune {
+		for _, b := range current {
+			if _, exists := desiredSet[b]; !exists {
+				toDelete = append(toDelete, b)
+			}
+		}
+	}
+	slices.SortFunc(toCreate, compareACLBindings)
+	slices.SortFunc(toDelete, compareACLBindings)
+	return toCreate, toDelete, unchanged
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
+)
+
+func TestACLDocumentRoundTrip(t *testing.T) {
+	resources := []*v1.ListACLsResponse_Resource{
+		{
+			ResourceType:        v1.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName:        "orders",
+			ResourcePatternType: v1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Acls: []*v1.ListACLsResponse_Policy{
+				{Principal: "User:bob", Host: "*", Operation: v1.ACL_OPERATION_WRITE, PermissionType: v1.ACL_PERMISSION_TYPE_ALLOW},
+				{Principal: "User:alice", Host: "*", Operation: v1.ACL_OPERATION_READ, PermissionType: v1.ACL_PERMISSION_TYPE_ALLOW},
+			},
+		},
+		{
+			ResourceType:        v1.ACL_RESOURCE_TYPE_REGISTRY,
+			ResourcePatternType: v1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Acls: []*v1.ListACLsResponse_Policy{
+				{Principal: "User:alice", Host: "*", Operation: v1.ACL_OPERATION_DESCRIBE, PermissionType: v1.ACL_PERMISSION_TYPE_ALLOW},
+			},
+		},
+	}
+	bindings := aclResourcesToBindings(resources)
+	require.Len(t, bindings, 3)
+
+	doc := aclBindingsToDocument(bindings)
+	require.Len(t, doc.Principals, 2)
+	assert.Equal(t, "User:alice", doc.Principals[0].Principal)
+	assert.Equal(t, aclDocumentEntry{
+		ResourceType: "TOPIC",
+		ResourceName: "orders",
+		PatternType:  "LITERAL",
+		Host:         "*",
+		Operation:    "READ",
+		Permission:   "ALLOW",
+	}, doc.Principals[0].ACLs[0])
+	assert.Equal(t, "REGISTRY", doc.Principals[0].ACLs[1].ResourceType)
+
+	out, err := yaml.Marshal(doc)
+	require.NoError(t, err)
+	var parsed aclDocument
+	require.NoError(t, yaml.Unmarshal(out, &parsed))
+	parsedBindings, err := parsed.toBindings()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, bindings, parsedBindings)
+}
+
+func TestACLDocumentToBindings(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		doc := aclDocument{Principals: []aclDocumentPrincipal{{
+			Principal: "User:alice",
+			ACLs: []aclDocumentEntry{
+				{ResourceType: "cluster", Operation: "describe", Permission: "allow"},
+			},
+		}}}
+		bindings, err := doc.toBindings()
+		require.NoError(t, err)
+		assert.Equal(t, []aclBinding{{
+			ResourceType:   v1.ACL_RESOURCE_TYPE_CLUSTER,
+			ResourceName:   "kafka-cluster",
+			PatternType:    v1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Principal:      "User:alice",
+			Host:           "*",
+			Operation:      v1.ACL_OPERATION_DESCRIBE,
+			PermissionType: v1.ACL_PERMISSION_TYPE_ALLOW,
+		}}, bindings)
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		doc := aclDocument{Principals: []aclDocumentPrincipal{
+			{Principal: "alice"},
+			{
+				Principal: "User:bob",
+				ACLs: []aclDocumentEntry{
+					{ResourceType: "TOPIC", ResourceName: "orders", Operation: "ANY", Permission: "ALLOW"},
+					{ResourceType: "TOPIC", ResourceName: "orders", PatternType: "MATCH", Operation: "READ", Permission: "ALLOW"},
+					{ResourceType: "TOPIC", Operation: "READ", Permission: "ALLOW"},
+					{ResourceType: "QUEUE", ResourceName: "orders", Operation: "READ", Permission: "ALLOW"},
+				},
+			},
+		}}
+		_, err := doc.toBindings()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "principals[0]: principal \"alice\" must be prefixed")
+		assert.Contains(t, err.Error(), "principals[1].acls[0]: operation ANY")
+		assert.Contains(t, err.Error(), "principals[1].acls[1]: patternType MATCH")
+		assert.Contains(t, err.Error(), "principals[1].acls[2]: resourceName must be set")
+		assert.Contains(t, err.Error(), "principals[1].acls[3]: resourceType \"QUEUE\" is invalid")
+	})
+}
+
+func TestDiffACLBindings(t *testing.T) {
+	binding := func(principal string, op v1.ACL_Operation) aclBinding {
+		return aclBinding{
+			ResourceType:   v1.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName:   "orders",
+			PatternType:    v1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Principal:      principal,
+			Host:           "*",
+			Operation:      op,
+			PermissionType: v1.ACL_PERMISSION_TYPE_ALLOW,
+		}
+	}
+	current := []aclBinding{binding("User:alice", v1.ACL_OPERATION_READ), binding("User:bob", v1.ACL_OPERATION_READ)}
+	desired := []aclBinding{binding("User:alice", v1.ACL_OPERATION_READ), binding("User:alice", v1.ACL_OPERATION_WRITE)}
+
+	toCreate, toDelete, unchanged := diffACLBindings(current, desired, false)
+	assert.Equal(t, []aclBinding{binding("User:alice", v1.ACL_OPERATION_WRITE)}, toCreate)
+	assert.Empty(t, toDelete)
+	assert.Equal(t, 1, unchanged)
+
+	toCreate, toDelete, unchanged = diffACLBindings(current, desired, true)
+	assert.Equal(t, []aclBinding{binding("User:alice", v1.ACL_OPERATION_WRITE)}, toCreate)
+	assert.Equal(t, []aclBinding{binding("User:bob", v1.ACL_OPERATION_READ)}, toDelete)
+	assert.Equal(t, 1, unchanged)
+}
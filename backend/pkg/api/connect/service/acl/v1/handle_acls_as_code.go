@@ -0,0 +1,262 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package acl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
+	"connectrpc.com/connect"
+	"go.yaml.in/yaml/v3"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
+)
+
+// maxACLDocumentSize is the maximum accepted size of an ACL document that is imported.
+const maxACLDocumentSize = 10 * 1024 * 1024
+
+// importACLsResponse is the plan, and if not running in dry-run mode the result,
+// of reconciling the ACLs in the cluster with an imported ACL document.
+type importACLsResponse struct {
+	DryRun    bool               `json:"dry_run"`
+	Prune     bool               `json:"prune"`
+	Create    []aclBindingChange `json:"create"`
+	Delete    []aclBindingChange `json:"delete"`
+	Unchanged int                `json:"unchanged"`
+	// Failed contains the changes that could not be applied. It is always
+	// empty in dry-run mode.
+	Failed []failedACLBindingChange `json:"failed"`
+}
+
+type failedACLBindingChange struct {
+	aclBindingChange
+	Action string `json:"action"`
+	Error  string `json:"error"`
+}
+
+// HandleExportACLs is the HTTP handler for exporting all Kafka and Schema Registry
+// ACLs as a YAML document grouped by principal. The document can be committed to
+// git and imported again via HandleImportACLs.
+func (s *Service) HandleExportACLs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bindings, err := s.listAllACLBindings(r.Context())
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		out, err := yaml.Marshal(aclBindingsToDocument(bindings))
+		if err != nil {
+			s.writeError(w, r, apierrors.NewConnectError(
+				connect.CodeInternal,
+				fmt.Errorf("failed to serialize ACLs into YAML: %w", err),
+				apierrors.NewErrorInfo(v1.Reason_REASON_CONSOLE_ERROR.String()),
+			))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(out); err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to write response to export ACLs request", slog.Any("error", err))
+		}
+	}
+}
+
+// HandleImportACLs is the HTTP handler for reconciling the cluster's ACLs with a
+// desired state YAML document as returned by HandleExportACLs. ACLs that are
+// declared in the document but missing in the cluster are created. ACLs that only
+// exist in the cluster are deleted if the query parameter prune=true is set.
+// With dryRun=true only the computed diff is returned and nothing is changed.
+func (s *Service) HandleImportACLs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. Parse and validate request
+		dryRun, err := parseBoolQueryParam(r, "dryRun")
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		prune, err := parseBoolQueryParam(r, "prune")
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxACLDocumentSize))
+		if err != nil {
+			s.writeError(w, r, apierrors.NewConnectError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("could not read request body: %w", err),
+				apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+			))
+			return
+		}
+
+		var doc aclDocument
+		if err := yaml.Unmarshal(body, &doc); err != nil {
+			s.writeError(w, r, apierrors.NewConnectError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("could not parse ACL document: %w", err),
+				apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+			))
+			return
+		}
+		desired, err := doc.toBindings()
+		if err != nil {
+			s.writeError(w, r, apierrors.NewConnectError(
+				connect.CodeInvalidArgument,
+				err,
+				apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+			))
+			return
+		}
+
+		isSRSupported := s.checkSchemaRegistryACLSupport(r.Context())
+		for _, b := range desired {
+			if err := s.dispatcher.validateCapabilities(s.dispatcher.analyzeTarget(b.ResourceType), isSRSupported); err != nil {
+				s.writeError(w, r, err)
+				return
+			}
+		}
+
+		// 2. Compute diff against the current state
+		current, err := s.listAllACLBindings(r.Context())
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		toCreate, toDelete, unchanged := diffACLBindings(current, desired, prune)
+
+		res := importACLsResponse{
+			DryRun:    dryRun,
+			Prune:     prune,
+			Create:    make([]aclBindingChange, len(toCreate)),
+			Delete:    make([]aclBindingChange, len(toDelete)),
+			Unchanged: unchanged,
+			Failed:    make([]failedACLBindingChange, 0),
+		}
+		for i, b := range toCreate {
+			res.Create[i] = b.toChange()
+		}
+		for i, b := range toDelete {
+			res.Delete[i] = b.toChange()
+		}
+
+		// 3. Apply changes. ACLs are created before stale ACLs are deleted, so
+		// that principals do not temporarily lose access while ACLs are replaced.
+		if !dryRun {
+			for _, b := range toCreate {
+				if err := s.createACLBinding(r.Context(), b); err != nil {
+					res.Failed = append(res.Failed, failedACLBindingChange{b.toChange(), "create", err.Error()})
+				}
+			}
+			for _, b := range toDelete {
+				if err := s.deleteACLBinding(r.Context(), b); err != nil {
+					res.Failed = append(res.Failed, failedACLBindingChange{b.toChange(), "delete", err.Error()})
+				}
+			}
+		}
+
+		out, err := json.Marshal(res)
+		if err != nil {
+			s.writeError(w, r, apierrors.NewConnectError(
+				connect.CodeInternal,
+				fmt.Errorf("failed to serialize response into JSON: %w", err),
+				apierrors.NewErrorInfo(v1.Reason_REASON_CONSOLE_ERROR.String()),
+			))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(out); err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to write response to import ACLs request", slog.Any("error", err))
+		}
+	}
+}
+
+// listAllACLBindings lists all Kafka ACLs and, if supported, all Schema Registry ACLs.
+func (s *Service) listAllACLBindings(ctx context.Context) ([]aclBinding, error) {
+	filter := &v1.ListACLsRequest_Filter{}
+	s.defaulter.applyListACLsRequestFilter(filter)
+
+	target := s.dispatcher.analyzeTarget(filter.GetResourceType())
+	var resources []*v1.ListACLsResponse_Resource
+	if target.includesKafka() {
+		kafkaResources, err := s.listKafkaACLs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, kafkaResources...)
+	}
+	if target.includesSR() {
+		srResources, err := s.listSchemaRegistryACLs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, srResources...)
+	}
+
+	return aclResourcesToBindings(resources), nil
+}
+
+func (s *Service) createACLBinding(ctx context.Context, b aclBinding) error {
+	req := b.toCreateACLRequest()
+	target := s.dispatcher.analyzeTarget(req.GetResourceType())
+	if target.isSROnly() {
+		return s.createSchemaRegistryACLs(ctx, req)
+	}
+	return s.createKafkaACLs(ctx, req)
+}
+
+func (s *Service) deleteACLBinding(ctx context.Context, b aclBinding) error {
+	req := b.toDeleteACLsRequest()
+	target := s.dispatcher.analyzeTarget(req.GetFilter().GetResourceType())
+	if target.isSROnly() {
+		_, err := s.deleteSchemaRegistryACLs(ctx, req)
+		return err
+	}
+	_, err := s.deleteKafkaACLs(ctx, req)
+	return err
+}
+
+// writeError writes an error using connect.ErrorWriter and also logs this event.
+func (s *Service) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	childLogger := s.logger.With(
+		slog.String("request_method", r.Method),
+		slog.String("request_path", r.URL.Path),
+	)
+
+	apierrors.HandleHTTPError(r.Context(), w, r, err)
+	childLogger.WarnContext(r.Context(), "", slog.Any("error", err))
+}
+
+func parseBoolQueryParam(r *http.Request, name string) (bool, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, apierrors.NewConnectError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("query parameter %v must be a boolean: %w", name, err),
+			apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+		)
+	}
+	return v, nil
+}
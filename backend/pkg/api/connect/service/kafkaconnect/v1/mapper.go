@@ -15,8 +15,10 @@ import (
 	"fmt"
 
 	con "github.com/cloudhut/connect-client"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/console"
 	dataplanev1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
 )
 
@@ -249,6 +251,44 @@ func (m mapper) connectorStatusToProto(status kafkaconnect.ConnectorStatus) (*da
 	}, nil
 }
 
+func (mapper) connectorOffsetsToProto(overview *console.ConnectorOffsetsOverview) (*dataplanev1.GetConnectorOffsetsResponse, error) {
+	offsets := make([]*dataplanev1.ConnectorOffset, len(overview.Offsets))
+	for i, offset := range overview.Offsets {
+		partition, err := structpb.NewStruct(offset.Partition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map partition of connector offset to proto: %w", err)
+		}
+		offsets[i] = &dataplanev1.ConnectorOffset{
+			Partition:     partition,
+			Lag:           offset.Lag,
+			HighWaterMark: offset.HighWaterMark,
+		}
+		if offset.Offset != nil {
+			offsets[i].Offset, err = structpb.NewStruct(offset.Offset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to map connector offset to proto: %w", err)
+			}
+		}
+	}
+
+	return &dataplanev1.GetConnectorOffsetsResponse{
+		Offsets:       offsets,
+		ConsumerGroup: overview.ConsumerGroup,
+	}, nil
+}
+
+func (mapper) connectorOffsetsProtoToClientRequest(offsets []*dataplanev1.ConnectorOffset) kafkaconnect.ConnectorOffsets {
+	converted := make([]kafkaconnect.ConnectorOffset, len(offsets))
+	for i, offset := range offsets {
+		converted[i] = kafkaconnect.ConnectorOffset{Partition: offset.GetPartition().AsMap()}
+		// A nil offset resets the offset of the partition
+		if offset.Offset != nil {
+			converted[i].Offset = offset.Offset.AsMap()
+		}
+	}
+	return kafkaconnect.ConnectorOffsets{Offsets: converted}
+}
+
 // convertStringMapToInterfaceMap converts interface map to string map
 func convertStringMapToInterfaceMap(stringMap map[string]string) map[string]any {
 	interfaceMap := make(map[string]any, len(stringMap))
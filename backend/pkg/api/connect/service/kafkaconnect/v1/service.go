@@ -27,6 +27,7 @@ import (
 	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
 	"github.com/redpanda-data/console/backend/pkg/config"
 	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/console"
 	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
 	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1/dataplanev1connect"
 )
@@ -38,6 +39,7 @@ type Service struct {
 	cfg        *config.Config
 	logger     *slog.Logger
 	connectSvc *kafkaconnect.Service
+	consoleSvc console.Servicer
 	mapper     *mapper
 	defaulter  defaulter
 }
@@ -46,11 +48,13 @@ type Service struct {
 func NewService(cfg *config.Config,
 	logger *slog.Logger,
 	kafkaConnectSrv *kafkaconnect.Service,
+	consoleSvc console.Servicer,
 ) *Service {
 	return &Service{
 		cfg:        cfg,
 		logger:     logger,
 		connectSvc: kafkaConnectSrv,
+		consoleSvc: consoleSvc,
 		mapper:     &mapper{},
 		defaulter:  defaulter{},
 	}
@@ -336,6 +340,49 @@ func (s *Service) ResetConnectorTopics(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// GetConnectorOffsets implements the handler for the get connector offsets
+// operation. Offsets of sink connectors include the lag of the connector's
+// consumer group.
+func (s *Service) GetConnectorOffsets(ctx context.Context, req *connect.Request[v1.GetConnectorOffsetsRequest]) (*connect.Response[v1.GetConnectorOffsetsResponse], error) {
+	overview, err := s.consoleSvc.GetConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	res, mapErr := s.mapper.connectorOffsetsToProto(overview)
+	if mapErr != nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeInternal,
+			mapErr,
+			apierrors.NewErrorInfo(v1.Reason_REASON_CONSOLE_ERROR.String()),
+		)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// AlterConnectorOffsets implements the handler for the alter connector offsets
+// operation. The connector must be stopped.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1.AlterConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	err := s.connectSvc.AlterConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name, s.mapper.connectorOffsetsProtoToClientRequest(req.Msg.Offsets))
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ResetConnectorOffsets implements the handler for the reset connector offsets
+// operation. The connector must be stopped.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1.ResetConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	err := s.connectSvc.ResetConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
 func (*Service) matchError(err *rest.Error) *connect.Error {
 	switch err.Status {
 	case http.StatusNotFound:
@@ -0,0 +1,41 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secret
+
+import (
+	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
+)
+
+type mapper struct{}
+
+// secretToProto converts a stored secret to its proto representation. The secret
+// data is never included.
+func (mapper) secretToProto(secret kafkaconnect.Secret) *v1.Secret {
+	scopes := make([]v1.Scope, 0, len(secret.Scopes))
+	for _, scope := range secret.Scopes {
+		if value, ok := v1.Scope_value[scope]; ok {
+			scopes = append(scopes, v1.Scope(value))
+		}
+	}
+	return &v1.Secret{
+		Id:     secret.ID,
+		Labels: secret.Labels,
+		Scopes: scopes,
+	}
+}
+
+func (mapper) scopesFromProto(scopes []v1.Scope) []string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = scope.String()
+	}
+	return names
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package secret contains the implementation of the console SecretService that
+// manages the secrets of Console's own secret store, such as externalized
+// Kafka connect connector secrets.
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/redpanda-data/common-go/api/pagination"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1/consolev1alpha1connect"
+	v1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1"
+)
+
+var _ consolev1alpha1connect.SecretServiceHandler = (*Service)(nil)
+
+// Service that implements the SecretServiceHandler interface on top of a
+// SecretStore. Secret data can be written, but it is never returned.
+type Service struct {
+	// ListResources is not supported, because the secret store does not
+	// track which resources use a secret.
+	consolev1alpha1connect.UnimplementedSecretServiceHandler
+
+	logger *slog.Logger
+	store  kafkaconnect.SecretStore
+	mapper mapper
+}
+
+// NewService creates a new secret service handler.
+func NewService(logger *slog.Logger, store kafkaconnect.SecretStore) *Service {
+	return &Service{
+		logger: logger,
+		store:  store,
+		mapper: mapper{},
+	}
+}
+
+// GetSecret returns the secret's metadata.
+func (s *Service) GetSecret(ctx context.Context, req *connect.Request[v1alpha1.GetSecretRequest]) (*connect.Response[v1alpha1.GetSecretResponse], error) {
+	secret, err := s.store.Get(ctx, req.Msg.GetRequest().GetId())
+	if err != nil {
+		return nil, s.storeError(ctx, err, "get")
+	}
+
+	return connect.NewResponse(&v1alpha1.GetSecretResponse{
+		Response: &v1.GetSecretResponse{Secret: s.mapper.secretToProto(secret)},
+	}), nil
+}
+
+// ListSecrets returns the metadata of all secrets that match the filter.
+func (s *Service) ListSecrets(ctx context.Context, req *connect.Request[v1alpha1.ListSecretsRequest]) (*connect.Response[v1alpha1.ListSecretsResponse], error) {
+	secrets, err := s.store.List(ctx)
+	if err != nil {
+		return nil, s.storeError(ctx, err, "list")
+	}
+
+	filter := req.Msg.GetRequest().GetFilter()
+	filtered := make([]*v1.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		if !matchesFilter(secret, filter) {
+			continue
+		}
+		filtered = append(filtered, s.mapper.secretToProto(secret))
+	}
+
+	var nextPageToken string
+	if pageSize := req.Msg.GetRequest().GetPageSize(); pageSize > 0 {
+		// Secrets are already sorted by ID
+		page, token, err := pagination.SliceToPaginatedWithToken(filtered, int(pageSize), req.Msg.GetRequest().GetPageToken(), "id", func(x *v1.Secret) string {
+			return x.GetId()
+		})
+		if err != nil {
+			return nil, apierrors.NewConnectError(
+				connect.CodeInternal,
+				fmt.Errorf("failed to apply pagination: %w", err),
+				apierrors.NewErrorInfo(v1.Reason_REASON_CONSOLE_ERROR.String()),
+			)
+		}
+		filtered = page
+		nextPageToken = token
+	}
+
+	return connect.NewResponse(&v1alpha1.ListSecretsResponse{
+		Response: &v1.ListSecretsResponse{Secrets: filtered, NextPageToken: nextPageToken},
+	}), nil
+}
+
+// CreateSecret stores a new secret.
+func (s *Service) CreateSecret(ctx context.Context, req *connect.Request[v1alpha1.CreateSecretRequest]) (*connect.Response[v1alpha1.CreateSecretResponse], error) {
+	msg := req.Msg.GetRequest()
+	secret := kafkaconnect.Secret{
+		ID:     msg.GetId(),
+		Labels: msg.GetLabels(),
+		Scopes: s.mapper.scopesFromProto(msg.GetScopes()),
+		Data:   msg.GetSecretData(),
+	}
+	if err := s.store.Create(ctx, secret); err != nil {
+		return nil, s.storeError(ctx, err, "create")
+	}
+
+	return connect.NewResponse(&v1alpha1.CreateSecretResponse{
+		Response: &v1.CreateSecretResponse{Secret: s.mapper.secretToProto(secret)},
+	}), nil
+}
+
+// UpdateSecret replaces the labels and scopes of an existing secret. The secret
+// data is only replaced if it is set.
+func (s *Service) UpdateSecret(ctx context.Context, req *connect.Request[v1alpha1.UpdateSecretRequest]) (*connect.Response[v1alpha1.UpdateSecretResponse], error) {
+	msg := req.Msg.GetRequest()
+	secret, err := s.store.Get(ctx, msg.GetId())
+	if err != nil {
+		return nil, s.storeError(ctx, err, "update")
+	}
+	secret.Labels = msg.GetLabels()
+	secret.Scopes = s.mapper.scopesFromProto(msg.GetScopes())
+	if len(msg.GetSecretData()) > 0 {
+		secret.Data = msg.GetSecretData()
+	}
+	if err := s.store.Put(ctx, secret); err != nil {
+		return nil, s.storeError(ctx, err, "update")
+	}
+
+	return connect.NewResponse(&v1alpha1.UpdateSecretResponse{
+		Response: &v1.UpdateSecretResponse{Secret: s.mapper.secretToProto(secret)},
+	}), nil
+}
+
+// DeleteSecret removes a secret. Connectors that still reference the secret
+// fail to resolve it once they are restarted.
+func (s *Service) DeleteSecret(ctx context.Context, req *connect.Request[v1alpha1.DeleteSecretRequest]) (*connect.Response[v1alpha1.DeleteSecretResponse], error) {
+	if err := s.store.Delete(ctx, req.Msg.GetRequest().GetId()); err != nil {
+		return nil, s.storeError(ctx, err, "delete")
+	}
+
+	return connect.NewResponse(&v1alpha1.DeleteSecretResponse{
+		Response: &v1.DeleteSecretResponse{},
+	}), nil
+}
+
+// ListSecretScopes returns all scopes that can be assigned to secrets.
+func (*Service) ListSecretScopes(context.Context, *connect.Request[v1alpha1.ListSecretScopesRequest]) (*connect.Response[v1alpha1.ListSecretScopesResponse], error) {
+	scopes := make([]v1.Scope, 0, len(v1.Scope_name))
+	for value := range v1.Scope_name {
+		if v1.Scope(value) != v1.Scope_SCOPE_UNSPECIFIED {
+			scopes = append(scopes, v1.Scope(value))
+		}
+	}
+	slices.Sort(scopes)
+
+	return connect.NewResponse(&v1alpha1.ListSecretScopesResponse{
+		Response: &v1.ListSecretScopesResponse{Scopes: scopes},
+	}), nil
+}
+
+func (s *Service) storeError(ctx context.Context, err error, operation string) *connect.Error {
+	switch {
+	case errors.Is(err, kafkaconnect.ErrSecretNotFound):
+		return apierrors.NewConnectError(
+			connect.CodeNotFound,
+			err,
+			apierrors.NewErrorInfo(v1.Reason_REASON_SECRET_STORE_ERROR.String()),
+		)
+	case errors.Is(err, kafkaconnect.ErrSecretAlreadyExists):
+		return apierrors.NewConnectError(
+			connect.CodeAlreadyExists,
+			err,
+			apierrors.NewErrorInfo(v1.Reason_REASON_SECRET_STORE_ERROR.String()),
+		)
+	default:
+		s.logger.ErrorContext(ctx, "failed to access secret store", slog.String("operation", operation), slog.Any("error", err))
+		return apierrors.NewConnectError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to %v secret: %w", operation, err),
+			apierrors.NewErrorInfo(v1.Reason_REASON_SECRET_STORE_ERROR.String()),
+		)
+	}
+}
+
+func matchesFilter(secret kafkaconnect.Secret, filter *v1.ListSecretsFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if !strings.Contains(secret.ID, filter.GetNameContains()) {
+		return false
+	}
+	for k, v := range filter.GetLabels() {
+		if secret.Labels[k] != v {
+			return false
+		}
+	}
+	if len(filter.GetScopes()) > 0 && !slices.ContainsFunc(filter.GetScopes(), func(scope v1.Scope) bool {
+		return slices.Contains(secret.Scopes, scope.String())
+	}) {
+		return false
+	}
+	return true
+}
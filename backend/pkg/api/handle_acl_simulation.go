@@ -0,0 +1,89 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+type simulateACLPermissionsRequest struct {
+	console.ACLSimulationRequest
+}
+
+func (s *simulateACLPermissionsRequest) OK() error {
+	if s.Principal == "" {
+		return errors.New("principal must be set")
+	}
+	if !strings.Contains(s.Principal, ":") {
+		return fmt.Errorf("principal %q must be prefixed with its type, e.g. User:%v", s.Principal, s.Principal)
+	}
+	for _, binding := range append(s.AddACLs, s.RemoveACLs...) {
+		if binding.Source != console.ACLSourceKafka && binding.Source != console.ACLSourceSchemaRegistry {
+			return fmt.Errorf("acl source %q is invalid, must be %v or %v", binding.Source, console.ACLSourceKafka, console.ACLSourceSchemaRegistry)
+		}
+		if binding.ResourceType == "" || binding.PatternType == "" || binding.Principal == "" ||
+			binding.Operation == "" || binding.Permission == "" {
+			return errors.New("acls must specify resource type, pattern type, principal, operation and permission")
+		}
+	}
+	return nil
+}
+
+// handleSimulateACLPermissions returns the effective permissions of a principal and,
+// optionally, how they would change if the proposed ACLs were created or deleted.
+func (api *API) handleSimulateACLPermissions() http.HandlerFunc {
+	type response struct {
+		*console.ACLSimulationResponse
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Console.CanViewACLs(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("requester is not allowed to view ACLs"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to view ACLs",
+				IsSilent: false,
+			})
+			return
+		}
+
+		var req simulateACLPermissionsRequest
+		restErr = rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		res, err := api.ConsoleSvc.SimulateACLPermissions(r.Context(), req.ACLSimulationRequest)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Could not simulate ACL permissions: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{res})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+// denyACLHooks denies viewing and managing ACLs, all other hooks keep their
+// default behavior.
+type denyACLHooks struct {
+	defaultHooks
+}
+
+func (*denyACLHooks) CanViewACLs(_ context.Context) (bool, *rest.Error) {
+	return false, nil
+}
+
+func (*denyACLHooks) CanManageACLs(_ context.Context) (bool, *rest.Error) {
+	return false, nil
+}
+
+func TestACLReadEndpointsRequirePermission(t *testing.T) {
+	api := &API{
+		ConsoleSvc: nil, // Must not be reached if the hook denies the request
+		Hooks:      &Hooks{Console: &denyACLHooks{}},
+		Logger:     slog.New(slog.NewTextHandler(nil, &slog.HandlerOptions{Level: slog.LevelError + 1})),
+	}
+
+	t.Run("list acls", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/acls", http.NoBody)
+		w := httptest.NewRecorder()
+		api.handleGetACLsOverview().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("simulate acl permissions", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/acls/simulate", bytes.NewReader([]byte(`{"principal":"User:alice"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		api.handleSimulateACLPermissions().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
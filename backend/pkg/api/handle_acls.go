@@ -10,6 +10,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -63,6 +64,21 @@ func (api *API) handleGetACLsOverview() http.HandlerFunc {
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Console.CanViewACLs(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("requester is not allowed to view ACLs"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to view ACLs",
+				IsSilent: false,
+			})
+			return
+		}
+
 		// Parse request from url parameters
 		decoder := schema.NewDecoder()
 		req := &getAclsOverviewRequest{}
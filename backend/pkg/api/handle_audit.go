@@ -0,0 +1,121 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/audit"
+)
+
+func (api *API) handleQueryAuditEvents() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if api.Auditor == nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("audit log is not enabled"),
+				Status:   http.StatusNotImplemented,
+				Message:  "The audit log is not enabled",
+				IsSilent: false,
+			})
+			return
+		}
+
+		canView, restErr := api.Hooks.Console.CanViewAuditLog(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("requester is not allowed to view the audit log"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to view the audit log",
+				IsSilent: false,
+			})
+			return
+		}
+
+		q, err := parseAuditQuery(r.URL.Query())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Invalid audit query: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.Auditor.Query(r.Context(), q)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, audit.ErrQueryNotSupported) {
+				status = http.StatusNotImplemented
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   status,
+				Message:  fmt.Sprintf("Failed to query audit log: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// parseAuditQuery parses the query parameters of the audit events endpoint.
+// Timestamps are RFC 3339 formatted.
+func parseAuditQuery(values url.Values) (audit.Query, error) {
+	q := audit.Query{
+		Actor:     values.Get("actor"),
+		Procedure: values.Get("procedure"),
+		Resource:  values.Get("resource"),
+		Outcome:   audit.Outcome(values.Get("outcome")),
+	}
+
+	switch q.Outcome {
+	case "", audit.OutcomeSuccess, audit.OutcomeFailure:
+	default:
+		return audit.Query{}, fmt.Errorf("outcome must be %q or %q", audit.OutcomeSuccess, audit.OutcomeFailure)
+	}
+
+	var err error
+	if from := values.Get("from"); from != "" {
+		if q.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return audit.Query{}, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+	if to := values.Get("to"); to != "" {
+		if q.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return audit.Query{}, fmt.Errorf("invalid to: %w", err)
+		}
+		if q.To.Before(q.From) {
+			return audit.Query{}, errors.New("to must not be before from")
+		}
+	}
+	if limit := values.Get("limit"); limit != "" {
+		if q.Limit, err = strconv.Atoi(limit); err != nil {
+			return audit.Query{}, fmt.Errorf("invalid limit: %w", err)
+		}
+		if q.Limit <= 0 || q.Limit > audit.MaxQueryLimit {
+			return audit.Query{}, fmt.Errorf("limit must be between 1 and %d", audit.MaxQueryLimit)
+		}
+	}
+	return q, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+// connectClusterRequest describes a Kafka connect cluster that is registered via
+// the API. The URL must match the registry's allowed hosts and the TLS file
+// paths are relative to its TLS directory.
+type connectClusterRequest struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Token    string `json:"token"`
+	TLS      struct {
+		Enabled               bool   `json:"enabled"`
+		CaFilepath            string `json:"caFilepath"`
+		CertFilepath          string `json:"certFilepath"`
+		KeyFilepath           string `json:"keyFilepath"`
+		InsecureSkipTLSVerify bool   `json:"insecureSkipTlsVerify"`
+	} `json:"tls"`
+}
+
+func (c *connectClusterRequest) OK() error {
+	if c.URL == "" {
+		return errors.New("url must be set")
+	}
+	return nil
+}
+
+func (c *connectClusterRequest) toClusterConfig() config.KafkaConnectCluster {
+	clusterCfg := config.KafkaConnectCluster{
+		Name:     c.Name,
+		URL:      c.URL,
+		Username: c.Username,
+		Password: c.Password,
+		Token:    c.Token,
+	}
+	clusterCfg.TLS.SetDefaults()
+	clusterCfg.TLS.Enabled = c.TLS.Enabled
+	clusterCfg.TLS.CaFilepath = c.TLS.CaFilepath
+	clusterCfg.TLS.CertFilepath = c.TLS.CertFilepath
+	clusterCfg.TLS.KeyFilepath = c.TLS.KeyFilepath
+	clusterCfg.TLS.InsecureSkipTLSVerify = c.TLS.InsecureSkipTLSVerify
+	return clusterCfg
+}
+
+// handleListConnectClusters returns all Kafka connect clusters, including the ones that
+// have been registered at runtime or discovered, along with their health.
+func (api *API) handleListConnectClusters() http.HandlerFunc {
+	type response struct {
+		Clusters []connect.ClusterStatus `json:"clusters"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{api.ConnectSvc.ListClusterStatuses()})
+	}
+}
+
+func (api *API) handleRegisterConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageConnectClusters(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var req connectClusterRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		status, restErr := api.ConnectSvc.RegisterCluster(r.Context(), req.toClusterConfig())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, status)
+	}
+}
+
+func (api *API) handleUpdateConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageConnectClusters(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		clusterName := rest.GetURLParam(r, "clusterName")
+
+		var req connectClusterRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		status, restErr := api.ConnectSvc.UpdateCluster(r.Context(), clusterName, req.toClusterConfig())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, status)
+	}
+}
+
+func (api *API) handleRemoveConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageConnectClusters(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		clusterName := rest.GetURLParam(r, "clusterName")
+
+		restErr := api.ConnectSvc.RemoveCluster(r.Context(), clusterName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
+
+// checkCanManageConnectClusters returns an error if the requester lacks the
+// permissions to register, update or remove Kafka connect clusters.
+func (api *API) checkCanManageConnectClusters(r *http.Request) *rest.Error {
+	canManage, restErr := api.Hooks.Console.CanManageConnectClusters(r.Context())
+	if restErr != nil {
+		return restErr
+	}
+	if !canManage {
+		return &rest.Error{
+			Err:      errors.New("requester is not allowed to manage kafka connect clusters"),
+			Status:   http.StatusForbidden,
+			Message:  "You don't have permissions to manage Kafka connect clusters",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleListConnectorConfigVersions() http.HandlerFunc {
+	type response struct {
+		Versions []connect.ConnectorConfigVersion `json:"versions"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		versions, restErr := api.ConnectSvc.ListConnectorConfigVersions(r.Context(), clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{versions})
+	}
+}
+
+func (api *API) handleDiffConnectorConfigVersions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		fromVersion, restErr := parseConfigVersion(r.URL.Query().Get("from"), "from")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		toVersion, restErr := parseConfigVersion(r.URL.Query().Get("to"), "to")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		diff, restErr := api.ConnectSvc.DiffConnectorConfigVersions(r.Context(), clusterName, connector, fromVersion, toVersion)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, diff)
+	}
+}
+
+func (api *API) handleRollbackConnectorConfig() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		version, restErr := parseConfigVersion(rest.GetURLParam(r, "version"), "version")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		cInfo, restErr := api.ConnectSvc.RollbackConnectorConfig(ctx, clusterName, connector, version)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, cInfo)
+	}
+}
+
+func parseConfigVersion(raw, name string) (int, *rest.Error) {
+	version, err := strconv.Atoi(raw)
+	if err != nil || version < 1 {
+		return 0, &rest.Error{
+			Err:      fmt.Errorf("failed to parse %v as version number", name),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Invalid %v given. It must be a version number greater than 0.", name),
+			IsSilent: false,
+		}
+	}
+	return version, nil
+}
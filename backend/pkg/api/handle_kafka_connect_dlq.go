@@ -0,0 +1,93 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+// handleGetConnectorDLQ returns the most recent records of a sink connector's dead
+// letter queue along with the decoded errors.
+func (api *API) handleGetConnectorDLQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		messageCount := 0
+		if raw := r.URL.Query().Get("messageCount"); raw != "" {
+			count, err := strconv.Atoi(raw)
+			if err != nil || count < 1 {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse messageCount %q", raw),
+					Status:   http.StatusBadRequest,
+					Message:  "Invalid messageCount given. It must be a number greater than 0.",
+					IsSilent: false,
+				})
+				return
+			}
+			messageCount = count
+		}
+
+		dlq, restErr := api.ConsoleSvc.GetConnectorDLQ(r.Context(), clusterName, connector, messageCount)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, dlq)
+	}
+}
+
+type replayConnectorDLQRequest struct {
+	Records []console.ConnectorDLQRecordRef `json:"records"`
+}
+
+func (req *replayConnectorDLQRequest) OK() error {
+	if len(req.Records) == 0 {
+		return errors.New("at least one record must be selected")
+	}
+	for _, record := range req.Records {
+		if record.PartitionID < 0 || record.Offset < 0 {
+			return errors.New("partitionId and offset must not be negative")
+		}
+	}
+	return nil
+}
+
+// handleReplayConnectorDLQRecords produces the selected dead letter queue records to
+// the topic they were originally consumed from.
+func (api *API) handleReplayConnectorDLQRecords() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req replayConnectorDLQRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		result, restErr := api.ConsoleSvc.ReplayConnectorDLQRecords(r.Context(), clusterName, connector, req.Records)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, result)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+type migrateConnectorRequest struct {
+	TargetClusterName   string `json:"targetClusterName"`
+	TargetConnectorName string `json:"targetConnectorName"`
+	PauseSource         bool   `json:"pauseSource"`
+	TransferOffsets     bool   `json:"transferOffsets"`
+	// SourceAction is either STOP, DELETE or KEEP. Defaults to STOP.
+	SourceAction string `json:"sourceAction"`
+}
+
+func (m *migrateConnectorRequest) OK() error {
+	if m.TargetClusterName == "" {
+		return errors.New("targetClusterName must be set")
+	}
+	switch connect.ConnectorMigrationSourceAction(strings.ToUpper(m.SourceAction)) {
+	case "", connect.ConnectorMigrationSourceActionStop, connect.ConnectorMigrationSourceActionDelete, connect.ConnectorMigrationSourceActionKeep:
+	default:
+		return fmt.Errorf("sourceAction %q is invalid, must be one of STOP, DELETE or KEEP", m.SourceAction)
+	}
+	return nil
+}
+
+// handleMigrateConnector moves a connector to another Kafka connect cluster. The
+// response reports every executed step, including the rollback of failed migrations.
+func (api *API) handleMigrateConnector() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req migrateConnectorRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		report, restErr := api.ConnectSvc.MigrateConnector(r.Context(), connect.MigrateConnectorRequest{
+			SourceClusterName:   clusterName,
+			ConnectorName:       connector,
+			TargetClusterName:   req.TargetClusterName,
+			TargetConnectorName: req.TargetConnectorName,
+			PauseSource:         req.PauseSource,
+			TransferOffsets:     req.TransferOffsets,
+			SourceAction:        connect.ConnectorMigrationSourceAction(strings.ToUpper(req.SourceAction)),
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, report)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleGetConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		offsets, restErr := api.ConsoleSvc.GetConnectorOffsets(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, offsets)
+	}
+}
+
+type alterConnectorOffsetsRequest struct {
+	connect.ConnectorOffsets
+}
+
+func (a *alterConnectorOffsetsRequest) OK() error {
+	if len(a.Offsets) == 0 {
+		return errors.New("at least one offset must be set")
+	}
+	for _, offset := range a.Offsets {
+		if len(offset.Partition) == 0 {
+			return errors.New("partition must be set for all offsets")
+		}
+	}
+	return nil
+}
+
+// handleAlterConnectorOffsets overwrites the offsets of the given connector partitions.
+// Offsets that are set to null reset the partition's offset.
+func (api *API) handleAlterConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req alterConnectorOffsetsRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		restErr = api.ConnectSvc.AlterConnectorOffsets(ctx, clusterName, connector, req.ConnectorOffsets)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
+
+func (api *API) handleResetConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		restErr := api.ConnectSvc.ResetConnectorOffsets(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
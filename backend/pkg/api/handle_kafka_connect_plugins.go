@@ -0,0 +1,35 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+)
+
+// handleGetConnectPluginCatalog returns all connector plugins that are installed in any
+// Kafka connect cluster, along with their versions and the connectors using them.
+func (api *API) handleGetConnectPluginCatalog() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		catalog, err := api.ConnectSvc.GetPluginCatalog(r.Context())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotImplemented,
+				Message:  "Kafka Connect is not configured in Redpanda Console",
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, catalog)
+	}
+}
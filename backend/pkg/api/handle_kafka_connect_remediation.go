@@ -0,0 +1,37 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleListRemediationEvents() http.HandlerFunc {
+	type response struct {
+		Events []connect.RemediationEvent `json:"events"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := r.URL.Query().Get("clusterName")
+		connector := r.URL.Query().Get("connector")
+
+		events, restErr := api.ConnectSvc.ListRemediationEvents(clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{events})
+	}
+}
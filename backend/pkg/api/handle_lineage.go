@@ -0,0 +1,88 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/gorilla/schema"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+type getLineageGraphRequest struct {
+	// NodeID is the node whose upstream or downstream graph shall be returned,
+	// e.g. "topic:orders". If empty, the whole graph is returned.
+	NodeID string `schema:"nodeId"`
+
+	// Direction is either upstream, downstream or both. Defaults to both.
+	Direction string `schema:"direction"`
+
+	// Depth is the maximum number of edges between the node and returned nodes.
+	// 0 means unlimited.
+	Depth int `schema:"depth"`
+}
+
+func (g *getLineageGraphRequest) OK() error {
+	switch console.LineageDirection(strings.ToUpper(g.Direction)) {
+	case "", console.LineageDirectionUpstream, console.LineageDirectionDownstream, console.LineageDirectionBoth:
+	default:
+		return fmt.Errorf("direction %q is invalid, must be one of upstream, downstream or both", g.Direction)
+	}
+	if g.Depth < 0 {
+		return errors.New("depth must not be negative")
+	}
+	return nil
+}
+
+// handleGetLineageGraph returns the data lineage graph of topics, consumer groups,
+// connectors, data transforms and Redpanda Connect pipelines.
+func (api *API) handleGetLineageGraph() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		decoder := schema.NewDecoder()
+		decoder.IgnoreUnknownKeys(true)
+		req := &getLineageGraphRequest{}
+		err := decoder.Decode(req, r.URL.Query())
+		if err == nil {
+			err = req.OK()
+		}
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse request parameters: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		direction := console.LineageDirection(strings.ToUpper(req.Direction))
+		if direction == "" {
+			direction = console.LineageDirectionBoth
+		}
+
+		graph, restErr := api.ConsoleSvc.GetLineageGraph(r.Context(), console.LineageGraphRequest{
+			NodeID:    req.NodeID,
+			Direction: direction,
+			Depth:     req.Depth,
+			Pipelines: api.Hooks.Console.LineagePipelines(r.Context()),
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, graph)
+	}
+}
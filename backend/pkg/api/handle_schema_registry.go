@@ -22,6 +22,7 @@ import (
 	"github.com/twmb/franz-go/pkg/sr"
 
 	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/schema/lint"
 )
 
 // createSchemaRequest defines the expected JSON body to create a schema.
@@ -29,6 +30,9 @@ type createSchemaRequest struct {
 	sr.Schema
 	Params struct {
 		Normalize bool `json:"normalize"`
+		// OverrideLintErrors registers the schema even if it violates lint rules
+		// with severity ERROR.
+		OverrideLintErrors bool `json:"overrideLintErrors"`
 	} `json:"params"`
 }
 
@@ -807,8 +811,25 @@ func (api *API) handleCreateSchema() http.HandlerFunc {
 			return
 		}
 
-		// 2. Send create request
+		// 2. Check whether the requester may override lint errors
+		if payload.Params.OverrideLintErrors {
+			if restErr := api.checkSchemaLintOverride(r); restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+		}
+
+		// 3. Send create request
 		res, err := api.ConsoleSvc.CreateSchemaRegistrySchema(r.Context(), subjectName, payload.Schema, payload.Params)
+		var lintErr *console.SchemaLintError
+		if errors.As(err, &lintErr) {
+			rest.SendResponse(w, r, api.Logger, http.StatusUnprocessableEntity, schemaLintErrorResponse{
+				StatusCode:     http.StatusUnprocessableEntity,
+				Message:        fmt.Sprintf("Failed to create schema: %v", lintErr.Error()),
+				LintViolations: lintErr.Violations,
+			})
+			return
+		}
 		if err != nil {
 			rest.SendRESTError(w, r, api.Logger, &rest.Error{
 				Err:          err,
@@ -824,6 +845,41 @@ func (api *API) handleCreateSchema() http.HandlerFunc {
 	}
 }
 
+// schemaLintErrorResponse is the response if a schema is rejected because it
+// violates lint rules. It extends the regular REST error with the violations.
+type schemaLintErrorResponse struct {
+	StatusCode     int              `json:"statusCode"`
+	Message        string           `json:"message"`
+	LintViolations []lint.Violation `json:"lintViolations"`
+}
+
+// checkSchemaLintOverride returns an error if lint errors must not be overridden,
+// either because overrides are disabled or because the requester lacks permissions.
+func (api *API) checkSchemaLintOverride(r *http.Request) *rest.Error {
+	if !api.Cfg.SchemaRegistry.Lint.AllowOverride {
+		return &rest.Error{
+			Err:      errors.New("schema lint overrides are not allowed"),
+			Status:   http.StatusForbidden,
+			Message:  "Overriding schema lint errors is not allowed. It can be enabled via the config key schemaRegistry.lint.allowOverride",
+			IsSilent: true,
+		}
+	}
+
+	canOverride, restErr := api.Hooks.Console.CanOverrideSchemaLintErrors(r.Context())
+	if restErr != nil {
+		return restErr
+	}
+	if !canOverride {
+		return &rest.Error{
+			Err:      errors.New("requester is not allowed to override schema lint errors"),
+			Status:   http.StatusForbidden,
+			Message:  "You don't have permissions to override schema lint errors",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
 func (api *API) handleValidateSchema() http.HandlerFunc {
 	if !api.Cfg.SchemaRegistry.Enabled {
 		return api.handleSchemaRegistryNotConfigured()
@@ -876,6 +932,170 @@ func (api *API) handleValidateSchema() http.HandlerFunc {
 	}
 }
 
+func (api *API) handleCheckSchemaCompatibility() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	type request struct {
+		Schema     string               `json:"schema"`
+		SchemaType sr.SchemaType        `json:"schemaType"`
+		References []sr.SchemaReference `json:"references"`
+		// Compatibility is the level to check. If unset, the subject's
+		// compatibility level is used.
+		Compatibility *sr.CompatibilityLevel `json:"compatibility"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. Parse request parameters
+		subjectName := getSubjectFromRequestPath(r)
+
+		var req request
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if req.Schema == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          errors.New("payload validation failed for checking schema compatibility"),
+				Status:       http.StatusBadRequest,
+				Message:      "You must set the schema field when checking the schema compatibility",
+				InternalLogs: []slog.Attr{slog.String("subject_name", subjectName)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		// 2. Check compatibility with all versions of the subject
+		res, err := api.ConsoleSvc.CheckSchemaRegistrySchemaCompatibility(r.Context(), subjectName, sr.Schema{
+			Schema:     req.Schema,
+			Type:       req.SchemaType,
+			References: req.References,
+		}, req.Compatibility)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("failed checking schema compatibility: %w", err),
+				Status:       http.StatusBadRequest,
+				Message:      fmt.Sprintf("Failed checking schema compatibility: %v", err.Error()),
+				InternalLogs: []slog.Attr{slog.String("subject_name", subjectName)},
+				IsSilent:     false,
+			})
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetSchemaDiff() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. Parse request parameters. The subject to compare with defaults to the
+		// same subject, so that two versions of a subject can be compared easily.
+		query := r.URL.Query()
+		fromSubject := query.Get("fromSubject")
+		toSubject := query.Get("toSubject")
+		if toSubject == "" {
+			toSubject = fromSubject
+		}
+		if fromSubject == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("fromSubject query parameter is missing"),
+				Status:   http.StatusBadRequest,
+				Message:  "You must set the fromSubject query parameter",
+				IsSilent: false,
+			})
+			return
+		}
+
+		fromVersion, restErr := parseVersionStr(query.Get("fromVersion"))
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		toVersion, restErr := parseVersionStr(query.Get("toVersion"))
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Compare both schema versions
+		res, err := api.ConsoleSvc.DiffSchemaRegistrySchemas(r.Context(), fromSubject, fromVersion, toSubject, toVersion)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:     fmt.Errorf("failed to compare schemas: %w", err),
+				Status:  http.StatusBadGateway,
+				Message: fmt.Sprintf("Failed to compare schemas: %v", err.Error()),
+				InternalLogs: []slog.Attr{
+					slog.String("from_subject", fromSubject),
+					slog.Int("from_version", fromVersion),
+					slog.String("to_subject", toSubject),
+					slog.Int("to_version", toVersion),
+				},
+				IsSilent: false,
+			})
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetSchemaUsage() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, restErr := api.ConsoleSvc.GetSchemaUsage(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetSubjectSchemaUsage() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		subjectName := getSubjectFromRequestPath(r)
+
+		res, restErr := api.ConsoleSvc.GetSubjectSchemaUsage(r.Context(), subjectName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleSyncSchemaRegistry() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := console.SchemaRegistrySyncRequest{}
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		res, restErr := api.ConsoleSvc.SyncSchemaRegistry(r.Context(), req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
 func getSubjectFromRequestPath(r *http.Request) string {
 	// Subject extraction is a little tricky.
 	// Subjects can have characters such as "/" and "%"".
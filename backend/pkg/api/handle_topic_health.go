@@ -0,0 +1,108 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/gorilla/schema"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+const (
+	defaultTopicHealthWindow        = 5 * time.Second
+	maxTopicHealthWindow            = time.Minute
+	defaultTopicHealthKeySampleSize = 1000
+	maxTopicHealthKeySampleSize     = 100_000
+)
+
+type getTopicHealthRequest struct {
+	// WindowMs is the duration in ms between the two watermark snapshots that are
+	// used to calculate the produce rate. Set to 0 to skip the rate sampling.
+	WindowMs *int64 `schema:"windowMs"`
+
+	// KeySampleSize is the number of recent records whose keys are hashed. Set to 0
+	// to skip the key distribution analysis.
+	KeySampleSize *int `schema:"keySampleSize"`
+}
+
+func (g *getTopicHealthRequest) OK() error {
+	if g.WindowMs != nil && (*g.WindowMs < 0 || time.Duration(*g.WindowMs)*time.Millisecond > maxTopicHealthWindow) {
+		return fmt.Errorf("windowMs must be between 0 and %d", maxTopicHealthWindow.Milliseconds())
+	}
+	if g.KeySampleSize != nil && (*g.KeySampleSize < 0 || *g.KeySampleSize > maxTopicHealthKeySampleSize) {
+		return fmt.Errorf("keySampleSize must be between 0 and %d", maxTopicHealthKeySampleSize)
+	}
+	return nil
+}
+
+// handleGetTopicHealth returns the partition skew and hot partition analysis of a topic.
+func (api *API) handleGetTopicHealth() http.HandlerFunc {
+	type response struct {
+		*console.TopicHealth
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		topicName := rest.GetURLParam(r, "topicName")
+		logger := api.Logger.With(slog.String("topic_name", topicName))
+
+		decoder := schema.NewDecoder()
+		decoder.IgnoreUnknownKeys(true)
+		req := &getTopicHealthRequest{}
+		err := decoder.Decode(req, r.URL.Query())
+		if err == nil {
+			err = req.OK()
+		}
+		if err != nil {
+			rest.SendRESTError(w, r, logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse request parameters: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		if topicName == "" {
+			rest.SendRESTError(w, r, logger, &rest.Error{
+				Err:      errors.New("topic name must be set"),
+				Status:   http.StatusBadRequest,
+				Message:  "Topic name must be set",
+				IsSilent: false,
+			})
+			return
+		}
+
+		healthReq := console.TopicHealthRequest{
+			TopicName:     topicName,
+			SampleWindow:  defaultTopicHealthWindow,
+			KeySampleSize: defaultTopicHealthKeySampleSize,
+		}
+		if req.WindowMs != nil {
+			healthReq.SampleWindow = time.Duration(*req.WindowMs) * time.Millisecond
+		}
+		if req.KeySampleSize != nil {
+			healthReq.KeySampleSize = *req.KeySampleSize
+		}
+
+		health, restErr := api.ConsoleSvc.GetTopicHealth(r.Context(), healthReq)
+		if restErr != nil {
+			rest.SendRESTError(w, r, logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, logger, http.StatusOK, response{health})
+	}
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/cloudhut/common/rest"
 	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
 )
 
 type recordsRequest struct {
@@ -148,3 +150,29 @@ func compressionTypeToKgoCodec(compressionType int8) []kgo.CompressionCodec {
 		return []kgo.CompressionCodec{kgo.NoCompression()}
 	}
 }
+
+func (api *API) handleGenerateTopicRecords() http.HandlerFunc {
+	if !api.Cfg.SchemaRegistry.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. Parse request, records are distributed across partitions unless requested otherwise
+		req := console.GenerateRecordsRequest{PartitionID: -1}
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		req.Topic = rest.GetURLParam(r, "topicName")
+
+		// 2. Generate and produce records
+		res, restErr := api.ConsoleSvc.GenerateRecords(r.Context(), req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
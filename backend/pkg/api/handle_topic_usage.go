@@ -0,0 +1,106 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/gorilla/schema"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+// defaultTopicUsageIdleDays is the number of days without writes after which a topic
+// is flagged as idle, if no threshold has been requested.
+const defaultTopicUsageIdleDays = 30
+
+type getTopicUsageRequest struct {
+	// TopicNames is a comma separated list of topics to analyze. All topics are
+	// analyzed if it is empty.
+	TopicNames string `schema:"topicNames"`
+
+	// IdleDays is the number of days without any writes after which a topic is
+	// flagged as idle.
+	IdleDays *int `schema:"idleDays"`
+
+	// IncludeInternal controls whether internal topics shall be analyzed too.
+	IncludeInternal bool `schema:"includeInternal"`
+}
+
+func (g *getTopicUsageRequest) OK() error {
+	if g.IdleDays != nil && *g.IdleDays < 0 {
+		return errors.New("idleDays must not be negative")
+	}
+	return nil
+}
+
+// ToServiceRequest returns the request that is expected by the console service.
+func (g *getTopicUsageRequest) ToServiceRequest() console.TopicUsageRequest {
+	idleDays := defaultTopicUsageIdleDays
+	if g.IdleDays != nil {
+		idleDays = *g.IdleDays
+	}
+
+	var topicNames []string
+	if g.TopicNames != "" {
+		topicNames = strings.Split(g.TopicNames, ",")
+	}
+
+	return console.TopicUsageRequest{
+		TopicNames:      topicNames,
+		IdleThreshold:   time.Duration(idleDays) * 24 * time.Hour,
+		IncludeInternal: g.IncludeInternal,
+	}
+}
+
+// handleGetTopicUsage returns a report that flags topics which are no longer
+// produced to or consumed from.
+func (api *API) handleGetTopicUsage() http.HandlerFunc {
+	type response struct {
+		*console.TopicUsageReport
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		decoder := schema.NewDecoder()
+		decoder.IgnoreUnknownKeys(true)
+		req := &getTopicUsageRequest{}
+		err := decoder.Decode(req, r.URL.Query())
+		if err == nil {
+			err = req.OK()
+		}
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse request parameters: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		report, err := api.ConsoleSvc.AnalyzeTopicUsage(r.Context(), req.ToServiceRequest())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Could not analyze topic usage: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{report})
+	}
+}
@@ -121,6 +121,37 @@ type ConsoleHooks interface {
 	// The response of this hook will be merged into the response that was originally
 	// composed by Console.
 	EndpointCompatibility(ctx context.Context) []console.EndpointCompatibilityEndpoint
+
+	// LineagePipelines returns the Redpanda Connect pipelines along with their input
+	// and output topics, so that they can be added to the data lineage graph.
+	LineagePipelines(ctx context.Context) []console.LineagePipeline
+
+	// CanOverrideSchemaLintErrors returns whether the requester may register a
+	// schema that violates lint rules with severity ERROR. It's only consulted
+	// if overrides are allowed via schemaRegistry.lint.allowOverride.
+	CanOverrideSchemaLintErrors(ctx context.Context) (bool, *rest.Error)
+
+	// CanManageConnectClusters returns whether the requester may register,
+	// update and remove Kafka connect clusters at runtime.
+	CanManageConnectClusters(ctx context.Context) (bool, *rest.Error)
+
+	// CanViewAuditLog returns whether the requester may query the events of
+	// the audit log.
+	CanViewAuditLog(ctx context.Context) (bool, *rest.Error)
+
+	// CanViewACLs returns whether the requester may list ACLs and simulate
+	// the effective permissions of a principal.
+	CanViewACLs(ctx context.Context) (bool, *rest.Error)
+
+	// CanManageACLs returns whether the requester may create and delete ACLs
+	// by importing an ACL document.
+	CanManageACLs(ctx context.Context) (bool, *rest.Error)
+
+	// AuthenticatedPrincipal returns the identity of the authenticated requester,
+	// which is used to attribute changes, e.g. in the audit log and the connector
+	// config history. It is called after the request has been authenticated and
+	// returns an empty string if the request is not authenticated.
+	AuthenticatedPrincipal(ctx context.Context) string
 }
 
 // defaultHooks is the default hook which is used if you don't attach your own hooks
@@ -166,10 +197,38 @@ func (*defaultHooks) CheckWebsocketConnection(r *http.Request, _ httptypes.ListM
 	return r.Context(), nil
 }
 
+func (*defaultHooks) LineagePipelines(context.Context) []console.LineagePipeline {
+	return nil
+}
+
 func (*defaultHooks) EnabledConnectClusterFeatures(_ context.Context, _ string) []pkgconnect.ClusterFeature {
 	return nil
 }
 
+func (*defaultHooks) CanOverrideSchemaLintErrors(_ context.Context) (bool, *rest.Error) {
+	return true, nil
+}
+
+func (*defaultHooks) CanManageConnectClusters(_ context.Context) (bool, *rest.Error) {
+	return true, nil
+}
+
+func (*defaultHooks) CanViewAuditLog(_ context.Context) (bool, *rest.Error) {
+	return true, nil
+}
+
+func (*defaultHooks) CanViewACLs(_ context.Context) (bool, *rest.Error) {
+	return true, nil
+}
+
+func (*defaultHooks) CanManageACLs(_ context.Context) (bool, *rest.Error) {
+	return true, nil
+}
+
+func (*defaultHooks) AuthenticatedPrincipal(_ context.Context) string {
+	return ""
+}
+
 func (*defaultHooks) CanListRedpandaRoles(_ context.Context) (bool, *rest.Error) {
 	return true, nil
 }
@@ -10,8 +10,13 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net"
 	"net/http"
 	"strconv"
@@ -20,6 +25,10 @@ import (
 
 	"github.com/cloudhut/common/rest"
 	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/redpanda-data/console/backend/pkg/actor"
+	"github.com/redpanda-data/console/backend/pkg/audit"
 )
 
 // BasePathCtxKey is a helper to avoid allocations, idea taken from chi
@@ -178,3 +187,173 @@ func forceLoopbackMiddleware(logger *slog.Logger) func(next http.Handler) http.H
 		})
 	}
 }
+
+// setDefaultActorMiddleware attributes each request to the client's address. In
+// deployments with authentication, the authenticated actor middleware or
+// interceptor replaces this default with the authenticated identity.
+func setDefaultActorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		ctx := actor.WithName(r.Context(), "anonymous@"+host)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// newAuthenticatedActorMiddleware attributes each request to the principal that
+// is returned by the given hook. It must be used after the authentication
+// middlewares. If the request is not authenticated, the default actor is kept.
+func newAuthenticatedActorMiddleware(principal func(ctx context.Context) string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if name := principal(r.Context()); name != "" {
+				actor.SetName(r.Context(), name)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requirePermissionMiddleware rejects requests with 403 unless the given permission
+// hook allows them. It is used for HTTP handlers that are not served via
+// ConnectRPC and thus bypass the interceptors that authorize requests.
+func requirePermissionMiddleware(logger *slog.Logger, isAllowed func(ctx context.Context) (bool, *rest.Error), action string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed, restErr := isAllowed(r.Context())
+			if restErr != nil {
+				rest.SendRESTError(w, r, logger, restErr)
+				return
+			}
+			if !allowed {
+				rest.SendRESTError(w, r, logger, &rest.Error{
+					Err:      fmt.Errorf("requester is not allowed to %v", action),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You don't have permissions to %v", action),
+					IsSilent: false,
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+const (
+	// maxAuditRequestBodyBytes is the max size of request bodies that are
+	// buffered to create the audit request digest.
+	maxAuditRequestBodyBytes = 1 << 20
+	// maxAuditResponseErrorBytes is the max size of error responses that are
+	// buffered to record the error message.
+	maxAuditResponseErrorBytes = 4096
+)
+
+// newAuditMiddleware creates a middleware that records an audit event for
+// every mutating request. The middleware must be used within the router that
+// registers the routes, so that the route pattern and URL parameters are set
+// once the handler returned. It does nothing if the recorder is nil.
+func newAuditMiddleware(recorder *audit.Recorder) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if recorder == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !audit.IsMutatingMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			event := audit.Event{
+				Time:          start,
+				SourceAddress: r.RemoteAddr,
+				Protocol:      "rest",
+				Procedure:     r.Method + " " + r.URL.Path,
+				Outcome:       audit.OutcomeSuccess,
+			}
+
+			// Buffer JSON request bodies, while keeping them readable for the handler
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if r.Body != nil && (mediaType == "" || mediaType == "application/json") {
+				body, err := io.ReadAll(io.LimitReader(r.Body, maxAuditRequestBodyBytes+1))
+				r.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+				switch {
+				case err != nil:
+					event.RequestDigest = fmt.Sprintf("<failed to read request body: %v>", err)
+				case len(body) > maxAuditRequestBodyBytes:
+					event.RequestDigest = fmt.Sprintf("<request body exceeds %d bytes>", maxAuditRequestBodyBytes)
+				default:
+					event.Resources = recorder.JSONResources(body)
+					event.RequestDigest = recorder.RequestDigest(body)
+				}
+			} else if mediaType != "" {
+				event.RequestDigest = fmt.Sprintf("<%d bytes of %s content>", r.ContentLength, mediaType)
+			}
+
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			errBody := &cappedBuffer{limit: maxAuditResponseErrorBytes}
+			ww.Tee(errBody)
+			next.ServeHTTP(ww, r)
+
+			// The actor is read after handling the request, so that it is the
+			// authenticated principal if the request is authenticated by a
+			// nested middleware.
+			event.Actor = actor.FromContext(r.Context())
+			event.LatencyMs = time.Since(start).Milliseconds()
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			event.Status = strconv.Itoa(status)
+			if status >= http.StatusBadRequest {
+				event.Outcome = audit.OutcomeFailure
+				event.Error = restErrorMessage(errBody.Bytes())
+			}
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if pattern := rctx.RoutePattern(); pattern != "" {
+					event.Procedure = r.Method + " " + pattern
+				}
+				for i, key := range rctx.URLParams.Keys {
+					if key == "*" {
+						continue
+					}
+					if event.Resources == nil {
+						event.Resources = make(map[string]string)
+					}
+					event.Resources[key] = rctx.URLParams.Values[i]
+				}
+			}
+			recorder.Record(r.Context(), event)
+		})
+	}
+}
+
+// restErrorMessage returns the message of a REST error response, or the
+// response itself if it is not a REST error.
+func restErrorMessage(body []byte) string {
+	var restErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &restErr); err == nil && restErr.Message != "" {
+		return restErr.Message
+	}
+	return strings.ToValidUTF8(string(body), "")
+}
+
+// cappedBuffer is a buffer that discards all writes beyond its limit.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.Len(); remaining > 0 {
+		b.Buffer.Write(p[:min(len(p), remaining)])
+	}
+	return len(p), nil
+}
@@ -9,12 +9,27 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/cloudhut/common/rest"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/actor"
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/config"
 )
 
 func TestCreateHSTSHeaderMiddleware(t *testing.T) {
@@ -40,3 +55,234 @@ func TestCreateHSTSHeaderMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuditMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	cfg := config.ConsoleAudit{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.File.Enabled = true
+	cfg.File.Path = path
+	recorder, err := audit.NewRecorder(cfg, slog.New(slog.DiscardHandler), nil)
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(actor.WithName(r.Context(), "alice")))
+		})
+	})
+	router.Route("/api", func(r chi.Router) {
+		r.Use(newAuditMiddleware(recorder))
+		r.Get("/topics", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		r.Patch("/topics/{topicName}/configuration", func(w http.ResponseWriter, r *http.Request) {
+			// The handler must still be able to read the complete body
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), "hunter2")
+
+			rest.SendRESTError(w, r, slog.New(slog.DiscardHandler), &rest.Error{
+				Err:     errors.New("denied"),
+				Status:  http.StatusForbidden,
+				Message: "You are not allowed to edit topic configs",
+			})
+		})
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp, err := ts.Client().Get(ts.URL + "/api/topics")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	req, err := http.NewRequest(http.MethodPatch, ts.URL+"/api/topics/orders/configuration",
+		strings.NewReader(`{"configs":[{"key":"sasl.jaas.config","op":"SET","value":"hunter2"}]}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = ts.Client().Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	require.NoError(t, recorder.Close())
+
+	// Only the mutating request has been recorded
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 1)
+
+	var event audit.Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.Equal(t, "alice", event.Actor)
+	assert.Equal(t, "rest", event.Protocol)
+	assert.Equal(t, "PATCH /api/topics/{topicName}/configuration", event.Procedure)
+	assert.Equal(t, map[string]string{"topicName": "orders"}, event.Resources)
+	assert.Equal(t, `{"configs":[{"key":"sasl.jaas.config","op":"SET","value":"[REDACTED]"}]}`, event.RequestDigest)
+	assert.Equal(t, audit.OutcomeFailure, event.Outcome)
+	assert.Equal(t, "403", event.Status)
+	assert.Equal(t, "You are not allowed to edit topic configs", event.Error)
+}
+
+func TestRequirePermissionMiddleware(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hooks := &denyACLHooks{}
+
+	handled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		handled = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	router := chi.NewRouter()
+	router.With(requirePermissionMiddleware(logger, hooks.CanViewACLs, "export ACLs")).Get("/v1/acls/export", next)
+	router.With(requirePermissionMiddleware(logger, hooks.CanManageACLs, "import ACLs")).Post("/v1/acls/import", next)
+	router.With(requirePermissionMiddleware(logger, hooks.CanViewAuditLog, "view the audit log")).Get("/allowed", next)
+
+	t.Run("export denied", func(t *testing.T) {
+		handled = false
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/acls/export", http.NoBody))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "You don't have permissions to export ACLs")
+		assert.False(t, handled)
+	})
+
+	t.Run("import denied", func(t *testing.T) {
+		handled = false
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/acls/import?dryRun=true", strings.NewReader("principals: []")))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "You don't have permissions to import ACLs")
+		assert.False(t, handled)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		handled = false
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/allowed", http.NoBody))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, handled)
+	})
+}
+
+func TestAuthenticatedActorMiddleware(t *testing.T) {
+	principal := func(r *http.Request) string {
+		return r.Header.Get("X-Test-Principal")
+	}
+
+	var handlerActor, outerActor string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerActor = actor.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	// outer reads the actor once the request has been handled, like the audit
+	// middleware and interceptor do
+	outer := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+			outerActor = actor.FromContext(r.Context())
+		})
+	}
+	// authenticate simulates an authentication middleware that stores the
+	// identity in a context that is only visible to nested handlers
+	type principalKey struct{}
+	authenticate := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal(r))))
+		})
+	}
+	fromContext := func(ctx context.Context) string {
+		name, _ := ctx.Value(principalKey{}).(string)
+		return name
+	}
+
+	h := setDefaultActorMiddleware(outer(authenticate(newAuthenticatedActorMiddleware(fromContext)(handler))))
+
+	t.Run("authenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/topics", http.NoBody)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("X-Test-Principal", "User:alice")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, "User:alice", handlerActor)
+		assert.Equal(t, "User:alice", outerActor)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/topics", http.NoBody)
+		req.RemoteAddr = "10.0.0.1:1234"
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, "anonymous@10.0.0.1", handlerActor)
+		assert.Equal(t, "anonymous@10.0.0.1", outerActor)
+	})
+}
+
+func TestAuditMiddlewareRecordsAuthenticatedPrincipal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	cfg := config.ConsoleAudit{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.File.Enabled = true
+	cfg.File.Path = path
+	recorder, err := audit.NewRecorder(cfg, slog.New(slog.DiscardHandler), nil)
+	require.NoError(t, err)
+
+	// authenticate simulates an authentication middleware that is registered
+	// after the audit middleware and stores the identity in the context
+	type principalKey struct{}
+	authenticate := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := r.Header.Get("X-Test-Principal")
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+		})
+	}
+	authenticatedPrincipal := func(ctx context.Context) string {
+		name, _ := ctx.Value(principalKey{}).(string)
+		return name
+	}
+
+	router := chi.NewRouter()
+	router.Use(setDefaultActorMiddleware)
+	router.Route("/api", func(r chi.Router) {
+		r.Use(newAuditMiddleware(recorder))
+		r.With(authenticate, newAuthenticatedActorMiddleware(authenticatedPrincipal)).
+			Post("/topics", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			})
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	for _, principal := range []string{"User:alice", ""} {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/api/topics", strings.NewReader(`{"topicName":"orders"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if principal != "" {
+			req.Header.Set("X-Test-Principal", principal)
+		}
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	require.NoError(t, recorder.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+
+	actors := make([]string, len(lines))
+	for i, line := range lines {
+		var event audit.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		actors[i] = event.Actor
+	}
+	assert.Equal(t, []string{"User:alice", "anonymous@127.0.0.1"}, actors)
+}
@@ -43,6 +43,7 @@ import (
 	licensesvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/license"
 	monitoringsvcv1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/monitoring/v1"
 	quotasvcv1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/quota/v1"
+	secretsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/secret"
 	topicsvcv1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/topic/v1"
 	topicsvcv1alpha1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/topic/v1alpha1"
 	topicsvcv1alpha2 "github.com/redpanda-data/console/backend/pkg/api/connect/service/topic/v1alpha2"
@@ -81,9 +82,15 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	baseInterceptors := []connect.Interceptor{
 		observerInterceptor,
 		interceptor.NewErrorLogInterceptor(api.Logger),
+	}
+	if api.Auditor != nil {
+		// Audit before validating, so that rejected requests are recorded as well
+		baseInterceptors = append(baseInterceptors, interceptor.NewAuditInterceptor(api.Auditor))
+	}
+	baseInterceptors = append(baseInterceptors,
 		interceptor.NewRequestValidationInterceptor(v, loggerpkg.Named(api.Logger, "validator")),
 		interceptor.NewEndpointCheckInterceptor(&api.Cfg.Console.API, loggerpkg.Named(api.Logger, "endpoint_checker")),
-	}
+	)
 
 	api.Hooks.Route.InitConnectRPCRouter(r)
 
@@ -122,7 +129,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	var userSvcV1 dataplanev1connect.UserServiceHandler = apiusersvcv1.NewService(loggerpkg.Named(api.Logger, "user_service"), api.ConsoleSvc)
 	quotaSvcV1 := quotasvcv1.NewService(api.Cfg, loggerpkg.Named(api.Logger, "quota_service"), api.ConsoleSvc)
 	transformSvcV1 := transformsvcv1.NewService(api.Cfg, loggerpkg.Named(api.Logger, "transform_service"), v, api.RedpandaClientProvider)
-	kafkaConnectSvcV1 := apikafkaconnectsvcv1.NewService(api.Cfg, loggerpkg.Named(api.Logger, "kafka_connect_service"), api.ConnectSvc)
+	kafkaConnectSvcV1 := apikafkaconnectsvcv1.NewService(api.Cfg, loggerpkg.Named(api.Logger, "kafka_connect_service"), api.ConnectSvc, api.ConsoleSvc)
 	consoleTransformSvcV1 := &transformsvcv1.ConsoleService{Impl: transformSvcV1}
 	monitoringSvcV1 := monitoringsvcv1.NewService(api.Cfg, loggerpkg.Named(api.Logger, "monitoring_service"), api.RedpandaClientProvider)
 
@@ -147,6 +154,10 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	if err != nil {
 		loggerpkg.Fatal(api.Logger, "failed to create license service", slog.Any("error", err))
 	}
+	var consoleSecretSvc consolev1alpha1connect.SecretServiceHandler = consolev1alpha1connect.UnimplementedSecretServiceHandler{}
+	if api.ConnectSvc.Secrets != nil {
+		consoleSecretSvc = secretsvc.NewService(loggerpkg.Named(api.Logger, "secret_service"), api.ConnectSvc.Secrets)
+	}
 	clusterStatusSvc := clusterstatus.NewService(
 		api.Cfg,
 		loggerpkg.Named(api.Logger, "redpanda_cluster_status_service"),
@@ -172,7 +183,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 			consolev1alpha1connect.TransformServiceName:      consoleTransformSvcV1,
 			consolev1alpha1connect.AuthenticationServiceName: &AuthenticationDefaultHandler{},
 			consolev1alpha1connect.ClusterStatusServiceName:  clusterStatusSvc,
-			consolev1alpha1connect.SecretServiceName:         consolev1alpha1connect.UnimplementedSecretServiceHandler{},
+			consolev1alpha1connect.SecretServiceName:         consoleSecretSvc,
 			dataplanev1alpha2connect.ACLServiceName:          aclSvcV1alpha2,
 			dataplanev1alpha2connect.TopicServiceName:        topicSvcV1alpha2,
 			dataplanev1alpha2connect.UserServiceName:         userSvcV1alpha2,
@@ -191,6 +202,10 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 		},
 	})
 
+	// Attribute calls to the authenticated principal, after all interceptors
+	// that may authenticate the request have run.
+	hookOutput.Interceptors = append(hookOutput.Interceptors, interceptor.NewActorInterceptor(api.Hooks.Console.AuthenticatedPrincipal))
+
 	// rewrite local variables that may have been replaced in the hooks
 	// that we need to use later on in the function to register services
 	// TODO properly rewrite all variables
@@ -207,16 +222,27 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	if len(hookOutput.HTTPMiddlewares) > 0 {
 		r.Use(hookOutput.HTTPMiddlewares...)
 	}
+	r.Use(newAuthenticatedActorMiddleware(api.Hooks.Console.AuthenticatedPrincipal))
 
 	r.Mount("/v1alpha1", gwMux)
 	r.Mount("/v1alpha2", gwMux)
 	r.Mount("/v1alpha3", gwMux)
 	r.Mount("/v1", gwMux)
 
+	// These HTTP handlers bypass the Connect interceptors, hence they are audited
+	// by the HTTP middleware.
+	audited := r.With(newAuditMiddleware(api.Auditor))
+
 	// Wasm Transforms
-	r.Put("/v1alpha1/transforms", transformSvcV1alpha1.HandleDeployTransform())
-	r.Put("/v1alpha2/transforms", transformSvcV1alpha2.HandleDeployTransform())
-	r.Put("/v1/transforms", transformSvcV1.HandleDeployTransform())
+	audited.Put("/v1alpha1/transforms", transformSvcV1alpha1.HandleDeployTransform())
+	audited.Put("/v1alpha2/transforms", transformSvcV1alpha2.HandleDeployTransform())
+	audited.Put("/v1/transforms", transformSvcV1.HandleDeployTransform())
+
+	// ACLs as code
+	r.With(requirePermissionMiddleware(api.Logger, api.Hooks.Console.CanViewACLs, "export ACLs")).
+		Get("/v1/acls/export", aclSvcV1.HandleExportACLs())
+	audited.With(requirePermissionMiddleware(api.Logger, api.Hooks.Console.CanManageACLs, "import ACLs")).
+		Post("/v1/acls/import", aclSvcV1.HandleImportACLs())
 
 	// v1alpha1
 
@@ -522,6 +548,7 @@ func (api *API) routes() *chi.Mux {
 
 	baseRouter.Use(recoverer.Wrap)
 	baseRouter.Use(basePath.Wrap)
+	baseRouter.Use(setDefaultActorMiddleware)
 	baseRouter.Use(cors.Handler(cors.Options{
 		AllowOriginFunc: func(r *http.Request, _ string) bool {
 			isAllowed := checkOriginFn(r)
@@ -585,6 +612,9 @@ func (api *API) routes() *chi.Mux {
 			api.Hooks.Route.ConfigAPIRouter(r)
 
 			r.Route("/api", func(r chi.Router) {
+				r.Use(newAuthenticatedActorMiddleware(api.Hooks.Console.AuthenticatedPrincipal))
+				r.Use(newAuditMiddleware(api.Auditor))
+
 				// Overview
 				r.Get("/cluster", api.handleDescribeCluster())
 				r.Get("/brokers", api.handleGetBrokers())
@@ -593,6 +623,7 @@ func (api *API) routes() *chi.Mux {
 
 				// ACLs
 				r.Get("/acls", api.handleGetACLsOverview())
+				r.Post("/acls/simulate", api.handleSimulateACLPermissions())
 
 				// Topics
 				r.Get("/topics-configs", api.handleGetTopicsConfigs())
@@ -602,11 +633,14 @@ func (api *API) routes() *chi.Mux {
 				r.Post("/topics", api.handleCreateTopic())
 				r.Delete("/topics/{topicName}", api.handleDeleteTopic())
 				r.Delete("/topics/{topicName}/records", api.handleDeleteTopicRecords())
+				r.Post("/topics/{topicName}/generate-records", api.handleGenerateTopicRecords())
 				r.Get("/topics/{topicName}/partitions", api.handleGetPartitions())
 				r.Get("/topics/{topicName}/configuration", api.handleGetTopicConfig())
 				r.Patch("/topics/{topicName}/configuration", api.handleEditTopicConfig())
 				r.Get("/topics/{topicName}/consumers", api.handleGetTopicConsumers())
 				r.Get("/topics/{topicName}/documentation", api.handleGetTopicDocumentation())
+				r.Get("/topics/{topicName}/health", api.handleGetTopicHealth())
+				r.Get("/lineage", api.handleGetLineageGraph())
 
 				// Consumer Groups
 				r.Get("/consumer-groups", api.handleGetConsumerGroups())
@@ -617,6 +651,7 @@ func (api *API) routes() *chi.Mux {
 
 				// Bulk Operations
 				r.Get("/operations/topic-details", api.handleGetAllTopicDetails())
+				r.Get("/operations/topic-usage", api.handleGetTopicUsage())
 				r.Get("/operations/reassign-partitions", api.handleGetPartitionReassignments())
 				r.Patch("/operations/reassign-partitions", api.handlePatchPartitionAssignments())
 				r.Patch("/operations/configs", api.handlePatchConfigs())
@@ -637,30 +672,53 @@ func (api *API) routes() *chi.Mux {
 				r.Get("/schema-registry/schemas", api.handleGetAllSchemas())
 				r.Get("/schema-registry/schemas/types", api.handleGetSchemaRegistrySchemaTypes())
 				r.Get("/schema-registry/schemas/ids/{id}/versions", api.handleGetSchemaUsagesByID())
+				r.Get("/schema-registry/diff", api.handleGetSchemaDiff())
+				r.Get("/schema-registry/usage", api.handleGetSchemaUsage())
+				r.Post("/schema-registry/sync", api.handleSyncSchemaRegistry())
 				r.Delete("/schema-registry/subjects/{subject}", api.handleDeleteSubject())
 				r.Post("/schema-registry/subjects/{subject}/versions", api.handleCreateSchema())
 				r.Post("/schema-registry/subjects/{subject}/versions/{version}/validate", api.handleValidateSchema())
+				r.Post("/schema-registry/subjects/{subject}/compatibility", api.handleCheckSchemaCompatibility())
+				r.Get("/schema-registry/subjects/{subject}/usage", api.handleGetSubjectSchemaUsage())
 				r.Delete("/schema-registry/subjects/{subject}/versions/{version}", api.handleDeleteSubjectVersion())
 				r.Get("/schema-registry/subjects/{subject}/versions/{version}", api.handleGetSchemaSubjectDetails())
 				r.Get("/schema-registry/subjects/{subject}/versions/{version}/referencedby", api.handleGetSchemaReferencedBy())
 
 				// Kafka Connect
 				r.Get("/kafka-connect/connectors", api.handleGetConnectors())
+				r.Get("/kafka-connect/remediation-events", api.handleListRemediationEvents())
+				r.Get("/kafka-connect/plugin-catalog", api.handleGetConnectPluginCatalog())
+				r.Get("/kafka-connect/clusters", api.handleListConnectClusters())
+				r.Post("/kafka-connect/clusters", api.handleRegisterConnectCluster())
 				r.Get("/kafka-connect/clusters/{clusterName}", api.handleGetClusterInfo())
+				r.Put("/kafka-connect/clusters/{clusterName}", api.handleUpdateConnectCluster())
+				r.Delete("/kafka-connect/clusters/{clusterName}", api.handleRemoveConnectCluster())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors", api.handleGetClusterConnectors())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors", api.handleCreateConnector())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleGetConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handlePutConnectorConfig())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/config-history", api.handleListConnectorConfigVersions())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/config-history/diff", api.handleDiffConnectorConfigVersions())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/config-history/{version}/rollback", api.handleRollbackConnectorConfig())
 				r.Put("/kafka-connect/clusters/{clusterName}/connector-plugins/{pluginClassName}/config/validate", api.handlePutValidateConnectorConfig())
 				r.Delete("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleDeleteConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/pause", api.handlePauseConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/resume", api.handleResumeConnector())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/restart", api.handleRestartConnector())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/migrate", api.handleMigrateConnector())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleGetConnectorOffsets())
+				r.Patch("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleAlterConnectorOffsets())
+				r.Delete("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleResetConnectorOffsets())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dlq", api.handleGetConnectorDLQ())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dlq/replay", api.handleReplayConnectorDLQRecords())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/tasks/{taskID}/restart", api.handleRestartConnectorTask())
 
 				// Wasm Transforms
 				r.Put("/transforms", transformSvc.HandleDeployTransform())
 
+				// Audit Log
+				r.Get("/audit/events", api.handleQueryAuditEvents())
+
 				// Console Endpoints that inform which endpoints & features are available to the frontend.
 				r.Get("/console/endpoints", api.handleGetEndpoints())
 			})
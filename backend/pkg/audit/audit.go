@@ -0,0 +1,235 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package audit records a durable trail of all mutating API calls. Events are
+// created by the Connect interceptor and the HTTP middleware of the api package
+// and written to one or more sinks, such as a rotated file, a Kafka topic or
+// syslog.
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// writeTimeout is the max time for writing a single event to all sinks.
+const writeTimeout = 5 * time.Second
+
+// ErrQueryNotSupported is returned by Query if the Kafka sink is not enabled.
+var ErrQueryNotSupported = errors.New("querying the audit log requires the kafka sink to be enabled")
+
+// Outcome describes whether an audited call succeeded.
+type Outcome string
+
+const (
+	// OutcomeSuccess is recorded for calls that completed without an error.
+	OutcomeSuccess Outcome = "success"
+	// OutcomeFailure is recorded for calls that returned an error.
+	OutcomeFailure Outcome = "failure"
+)
+
+// Event is a single audited API call.
+type Event struct {
+	Time time.Time `json:"time"`
+	// Actor is the identity that issued the call, see package actor.
+	Actor         string `json:"actor"`
+	SourceAddress string `json:"sourceAddress,omitempty"`
+	// Protocol is "rest" for the REST API, or the protocol of Connect and
+	// gRPC gateway calls, such as "connect", "grpc" or "http".
+	Protocol string `json:"protocol"`
+	// Procedure is the full Connect procedure name, or the HTTP method and
+	// route pattern of REST calls.
+	Procedure string `json:"procedure"`
+	// Resources are the names and IDs of the resources that the call targets,
+	// keyed by the request field or URL parameter they were taken from.
+	Resources map[string]string `json:"resources,omitempty"`
+	// RequestDigest is the request body with all sensitive values redacted.
+	RequestDigest string  `json:"requestDigest,omitempty"`
+	Outcome       Outcome `json:"outcome"`
+	// Status is the Connect code or the HTTP status code.
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// Sink persists audit events.
+type Sink interface {
+	Write(ctx context.Context, event Event) error
+	Close() error
+}
+
+// Recorder redacts audit events and writes them to all configured sinks. Events
+// are queued and written in the background, so that slow sinks don't delay the
+// audited calls.
+type Recorder struct {
+	logger   *slog.Logger
+	sinks    []Sink
+	kafka    *KafkaSink
+	redactor *redactor
+
+	// mu guards closed, so that no event is queued after the queue is closed.
+	mu      sync.RWMutex
+	closed  bool
+	queue   chan Event
+	done    chan struct{}
+	dropped atomic.Uint64
+}
+
+// NewRecorder creates a recorder with the sinks that are enabled in cfg. The
+// Kafka clients are retrieved via getClients on each access, so that the
+// Kafka sink works with the same client factories as the rest of Console.
+func NewRecorder(cfg config.ConsoleAudit, logger *slog.Logger, getClients func(ctx context.Context) (*kgo.Client, *kadm.Client, error)) (*Recorder, error) {
+	r := &Recorder{
+		logger:   logger,
+		redactor: newRedactor(cfg.RedactedFields, cfg.MaxRequestDigestBytes),
+		queue:    make(chan Event, cfg.QueueSize),
+		done:     make(chan struct{}),
+	}
+	if cfg.File.Enabled {
+		r.sinks = append(r.sinks, NewFileSink(cfg.File))
+	}
+	if cfg.Kafka.Enabled {
+		r.kafka = NewKafkaSink(cfg.Kafka, getClients)
+		r.sinks = append(r.sinks, r.kafka)
+	}
+	if cfg.Syslog.Enabled {
+		sink, err := NewSyslogSink(cfg.Syslog)
+		if err != nil {
+			for _, sink := range r.sinks {
+				sink.Close()
+			}
+			return nil, fmt.Errorf("failed to create syslog sink: %w", err)
+		}
+		r.sinks = append(r.sinks, sink)
+	}
+	go r.run()
+	return r, nil
+}
+
+// Record queues the event to be written to all sinks. If the queue is full or
+// the recorder has been closed, the event is dropped and a warning is logged.
+func (r *Recorder) Record(ctx context.Context, event Event) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reason := "recorder is closed"
+	if !r.closed {
+		select {
+		case r.queue <- event:
+			return
+		default:
+			reason = "queue is full"
+		}
+	}
+	dropped := r.dropped.Add(1)
+	r.logger.WarnContext(ctx, "dropped audit event",
+		slog.String("reason", reason),
+		slog.String("procedure", event.Procedure),
+		slog.String("actor", event.Actor),
+		slog.Uint64("dropped_events", dropped))
+}
+
+// Dropped returns the number of events that have been dropped since startup.
+func (r *Recorder) Dropped() uint64 {
+	return r.dropped.Load()
+}
+
+// run writes the queued events until the queue is closed.
+func (r *Recorder) run() {
+	defer close(r.done)
+	for event := range r.queue {
+		r.write(event)
+	}
+}
+
+// write writes the event to all sinks. Failing sinks are logged, but don't
+// prevent the event from being written to the remaining sinks.
+func (r *Recorder) write(event Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			r.logger.ErrorContext(ctx, "failed to write audit event",
+				slog.String("procedure", event.Procedure),
+				slog.String("actor", event.Actor),
+				slog.Any("error", err))
+		}
+	}
+}
+
+// RequestDigest returns the redacted and possibly truncated JSON request body.
+func (r *Recorder) RequestDigest(body []byte) string {
+	return r.redactor.digest(body)
+}
+
+// Query returns the newest events of the Kafka sink that match q.
+func (r *Recorder) Query(ctx context.Context, q Query) (QueryResult, error) {
+	if r.kafka == nil {
+		return QueryResult{}, ErrQueryNotSupported
+	}
+	return r.kafka.Query(ctx, q)
+}
+
+// Close writes all queued events and closes all sinks. Events that are recorded
+// afterwards are dropped.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	<-r.done
+
+	var errs []error
+	for _, sink := range r.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// readOnlyMethodPrefixes are the prefixes of Connect methods that don't change
+// any state.
+var readOnlyMethodPrefixes = []string{
+	"Get", "List", "Describe", "Check", "Validate", "Simulate", "Export", "Lint", "Search", "Diff", "Preview",
+}
+
+// IsMutatingProcedure reports whether a Connect procedure may change state.
+// Procedures are considered mutating unless their method name has a read-only
+// prefix, so that new procedures are audited by default.
+func IsMutatingProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMutatingMethod reports whether an HTTP method may change state.
+func IsMutatingMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "TRACE":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestRedactorDigest(t *testing.T) {
+	r := newRedactor([]string{"record-value"}, 4096)
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "sensitive field names in any case and separator style",
+			body: `{"user":{"name":"alice","password":"p"},"saslPassword":"p","api_key":"k","SECRET":"s","mechanism":"SCRAM-SHA-256"}`,
+			want: `{"SECRET":"[REDACTED]","api_key":"[REDACTED]","mechanism":"SCRAM-SHA-256","saslPassword":"[REDACTED]","user":{"name":"alice","password":"[REDACTED]"}}`,
+		},
+		{
+			name: "name value pairs",
+			body: `{"configs":[{"key":"sasl.jaas.config","value":"secret"},{"name":"retention.ms","value":"1000"}]}`,
+			want: `{"configs":[{"key":"sasl.jaas.config","value":"[REDACTED]"},{"name":"retention.ms","value":"1000"}]}`,
+		},
+		{
+			name: "connector configs",
+			body: `{"connectorName":"sink","config":{"connection.url":"jdbc:postgresql://u:p@db/orders","topics":"orders"}}`,
+			want: `{"config":"[REDACTED]","connectorName":"sink"}`,
+		},
+		{
+			name: "nested connector configs",
+			body: `{"cluster_name":"redpanda","connector":{"name":"sink","config":{"ssl.keystore.certificate.chain":"c"}}}`,
+			want: `{"cluster_name":"redpanda","connector":{"config":"[REDACTED]","name":"sink"}}`,
+		},
+		{
+			name: "configured fields",
+			body: `{"recordValue":"pii","topic":"orders"}`,
+			want: `{"recordValue":"[REDACTED]","topic":"orders"}`,
+		},
+		{
+			name: "non-JSON content",
+			body: `password=p`,
+			want: `<10 bytes of non-JSON content>`,
+		},
+		{
+			name: "empty body",
+			body: ``,
+			want: ``,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.digest([]byte(tt.body)))
+		})
+	}
+
+	truncating := newRedactor(nil, 10)
+	assert.Equal(t, `{"topic":"...(truncated)`, truncating.digest([]byte(`{"topic":"orders"}`)))
+}
+
+func TestIsMutatingProcedure(t *testing.T) {
+	for procedure, mutating := range map[string]bool{
+		"/redpanda.api.dataplane.v1.TopicService/CreateTopic":             true,
+		"/redpanda.api.dataplane.v1.TopicService/DeleteTopic":             true,
+		"/redpanda.api.dataplane.v1.TopicService/SetTopicConfigurations":  true,
+		"/redpanda.api.dataplane.v1.TransformService/DeployTransform":     true,
+		"/redpanda.api.dataplane.v1.TopicService/ListTopics":              false,
+		"/redpanda.api.dataplane.v1.TopicService/GetTopicConfigurations":  false,
+		"/redpanda.api.console.v1alpha1.ConsoleService/ListMessages":      false,
+		"/redpanda.api.console.v1alpha1.SecurityService/CreateRoleMember": true,
+		"unknown": true,
+	} {
+		assert.Equal(t, mutating, IsMutatingProcedure(procedure), procedure)
+	}
+
+	assert.True(t, IsMutatingMethod("PATCH"))
+	assert.False(t, IsMutatingMethod("GET"))
+}
+
+func TestRecorderProtoResources(t *testing.T) {
+	recorder := &Recorder{redactor: newRedactor(nil, 4096)}
+
+	msg := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("order_id"),
+		TypeName: proto.String(".shop.Order"),
+		JsonName: proto.String("orderId"),
+		Number:   proto.Int32(1),
+		Options:  &descriptorpb.FieldOptions{Deprecated: proto.Bool(true)},
+	}
+	assert.Equal(t, map[string]string{
+		"name":      "order_id",
+		"type_name": ".shop.Order",
+		"json_name": "orderId",
+	}, recorder.ProtoResources(msg))
+	assert.Nil(t, recorder.ProtoResources(&descriptorpb.FieldOptions{}))
+
+	assert.Equal(t,
+		map[string]string{"topicName": "orders", "partitionId": "2"},
+		recorder.JSONResources([]byte(`{"topicName":"orders","partitionId":2,"tokenId":"t","count":5}`)))
+}
+
+func TestRecorderFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	cfg := config.ConsoleAudit{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.File.Enabled = true
+	cfg.File.Path = path
+	require.NoError(t, cfg.Validate())
+
+	recorder, err := NewRecorder(cfg, slog.New(slog.DiscardHandler), nil)
+	require.NoError(t, err)
+
+	events := []Event{
+		{
+			Time:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			Actor:     "alice",
+			Protocol:  "connect",
+			Procedure: "/redpanda.api.dataplane.v1.TopicService/DeleteTopic",
+			Resources: map[string]string{"name": "orders"},
+			Outcome:   OutcomeSuccess,
+			Status:    "ok",
+		},
+		{
+			Time:          time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC),
+			Actor:         "bob",
+			Protocol:      "rest",
+			Procedure:     "PATCH /api/topics/{topicName}/configuration",
+			RequestDigest: recorder.RequestDigest([]byte(`{"password":"p"}`)),
+			Outcome:       OutcomeFailure,
+			Status:        "403",
+			Error:         "forbidden",
+		},
+	}
+	for _, event := range events {
+		recorder.Record(t.Context(), event)
+	}
+	require.NoError(t, recorder.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var written []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		written = append(written, event)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, events, written)
+	assert.False(t, strings.Contains(written[1].RequestDigest, `"p"`))
+
+	_, err = recorder.Query(t.Context(), Query{})
+	assert.ErrorIs(t, err, ErrQueryNotSupported)
+}
+
+func TestKafkaSink(t *testing.T) {
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1))
+	require.NoError(t, err)
+	t.Cleanup(fakeCluster.Close)
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...))
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+	adminCl := kadm.NewClient(cl)
+
+	cfg := config.ConsoleAudit{}
+	cfg.SetDefaults()
+	cfg.Kafka.Enabled = true
+	cfg.Kafka.Retention = 7 * 24 * time.Hour
+	sink := NewKafkaSink(cfg.Kafka, func(context.Context) (*kgo.Client, *kadm.Client, error) {
+		return cl, adminCl, nil
+	})
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	old := Event{Time: now.Add(-2 * DefaultQueryLookback), Actor: "alice", Outcome: OutcomeSuccess}
+	recent := Event{Time: now.Add(-time.Minute), Actor: "bob", Outcome: OutcomeFailure}
+	require.NoError(t, sink.Write(t.Context(), old))
+	require.NoError(t, sink.Write(t.Context(), recent))
+
+	t.Run("topic is created with the configured configs", func(t *testing.T) {
+		configs, err := adminCl.DescribeTopicConfigs(t.Context(), cfg.Kafka.Topic)
+		require.NoError(t, err)
+		topicConfigs, err := configs.On(cfg.Kafka.Topic, nil)
+		require.NoError(t, err)
+
+		values := make(map[string]string)
+		for _, c := range topicConfigs.Configs {
+			values[c.Key] = c.MaybeValue()
+		}
+		assert.Equal(t, "604800000", values["retention.ms"])
+		assert.Equal(t, "delete", values["cleanup.policy"])
+	})
+
+	t.Run("query without from is bounded by the default lookback", func(t *testing.T) {
+		res, err := sink.Query(t.Context(), Query{})
+		require.NoError(t, err)
+		assert.False(t, res.Partial)
+		assert.Equal(t, []Event{recent}, res.Events)
+	})
+
+	t.Run("query with from reads older events", func(t *testing.T) {
+		res, err := sink.Query(t.Context(), Query{From: old.Time})
+		require.NoError(t, err)
+		assert.Equal(t, []Event{recent, old}, res.Events)
+	})
+}
+
+func TestQueryMatches(t *testing.T) {
+	event := Event{
+		Time:      time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		Actor:     "alice",
+		Procedure: "/redpanda.api.dataplane.v1.TopicService/DeleteTopic",
+		Resources: map[string]string{"name": "orders"},
+		Outcome:   OutcomeSuccess,
+	}
+
+	for _, tt := range []struct {
+		query Query
+		want  bool
+	}{
+		{Query{}, true},
+		{Query{Actor: "alice", Procedure: "deletetopic", Resource: "orders", Outcome: OutcomeSuccess}, true},
+		{Query{From: event.Time.Add(-time.Hour), To: event.Time}, true},
+		{Query{From: event.Time.Add(time.Second)}, false},
+		{Query{To: event.Time.Add(-time.Second)}, false},
+		{Query{Actor: "bob"}, false},
+		{Query{Procedure: "CreateTopic"}, false},
+		{Query{Resource: "payments"}, false},
+		{Query{Outcome: OutcomeFailure}, false},
+	} {
+		assert.Equal(t, tt.want, tt.query.matches(event), "%+v", tt.query)
+	}
+}
+
+// blockingSink blocks all writes until unblock is closed.
+type blockingSink struct {
+	unblock chan struct{}
+	written []Event
+}
+
+func (s *blockingSink) Write(_ context.Context, event Event) error {
+	<-s.unblock
+	s.written = append(s.written, event)
+	return nil
+}
+
+func (*blockingSink) Close() error {
+	return nil
+}
+
+func TestRecorderDropsEventsIfQueueIsFull(t *testing.T) {
+	cfg := config.ConsoleAudit{}
+	cfg.SetDefaults()
+	cfg.QueueSize = 1
+
+	recorder, err := NewRecorder(cfg, slog.New(slog.DiscardHandler), nil)
+	require.NoError(t, err)
+	sink := &blockingSink{unblock: make(chan struct{})}
+	recorder.sinks = []Sink{sink}
+
+	// The first event is written and blocks the sink, the second one is queued
+	recorder.Record(t.Context(), Event{Procedure: "first"})
+	require.Eventually(t, func() bool { return len(recorder.queue) == 0 }, 5*time.Second, time.Millisecond)
+	recorder.Record(t.Context(), Event{Procedure: "second"})
+	recorder.Record(t.Context(), Event{Procedure: "dropped"})
+	assert.Equal(t, uint64(1), recorder.Dropped())
+
+	// Close waits until all queued events are written
+	close(sink.unblock)
+	require.NoError(t, recorder.Close())
+	assert.Equal(t, []Event{{Procedure: "first"}, {Procedure: "second"}}, sink.written)
+
+	recorder.Record(t.Context(), Event{Procedure: "closed"})
+	assert.Equal(t, uint64(2), recorder.Dropped())
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactedValue replaces the values of sensitive fields.
+const redactedValue = "[REDACTED]"
+
+// sensitiveFieldParts mark a field as sensitive if its normalized name
+// contains any of them.
+var sensitiveFieldParts = []string{
+	"password", "passwd", "passphrase", "secret", "token", "credential",
+	"privatekey", "apikey", "accesskey", "authorization", "jaas",
+}
+
+// connectorConfigField is the field that holds the properties of a connector
+// in create and update requests. Sensitive connector properties can have
+// arbitrary names, so the whole object is redacted.
+const connectorConfigField = "config"
+
+// maxResourceDepth is the depth of nested messages in which resource names
+// are searched for, such as topic.name in a CreateTopicRequest.
+const maxResourceDepth = 2
+
+type redactor struct {
+	extraFields    map[string]struct{}
+	maxDigestBytes int
+}
+
+func newRedactor(extraFields []string, maxDigestBytes int) *redactor {
+	r := &redactor{extraFields: make(map[string]struct{}), maxDigestBytes: maxDigestBytes}
+	for _, field := range extraFields {
+		r.extraFields[normalizeFieldName(field)] = struct{}{}
+	}
+	return r
+}
+
+// digest redacts all sensitive values of a JSON document. Documents that
+// aren't JSON are not recorded, as their content can't be redacted.
+func (r *redactor) digest(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON content>", len(body))
+	}
+	redacted, err := json.Marshal(r.redact(doc))
+	if err != nil {
+		return fmt.Sprintf("<failed to encode redacted request: %v>", err)
+	}
+	if len(redacted) <= r.maxDigestBytes {
+		return string(redacted)
+	}
+	return strings.ToValidUTF8(string(redacted[:r.maxDigestBytes]), "") + "...(truncated)"
+}
+
+// redact replaces the values of sensitive fields. Besides field names, it
+// checks name/value pairs such as [{"name": "sasl.password", "value": "..."}],
+// which are used for configs. Connector configs are redacted entirely.
+func (r *redactor) redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		redactValue := false
+		for _, key := range []string{"name", "key"} {
+			if name, ok := v[key].(string); ok && r.isSensitive(name) {
+				redactValue = true
+			}
+		}
+		for key, value := range v {
+			switch {
+			case r.isSensitive(key):
+				out[key] = redactedValue
+			case key == connectorConfigField && isObject(value):
+				out[key] = redactedValue
+			case redactValue && key == "value":
+				out[key] = redactedValue
+			default:
+				out[key] = r.redact(value)
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = r.redact(item)
+		}
+		return out
+	}
+	return v
+}
+
+func isObject(v any) bool {
+	_, ok := v.(map[string]any)
+	return ok
+}
+
+func (r *redactor) isSensitive(field string) bool {
+	normalized := normalizeFieldName(field)
+	if _, ok := r.extraFields[normalized]; ok {
+		return true
+	}
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(normalized, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// protoDigest returns the digest of the message's JSON encoding.
+func (r *redactor) protoDigest(msg proto.Message) string {
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Sprintf("<failed to encode request: %v>", err)
+	}
+	return r.digest(body)
+}
+
+// ProtoResources returns the names and IDs that a request message targets.
+// These are the populated string fields called name or id, or ending with
+// _name or _id, plus principals. Nested messages are searched up to
+// maxResourceDepth, with the keys joined by dots.
+func (r *Recorder) ProtoResources(msg proto.Message) map[string]string {
+	resources := make(map[string]string)
+	r.collectProtoResources(msg.ProtoReflect(), "", 0, resources)
+	if len(resources) == 0 {
+		return nil
+	}
+	return resources
+}
+
+// ProtoDigest returns the redacted and possibly truncated JSON encoding of
+// the request message.
+func (r *Recorder) ProtoDigest(msg proto.Message) string {
+	return r.redactor.protoDigest(msg)
+}
+
+func (r *Recorder) collectProtoResources(msg protoreflect.Message, prefix string, depth int, resources map[string]string) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(field.Name())
+		if field.IsList() || field.IsMap() || r.redactor.isSensitive(name) {
+			return true
+		}
+		switch field.Kind() {
+		case protoreflect.MessageKind:
+			if depth < maxResourceDepth {
+				r.collectProtoResources(value.Message(), prefix+name+".", depth+1, resources)
+			}
+		case protoreflect.StringKind:
+			if isResourceField(name) && value.String() != "" {
+				resources[prefix+name] = value.String()
+			}
+		case protoreflect.Int32Kind, protoreflect.Int64Kind:
+			if isResourceField(name) {
+				resources[prefix+name] = strconv.FormatInt(value.Int(), 10)
+			}
+		default:
+		}
+		return true
+	})
+}
+
+// JSONResources returns the top-level fields of a JSON object request body
+// that name a resource, see ProtoResources.
+func (r *Recorder) JSONResources(body []byte) map[string]string {
+	var doc map[string]any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil
+	}
+	resources := make(map[string]string)
+	for key, value := range doc {
+		if !isResourceField(key) || r.redactor.isSensitive(key) {
+			continue
+		}
+		switch value := value.(type) {
+		case string:
+			if value != "" {
+				resources[key] = value
+			}
+		case float64:
+			resources[key] = strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	return resources
+}
+
+// isResourceField reports whether a snake_case or camelCase field name refers
+// to the name or ID of a resource.
+func isResourceField(name string) bool {
+	switch name {
+	case "name", "id", "principal":
+		return true
+	}
+	for _, suffix := range []string{"_name", "_id", "Name", "Id", "ID"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeFieldName lowercases the name and removes all separators, so that
+// sasl_password, saslPassword and sasl.password are treated alike.
+func normalizeFieldName(name string) string {
+	var b strings.Builder
+	for _, c := range name {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			b.WriteRune(unicode.ToLower(c))
+		}
+	}
+	return b.String()
+}
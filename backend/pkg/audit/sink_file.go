@@ -0,0 +1,59 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes events as JSON lines into a file. Once the file exceeds the
+// configured size, it is renamed with a timestamp suffix and a new file is
+// started. Only the configured number of rotated files is retained.
+type FileSink struct {
+	out *lumberjack.Logger
+}
+
+// NewFileSink creates a file sink. The file and its directory are created on
+// the first write.
+func NewFileSink(cfg config.ConsoleAuditFileSink) *FileSink {
+	return &FileSink{
+		out: &lumberjack.Logger{
+			Filename:   cfg.Path,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			Compress:   cfg.Compress,
+		},
+	}
+}
+
+// Write appends the event to the file.
+func (f *FileSink) Write(_ context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	if _, err := f.out.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit event to file: %w", err)
+	}
+	return nil
+}
+
+// Close the current file.
+func (f *FileSink) Close() error {
+	return f.out.Close()
+}
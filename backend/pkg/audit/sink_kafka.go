@@ -0,0 +1,277 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var _ Sink = (*KafkaSink)(nil)
+
+const (
+	// kafkaQueryTimeout is the maximum duration for reading the audit topic.
+	// Queries that take longer return the events read so far.
+	kafkaQueryTimeout = 30 * time.Second
+	// DefaultQueryLookback is the time range before Query.To, or now, that is
+	// queried if Query.From is not set.
+	DefaultQueryLookback = 24 * time.Hour
+	// DefaultQueryLimit is the number of events a query returns by default.
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the max number of events a single query returns.
+	MaxQueryLimit = 1000
+)
+
+// Query filters the events of the Kafka sink. Empty fields match all events,
+// except for From which defaults to DefaultQueryLookback before To.
+type Query struct {
+	From time.Time
+	To   time.Time
+	// Actor must match exactly.
+	Actor string
+	// Procedure matches all procedures that contain it, ignoring case.
+	Procedure string
+	// Resource matches all events that target a resource with this name or ID.
+	Resource string
+	Outcome  Outcome
+	// Limit is the max number of returned events, which are the newest ones.
+	Limit int
+}
+
+// QueryResult contains the events that matched a query, newest first.
+type QueryResult struct {
+	Events []Event `json:"events"`
+	// Partial is true if reading the audit topic timed out and only the
+	// events that have been read until then were considered.
+	Partial bool `json:"partial"`
+}
+
+func (q *Query) matches(event Event) bool {
+	switch {
+	case !q.From.IsZero() && event.Time.Before(q.From):
+		return false
+	case !q.To.IsZero() && event.Time.After(q.To):
+		return false
+	case q.Actor != "" && event.Actor != q.Actor:
+		return false
+	case q.Procedure != "" && !strings.Contains(strings.ToLower(event.Procedure), strings.ToLower(q.Procedure)):
+		return false
+	case q.Outcome != "" && event.Outcome != q.Outcome:
+		return false
+	}
+	if q.Resource == "" {
+		return true
+	}
+	for _, value := range event.Resources {
+		if value == q.Resource {
+			return true
+		}
+	}
+	return false
+}
+
+// KafkaSink produces events to a topic, keyed by actor. The topic is the only
+// sink that can be queried.
+type KafkaSink struct {
+	cfg        config.ConsoleAuditKafkaSink
+	getClients func(ctx context.Context) (*kgo.Client, *kadm.Client, error)
+
+	mu           sync.Mutex
+	topicEnsured bool
+}
+
+// NewKafkaSink creates a sink that produces events to the configured topic.
+func NewKafkaSink(cfg config.ConsoleAuditKafkaSink, getClients func(ctx context.Context) (*kgo.Client, *kadm.Client, error)) *KafkaSink {
+	return &KafkaSink{
+		cfg:        cfg,
+		getClients: getClients,
+	}
+}
+
+// Write produces the event and waits until it has been acknowledged.
+func (k *KafkaSink) Write(ctx context.Context, event Event) error {
+	cl, adminCl, err := k.getClients(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.ensureTopic(ctx, adminCl); err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	record := &kgo.Record{
+		Topic:     k.cfg.Topic,
+		Key:       []byte(event.Actor),
+		Value:     value,
+		Timestamp: event.Time,
+	}
+	if err := cl.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce audit event: %w", err)
+	}
+	return nil
+}
+
+// Close does nothing, as the clients are owned by the client factory.
+func (*KafkaSink) Close() error {
+	return nil
+}
+
+// ensureTopic creates the audit topic if it does not exist yet.
+func (k *KafkaSink) ensureTopic(ctx context.Context, adminCl *kadm.Client) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.topicEnsured {
+		return nil
+	}
+	res, err := adminCl.CreateTopic(ctx, 1, -1, nil, k.cfg.Topic)
+	if err == nil {
+		err = res.Err
+	}
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create audit topic %q: %w", k.cfg.Topic, err)
+	}
+	k.topicEnsured = true
+	return nil
+}
+
+// topicConfigs returns the configs that the audit topic is created with.
+func (k *KafkaSink) topicConfigs() map[string]*string {
+	return map[string]*string{
+		"retention.ms":   kadm.StringPtr(strconv.FormatInt(k.cfg.Retention.Milliseconds(), 10)),
+		"cleanup.policy": kadm.StringPtr(k.cfg.CleanupPolicy),
+	}
+}
+
+// Query consumes the audit topic from q.From up to the current end offsets
+// and returns the newest matching events, newest first. If q.From is not set,
+// only the DefaultQueryLookback before q.To is read. If reading the topic
+// takes longer than kafkaQueryTimeout, the events read so far are returned as
+// a partial result.
+func (k *KafkaSink) Query(ctx context.Context, q Query) (QueryResult, error) {
+	if q.Limit <= 0 {
+		q.Limit = DefaultQueryLimit
+	}
+	q.Limit = min(q.Limit, MaxQueryLimit)
+	if q.From.IsZero() {
+		to := q.To
+		if to.IsZero() {
+			to = time.Now()
+		}
+		q.From = to.Add(-DefaultQueryLookback)
+	}
+
+	cl, adminCl, err := k.getClients(ctx)
+	if err != nil {
+		return QueryResult{}, err
+	}
+
+	startOffsets, err := adminCl.ListOffsetsAfterMilli(ctx, q.From.UnixMilli(), k.cfg.Topic)
+	if err == nil {
+		err = startOffsets.Error()
+	}
+	if errors.Is(err, kerr.UnknownTopicOrPartition) {
+		return QueryResult{Events: []Event{}}, nil
+	}
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("failed to list start offsets of audit topic: %w", err)
+	}
+	endOffsets, err := adminCl.ListEndOffsets(ctx, k.cfg.Topic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("failed to list end offsets of audit topic: %w", err)
+	}
+
+	partitionOffsets := make(map[int32]kgo.Offset)
+	remaining := make(map[int32]int64)
+	startOffsets.Each(func(o kadm.ListedOffset) {
+		end, ok := endOffsets.Lookup(k.cfg.Topic, o.Partition)
+		if ok && o.Offset >= 0 && o.Offset < end.Offset {
+			partitionOffsets[o.Partition] = kgo.NewOffset().At(o.Offset)
+			remaining[o.Partition] = end.Offset
+		}
+	})
+	if len(remaining) == 0 {
+		return QueryResult{Events: []Event{}}, nil
+	}
+
+	consumer, err := kgo.NewClient(append(cl.Opts(),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{k.cfg.Topic: partitionOffsets}),
+		kgo.KeepControlRecords(),
+	)...)
+	if err != nil {
+		return QueryResult{}, fmt.Errorf("failed to create consumer for audit topic: %w", err)
+	}
+	defer consumer.Close()
+
+	readCtx, cancel := context.WithTimeout(ctx, kafkaQueryTimeout)
+	defer cancel()
+
+	events := []Event{}
+	partial := false
+	for len(remaining) > 0 {
+		fetches := consumer.PollFetches(readCtx)
+		if ctx.Err() != nil {
+			return QueryResult{}, fmt.Errorf("failed to read audit topic: %w", ctx.Err())
+		}
+		if readCtx.Err() != nil {
+			partial = true
+			break
+		}
+		if errs := fetches.Errors(); len(errs) > 0 {
+			return QueryResult{}, fmt.Errorf("failed to read audit topic: %w", errs[0].Err)
+		}
+		fetches.EachRecord(func(r *kgo.Record) {
+			if r.Offset >= remaining[r.Partition]-1 {
+				delete(remaining, r.Partition)
+			}
+			if r.Attrs.IsControl() {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal(r.Value, &event); err != nil || !q.matches(event) {
+				return
+			}
+			events = append(events, event)
+		})
+		// Only retain the newest events to bound memory usage of wide queries
+		if len(events) > 2*q.Limit {
+			events = newestEvents(events, q.Limit)
+		}
+	}
+
+	return QueryResult{Events: newestEvents(events, q.Limit), Partial: partial}, nil
+}
+
+// newestEvents sorts the events newest first and returns at most limit events.
+func newestEvents(events []Event, limit int) []Event {
+	slices.SortStableFunc(events, func(a, b Event) int {
+		return b.Time.Compare(a.Time)
+	})
+	return events[:min(len(events), limit)]
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+//go:build !windows
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var _ Sink = (*SyslogSink)(nil)
+
+// SyslogSink sends events as JSON messages with the auth facility to a syslog
+// daemon. Failed calls are sent with warning severity, all others as notices.
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogSink connects to the configured syslog daemon.
+func NewSyslogSink(cfg config.ConsoleAuditSyslogSink) (*SyslogSink, error) {
+	w, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_AUTH|syslog.LOG_NOTICE, cfg.Tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+// Write sends the event to the syslog daemon.
+func (s *SyslogSink) Write(_ context.Context, event Event) error {
+	msg, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	if event.Outcome == OutcomeFailure {
+		err = s.w.Warning(string(msg))
+	} else {
+		err = s.w.Notice(string(msg))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send audit event to syslog: %w", err)
+	}
+	return nil
+}
+
+// Close the connection to the syslog daemon.
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
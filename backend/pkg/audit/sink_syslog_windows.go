@@ -0,0 +1,37 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var _ Sink = (*SyslogSink)(nil)
+
+// SyslogSink is not supported on Windows, because log/syslog isn't.
+type SyslogSink struct{}
+
+// NewSyslogSink always returns an error on Windows.
+func NewSyslogSink(config.ConsoleAuditSyslogSink) (*SyslogSink, error) {
+	return nil, errors.New("the syslog sink is not supported on windows")
+}
+
+// Write is never called, as the sink can't be created.
+func (*SyslogSink) Write(context.Context, Event) error {
+	return errors.New("the syslog sink is not supported on windows")
+}
+
+// Close does nothing.
+func (*SyslogSink) Close() error {
+	return nil
+}
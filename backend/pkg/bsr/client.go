@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	modulev1connect "buf.build/gen/go/bufbuild/registry/connectrpc/go/buf/registry/module/v1/modulev1connect"
@@ -183,12 +184,82 @@ func (c *Client) fetchFromBSR(ctx context.Context, messageName, commit string) (
 		return nil, nil, fmt.Errorf("failed to call BSR API: %w", err)
 	}
 
-	if resp.Msg == nil || resp.Msg.FileDescriptorSet == nil {
+	linkerFiles, protoFiles, err := c.toLinkerFiles(resp.Msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Find the message descriptor by fully qualified name
+	messageDesc, err := findMessageDescriptor(protoFiles, messageName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find message descriptor for %q: %w", messageName, err)
+	}
+
+	return linkerFiles, messageDesc, nil
+}
+
+// GetModuleFile fetches the file with the given path from a BSR module. The
+// module is referenced as owner/module and optionally followed by :label,
+// otherwise the default label is used. This allows schemas from other sources
+// to import files that are published on the BSR.
+func (c *Client) GetModuleFile(ctx context.Context, module, path string) (protoreflect.FileDescriptor, error) {
+	name, label, _ := strings.Cut(module, ":")
+	owner, moduleName, found := strings.Cut(name, "/")
+	if !found {
+		return nil, fmt.Errorf("invalid module %q, must be in the format owner/module[:label]", module)
+	}
+
+	// Modules are cached next to messages, the prefix avoids collisions with message names
+	entry, err, _ := c.cache.Get("module:"+module, func() (*bsrCacheEntry, error) {
+		files, fetchErr := c.fetchModuleFromBSR(ctx, owner, moduleName, label)
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return &bsrCacheEntry{files: files}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	file := entry.files.FindFileByPath(path)
+	if file == nil {
+		return nil, fmt.Errorf("file %q not found in module %q", path, module)
+	}
+	return file, nil
+}
+
+// fetchModuleFromBSR fetches the file descriptor set of all files of a module,
+// including its dependencies, from BSR via the Connect API.
+func (c *Client) fetchModuleFromBSR(ctx context.Context, owner, module, label string) (linker.Files, error) {
+	ref := &modulev1.ResourceRef_Name{Owner: owner, Module: module}
+	if label != "" {
+		ref.Child = &modulev1.ResourceRef_Name_LabelName{LabelName: label}
+	}
+	req := connect.NewRequest(&modulev1.GetFileDescriptorSetRequest{
+		ResourceRef: &modulev1.ResourceRef{
+			Value: &modulev1.ResourceRef_Name_{Name: ref},
+		},
+	})
+
+	resp, err := c.fileDescriptorSetClient.GetFileDescriptorSet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call BSR API: %w", err)
+	}
+
+	linkerFiles, _, err := c.toLinkerFiles(resp.Msg)
+	return linkerFiles, err
+}
+
+// toLinkerFiles converts the file descriptor set of a BSR response to
+// linker.Files, which provide resolvers, and protoregistry.Files, which allow
+// looking up descriptors by name.
+func (c *Client) toLinkerFiles(resp *modulev1.GetFileDescriptorSetResponse) (linker.Files, *protoregistry.Files, error) {
+	if resp == nil || resp.FileDescriptorSet == nil {
 		return nil, nil, errors.New("BSR returned empty response")
 	}
 
 	// Convert FileDescriptorSet to protoregistry.Files
-	protoFiles, err := protodesc.NewFiles(resp.Msg.FileDescriptorSet)
+	protoFiles, err := protodesc.NewFiles(resp.FileDescriptorSet)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create proto files from descriptor set: %w", err)
 	}
@@ -209,13 +280,7 @@ func (c *Client) fetchFromBSR(ctx context.Context, messageName, commit string) (
 		return nil, nil, errors.New("no valid files in descriptor set")
 	}
 
-	// Find the message descriptor by fully qualified name
-	messageDesc, err := findMessageDescriptor(protoFiles, messageName)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to find message descriptor for %q: %w", messageName, err)
-	}
-
-	return linkerFiles, messageDesc, nil
+	return linkerFiles, protoFiles, nil
 }
 
 // findMessageDescriptor searches for a message descriptor by fully qualified name.
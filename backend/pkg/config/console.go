@@ -22,12 +22,18 @@ const DefaultMaxDeserializationPayloadSize = 20_480 // 20 KB
 type Console struct {
 	TopicDocumentation ConsoleTopicDocumentation `yaml:"topicDocumentation"`
 	API                ConsoleAPI                `yaml:"api"`
+	MessageSearch      ConsoleMessageSearch      `yaml:"messageSearch"`
+	SchemaUsage        ConsoleSchemaUsage        `yaml:"schemaUsage"`
+	Audit              ConsoleAudit              `yaml:"audit"`
 }
 
 // SetDefaults for Console configs.
 func (c *Console) SetDefaults() {
 	c.TopicDocumentation.SetDefaults()
 	c.API.SetDefaults()
+	c.MessageSearch.SetDefaults()
+	c.SchemaUsage.SetDefaults()
+	c.Audit.SetDefaults()
 }
 
 // RegisterFlags for sensitive Console configurations.
@@ -45,5 +51,17 @@ func (c *Console) Validate() error {
 		return fmt.Errorf("failed to validate API config: %w", err)
 	}
 
+	if err := c.MessageSearch.Validate(); err != nil {
+		return fmt.Errorf("failed to validate message search config: %w", err)
+	}
+
+	if err := c.SchemaUsage.Validate(); err != nil {
+		return fmt.Errorf("failed to validate schema usage config: %w", err)
+	}
+
+	if err := c.Audit.Validate(); err != nil {
+		return fmt.Errorf("failed to validate audit config: %w", err)
+	}
+
 	return nil
 }
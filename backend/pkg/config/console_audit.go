@@ -0,0 +1,141 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ConsoleAudit configures the audit log. If enabled, every mutating REST and
+// Connect API call is recorded with the acting identity, the target resources,
+// a redacted digest of the request and its outcome. Events are written to all
+// enabled sinks.
+type ConsoleAudit struct {
+	Enabled bool                   `yaml:"enabled"`
+	File    ConsoleAuditFileSink   `yaml:"file"`
+	Kafka   ConsoleAuditKafkaSink  `yaml:"kafka"`
+	Syslog  ConsoleAuditSyslogSink `yaml:"syslog"`
+	// RedactedFields are additional request field names whose values are
+	// removed from the request digest. Fields that look like passwords, secrets,
+	// tokens or keys and connector configs are always redacted.
+	RedactedFields []string `yaml:"redactedFields"`
+	// MaxRequestDigestBytes is the max size of the recorded request digest.
+	// Larger digests are truncated.
+	MaxRequestDigestBytes int `yaml:"maxRequestDigestBytes"`
+	// QueueSize is the number of events that are buffered while they are
+	// written to the sinks in the background. Events are dropped if the
+	// queue is full.
+	QueueSize int `yaml:"queueSize"`
+}
+
+// ConsoleAuditFileSink writes audit events as JSON lines into a local file
+// that is rotated once it exceeds a size.
+type ConsoleAuditFileSink struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+	// MaxSizeMB is the size after which the file is rotated.
+	MaxSizeMB int `yaml:"maxSizeMb"`
+	// MaxBackups is the number of rotated files that are retained.
+	MaxBackups int `yaml:"maxBackups"`
+	// Compress rotated files with gzip.
+	Compress bool `yaml:"compress"`
+}
+
+// ConsoleAuditKafkaSink produces audit events to a Kafka topic. This sink
+// is required to query the audit log via the API.
+type ConsoleAuditKafkaSink struct {
+	Enabled bool `yaml:"enabled"`
+	// Topic is created on first use, if it does not exist.
+	Topic string `yaml:"topic"`
+	// Retention is set as retention.ms when the topic is created. Events
+	// older than this can no longer be queried.
+	Retention time.Duration `yaml:"retention"`
+	// CleanupPolicy is set as cleanup.policy when the topic is created.
+	// Events are keyed by actor, so compaction only retains the newest
+	// event of each actor.
+	CleanupPolicy string `yaml:"cleanupPolicy"`
+}
+
+// ConsoleAuditSyslogSink sends audit events to a syslog daemon.
+type ConsoleAuditSyslogSink struct {
+	Enabled bool `yaml:"enabled"`
+	// Network and Address of the syslog daemon, such as "udp" and
+	// "localhost:514". If both are empty, the local syslog daemon is used.
+	Network string `yaml:"network"`
+	Address string `yaml:"address"`
+	Tag     string `yaml:"tag"`
+}
+
+// SetDefaults for the audit log.
+func (c *ConsoleAudit) SetDefaults() {
+	c.MaxRequestDigestBytes = 4096
+	c.QueueSize = 1024
+	c.File.MaxSizeMB = 100
+	c.File.MaxBackups = 10
+	c.Kafka.Topic = "_redpanda.console.audit-log"
+	c.Kafka.Retention = 90 * 24 * time.Hour
+	c.Kafka.CleanupPolicy = "delete"
+	c.Syslog.Tag = "redpanda-console"
+}
+
+// Validate the audit log configuration.
+func (c *ConsoleAudit) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.File.Enabled && !c.Kafka.Enabled && !c.Syslog.Enabled {
+		return errors.New("at least one sink must be enabled")
+	}
+	if c.MaxRequestDigestBytes <= 0 {
+		return errors.New("maxRequestDigestBytes must be greater than 0")
+	}
+	if c.QueueSize <= 0 {
+		return errors.New("queueSize must be greater than 0")
+	}
+
+	if c.File.Enabled {
+		if c.File.Path == "" {
+			return errors.New("a path must be set if the file sink is enabled")
+		}
+		if c.File.MaxSizeMB <= 0 {
+			return errors.New("file.maxSizeMb must be greater than 0")
+		}
+		if c.File.MaxBackups < 0 {
+			return errors.New("file.maxBackups must not be negative")
+		}
+	}
+	if c.Kafka.Enabled {
+		if c.Kafka.Topic == "" {
+			return errors.New("a topic must be set if the kafka sink is enabled")
+		}
+		if c.Kafka.Retention < time.Millisecond {
+			return errors.New("kafka.retention must be at least 1ms")
+		}
+		switch c.Kafka.CleanupPolicy {
+		case "delete", "compact", "compact,delete", "delete,compact":
+		default:
+			return fmt.Errorf("kafka cleanup policy %q is invalid", c.Kafka.CleanupPolicy)
+		}
+	}
+	if c.Syslog.Enabled {
+		switch c.Syslog.Network {
+		case "", "tcp", "tcp4", "tcp6", "udp", "udp4", "udp6", "unix", "unixgram":
+		default:
+			return fmt.Errorf("syslog network %q is invalid", c.Syslog.Network)
+		}
+		if (c.Syslog.Network == "") != (c.Syslog.Address == "") {
+			return errors.New("syslog network and address must either both be set or both be empty")
+		}
+	}
+
+	return nil
+}
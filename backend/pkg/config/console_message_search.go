@@ -0,0 +1,71 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"runtime"
+)
+
+// ConsoleMessageSearch configures the pipeline that fetches, deserializes and
+// filters records when listing messages of a topic.
+//
+// The limits apply to each search and can't be set per request. They exist to
+// bound the CPU and memory that a single search may use, which only the operator
+// can decide on. A client has no reason to choose lower limits: messages are
+// returned in the same order regardless of the number of workers, and the number
+// of returned messages is already limited by the message count or page size of
+// the request. Raising the limits per request must not be possible either.
+type ConsoleMessageSearch struct {
+	// MaxWorkers is the maximum number of goroutines that deserialize and filter
+	// records for a single search. The number of workers is additionally limited
+	// by GOMAXPROCS. Zero means GOMAXPROCS workers.
+	MaxWorkers int `yaml:"maxWorkers"`
+
+	// MaxBufferedMessages is the number of fetched records that may be in flight
+	// between the Kafka consumer and the response of a single search. Once this
+	// many records are buffered, fetching pauses until the response has caught up.
+	// It is raised to the number of workers if it is lower.
+	MaxBufferedMessages int `yaml:"maxBufferedMessages"`
+}
+
+// SetDefaults for ConsoleMessageSearch.
+func (c *ConsoleMessageSearch) SetDefaults() {
+	c.MaxWorkers = 0
+	// Low enough to limit memory usage in serverless environments.
+	// With large records (up to 1MB), 100 records = 1GB+ after deserialization
+	c.MaxBufferedMessages = 20
+}
+
+// Validate the message search config.
+func (c *ConsoleMessageSearch) Validate() error {
+	if c.MaxWorkers < 0 {
+		return errors.New("maxWorkers must not be negative")
+	}
+	if c.MaxBufferedMessages <= 0 {
+		return errors.New("maxBufferedMessages must be greater than 0")
+	}
+	return nil
+}
+
+// WorkerCount returns the number of workers to use for a single search.
+func (c *ConsoleMessageSearch) WorkerCount() int {
+	workers := runtime.GOMAXPROCS(0)
+	if c.MaxWorkers > 0 && c.MaxWorkers < workers {
+		workers = c.MaxWorkers
+	}
+	return workers
+}
+
+// BufferSize returns the number of records that may be in flight for a single
+// search with the given number of workers.
+func (c *ConsoleMessageSearch) BufferSize(workerCount int) int {
+	return max(c.MaxBufferedMessages, workerCount)
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// ConsoleSchemaUsage configures a background scanner that samples the most recent
+// records of all topics and tracks which schema IDs they are serialized with.
+type ConsoleSchemaUsage struct {
+	Enabled bool `yaml:"enabled"`
+	// ScanInterval is the interval in which all topics are sampled.
+	ScanInterval time.Duration `yaml:"scanInterval"`
+	// ScanTimeout is the max time a single scan may take to fetch the sampled
+	// records. Topics whose records could not be fetched in time keep the schema
+	// IDs of the previous scans.
+	ScanTimeout time.Duration `yaml:"scanTimeout"`
+	// RecordsPerTopic is the number of most recent records that are sampled per
+	// topic, spread evenly across all partitions.
+	RecordsPerTopic int `yaml:"recordsPerTopic"`
+	// IncludeInternal controls whether internal topics are scanned.
+	IncludeInternal bool `yaml:"includeInternal"`
+}
+
+// SetDefaults for ConsoleSchemaUsage.
+func (c *ConsoleSchemaUsage) SetDefaults() {
+	c.ScanInterval = 15 * time.Minute
+	c.ScanTimeout = time.Minute
+	c.RecordsPerTopic = 100
+}
+
+// Validate the schema usage config.
+func (c *ConsoleSchemaUsage) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ScanInterval <= 0 {
+		return errors.New("scanInterval must be greater than 0")
+	}
+	if c.ScanTimeout <= 0 {
+		return errors.New("scanTimeout must be greater than 0")
+	}
+	if c.RecordsPerTopic <= 0 {
+		return errors.New("recordsPerTopic must be greater than 0")
+	}
+	return nil
+}
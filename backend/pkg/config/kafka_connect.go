@@ -23,6 +23,17 @@ type KafkaConnect struct {
 	ConnectTimeout time.Duration         `yaml:"connectTimeout"` // used for connectivity test
 	ReadTimeout    time.Duration         `yaml:"readTimeout"`    // overall REST/HTTP read timeout
 	RequestTimeout time.Duration         `yaml:"requestTimeout"` // timeout for REST requests to Kafka KafkaConnect
+
+	// ConfigHistory keeps track of all connector configurations that are applied via Console.
+	ConfigHistory KafkaConnectConfigHistory `yaml:"configHistory"`
+	// AutoRemediation restarts failed connectors and tasks in the background.
+	AutoRemediation KafkaConnectAutoRemediation `yaml:"autoRemediation"`
+	// Guides are declarative connector guides that are loaded from YAML files.
+	Guides KafkaConnectGuides `yaml:"guides"`
+	// Registry allows to add and remove clusters at runtime and probes their health.
+	Registry KafkaConnectClusterRegistry `yaml:"registry"`
+	// Secrets moves sensitive connector config properties into a secret store.
+	Secrets KafkaConnectSecrets `yaml:"secrets"`
 }
 
 // SetDefaults for Kafka connect configuration.
@@ -33,6 +44,11 @@ func (c *KafkaConnect) SetDefaults() {
 	c.ConnectTimeout = 15 * time.Second
 	c.ReadTimeout = 6 * time.Second
 	c.RequestTimeout = 6 * time.Second
+	c.ConfigHistory.SetDefaults()
+	c.AutoRemediation.SetDefaults()
+	c.Guides.SetDefaults()
+	c.Registry.SetDefaults()
+	c.Secrets.SetDefaults()
 }
 
 // RegisterFlags registers all nested config flags.
@@ -51,5 +67,20 @@ func (c *KafkaConnect) Validate() error {
 			return fmt.Errorf("failed to validate cluster at index '%d' (name: '%v'): %w", i, cluster.Name, err)
 		}
 	}
+	if err := c.ConfigHistory.Validate(); err != nil {
+		return fmt.Errorf("failed to validate config history: %w", err)
+	}
+	if err := c.AutoRemediation.Validate(); err != nil {
+		return fmt.Errorf("failed to validate auto remediation: %w", err)
+	}
+	if err := c.Guides.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connector guides: %w", err)
+	}
+	if err := c.Registry.Validate(); err != nil {
+		return fmt.Errorf("failed to validate cluster registry: %w", err)
+	}
+	if err := c.Secrets.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connector secrets: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,160 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// KafkaConnectAutoRemediation configures a background supervisor that polls the
+// status of all connectors and restarts failed connectors and tasks.
+type KafkaConnectAutoRemediation struct {
+	Enabled bool `yaml:"enabled"`
+	// PollInterval is the interval in which the connector status of all
+	// clusters is checked.
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// MaxEvents is the number of remediation events that are kept in memory.
+	MaxEvents int `yaml:"maxEvents"`
+	// DefaultPolicy applies to all connectors that are not matched by any of
+	// the policies.
+	DefaultPolicy KafkaConnectRemediationPolicy `yaml:"defaultPolicy"`
+	// Policies override the default policy for specific connectors. The first
+	// matching policy is used. Unset properties are inherited from the default policy.
+	Policies []KafkaConnectRemediationPolicy `yaml:"policies"`
+}
+
+// KafkaConnectRemediationPolicy defines how failed connectors and tasks are restarted.
+type KafkaConnectRemediationPolicy struct {
+	// Cluster and Connector select the connectors the policy applies to. Both
+	// support literals and regular expressions that are surrounded by slashes,
+	// e.g. "/^jdbc-.*/". An empty value matches all names. They are ignored in
+	// the default policy.
+	Cluster   string `yaml:"cluster"`
+	Connector string `yaml:"connector"`
+
+	// MaxAttempts is the maximum number of consecutive restarts of a failed
+	// connector or task. Once exceeded, Console gives up until the connector or
+	// task has been healthy for ResetAfter.
+	MaxAttempts int `yaml:"maxAttempts"`
+	// BaseInterval, MaxInterval and Multiplier define the exponential backoff
+	// between consecutive restarts.
+	BaseInterval time.Duration `yaml:"baseInterval"`
+	MaxInterval  time.Duration `yaml:"maxInterval"`
+	Multiplier   float64       `yaml:"multiplier"`
+	// ResetAfter is the duration after the last restart, after which a connector
+	// or task that is not failed anymore is considered healthy again.
+	ResetAfter time.Duration `yaml:"resetAfter"`
+
+	// AllowedTraces is a list of regular expressions. If set, only connectors and
+	// tasks whose error trace matches one of them are restarted.
+	AllowedTraces []string `yaml:"allowedTraces"`
+	// DeniedTraces is a list of regular expressions. Connectors and tasks whose
+	// error trace matches one of them are never restarted.
+	DeniedTraces []string `yaml:"deniedTraces"`
+}
+
+// SetDefaults for the Kafka connect auto remediation.
+func (c *KafkaConnectAutoRemediation) SetDefaults() {
+	c.PollInterval = 30 * time.Second
+	c.MaxEvents = 1000
+	c.DefaultPolicy.MaxAttempts = 5
+	c.DefaultPolicy.BaseInterval = 30 * time.Second
+	c.DefaultPolicy.MaxInterval = 30 * time.Minute
+	c.DefaultPolicy.Multiplier = 2
+	c.DefaultPolicy.ResetAfter = time.Hour
+}
+
+// Validate the auto remediation configuration. Unset properties of the policies
+// are set to the values of the default policy.
+func (c *KafkaConnectAutoRemediation) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.PollInterval <= 0 {
+		return errors.New("pollInterval must be greater than 0")
+	}
+	if c.MaxEvents <= 0 {
+		return errors.New("maxEvents must be greater than 0")
+	}
+	if err := c.DefaultPolicy.Validate(); err != nil {
+		return fmt.Errorf("failed to validate default policy: %w", err)
+	}
+
+	for i := range c.Policies {
+		policy := &c.Policies[i]
+		policy.inheritFrom(c.DefaultPolicy)
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("failed to validate policy at index '%d': %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the remediation policy.
+func (p *KafkaConnectRemediationPolicy) Validate() error {
+	if p.MaxAttempts <= 0 {
+		return errors.New("maxAttempts must be greater than 0")
+	}
+	if p.BaseInterval <= 0 {
+		return errors.New("baseInterval must be greater than 0")
+	}
+	if p.MaxInterval < p.BaseInterval {
+		return errors.New("maxInterval must not be smaller than baseInterval")
+	}
+	if p.Multiplier < 1 {
+		return errors.New("multiplier must be at least 1")
+	}
+	if p.ResetAfter <= 0 {
+		return errors.New("resetAfter must be greater than 0")
+	}
+
+	if _, err := CompileRegex(p.Cluster); err != nil {
+		return fmt.Errorf("failed to compile cluster expression %q: %w", p.Cluster, err)
+	}
+	if _, err := CompileRegex(p.Connector); err != nil {
+		return fmt.Errorf("failed to compile connector expression %q: %w", p.Connector, err)
+	}
+	for _, expr := range append(p.AllowedTraces, p.DeniedTraces...) {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("failed to compile trace expression %q: %w", expr, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *KafkaConnectRemediationPolicy) inheritFrom(defaultPolicy KafkaConnectRemediationPolicy) {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaultPolicy.MaxAttempts
+	}
+	if p.BaseInterval == 0 {
+		p.BaseInterval = defaultPolicy.BaseInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = defaultPolicy.MaxInterval
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = defaultPolicy.Multiplier
+	}
+	if p.ResetAfter == 0 {
+		p.ResetAfter = defaultPolicy.ResetAfter
+	}
+	if p.AllowedTraces == nil {
+		p.AllowedTraces = defaultPolicy.AllowedTraces
+	}
+	if p.DeniedTraces == nil {
+		p.DeniedTraces = defaultPolicy.DeniedTraces
+	}
+}
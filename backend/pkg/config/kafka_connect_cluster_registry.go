@@ -0,0 +1,134 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"time"
+)
+
+const (
+	// KafkaConnectClusterDiscoveryFile discovers Kafka connect clusters from a YAML file.
+	KafkaConnectClusterDiscoveryFile = "file"
+	// KafkaConnectClusterDiscoveryDNSSRV discovers Kafka connect clusters via DNS SRV records.
+	KafkaConnectClusterDiscoveryDNSSRV = "dns-srv"
+)
+
+// KafkaConnectClusterRegistry configures how Kafka connect clusters can be added
+// and removed while Console is running, in addition to the statically configured
+// clusters.
+type KafkaConnectClusterRegistry struct {
+	// Enabled allows to add, update and remove Kafka connect clusters via the API.
+	Enabled bool `yaml:"enabled"`
+	// StoreFilepath is the file that clusters which are registered via the API are
+	// persisted to. The file contains the cluster credentials in plaintext, if any.
+	// Console refuses to start if the file is accessible by other users (permissions
+	// wider than 0600).
+	StoreFilepath string `yaml:"storeFilepath"`
+	// AllowedHosts are the hosts that clusters registered via the API may point
+	// to, so that Console can't be used to send requests to arbitrary addresses.
+	// Patterns may contain wildcards, see path.Match. They match the host name,
+	// or the host name and port if the pattern contains a port, such as
+	// "*.connect.svc.cluster.local:8083".
+	AllowedHosts []string `yaml:"allowedHosts"`
+	// TLSDirectory is the directory that the TLS files of clusters registered via
+	// the API must be located in. The file paths in the API requests are relative
+	// to it. If empty, registered clusters can't use custom CAs or client
+	// certificates.
+	TLSDirectory string `yaml:"tlsDirectory"`
+	// HealthCheckInterval is the interval in which all clusters are probed. Set it
+	// to 0 to disable the periodic health checks.
+	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
+	// Discovery periodically discovers clusters from an external source.
+	Discovery KafkaConnectClusterDiscovery `yaml:"discovery"`
+}
+
+// KafkaConnectClusterDiscovery configures a source which Kafka connect clusters are
+// discovered from. Discovered clusters are added and removed as the source changes.
+type KafkaConnectClusterDiscovery struct {
+	Enabled bool `yaml:"enabled"`
+	// Type is either "file" or "dns-srv".
+	Type string `yaml:"type"`
+	// Filepath is a YAML file containing a list of clusters with a name and url.
+	Filepath string `yaml:"filepath"`
+	// SRVRecord is the DNS SRV record to lookup, e.g. "_connect._tcp.example.com".
+	// Each target of the record is added as a cluster that is named after the target host.
+	SRVRecord string `yaml:"srvRecord"`
+	// Scheme is the URL scheme that is used for clusters discovered via DNS SRV records.
+	Scheme          string        `yaml:"scheme"`
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+
+	// Authentication configuration that is used for all discovered clusters
+	TLS      TLS    `yaml:"tls"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Token    string `yaml:"token"`
+}
+
+// SetDefaults for the Kafka connect cluster registry.
+func (c *KafkaConnectClusterRegistry) SetDefaults() {
+	c.HealthCheckInterval = 30 * time.Second
+	c.Discovery.Scheme = "http"
+	c.Discovery.RefreshInterval = time.Minute
+	c.Discovery.TLS.SetDefaults()
+}
+
+// Validate the Kafka connect cluster registry configuration.
+func (c *KafkaConnectClusterRegistry) Validate() error {
+	if c.HealthCheckInterval < 0 {
+		return errors.New("health check interval must not be negative")
+	}
+	if c.Enabled && c.StoreFilepath == "" {
+		return errors.New("a store filepath must be set to persist registered clusters")
+	}
+	if c.Enabled && len(c.AllowedHosts) == 0 {
+		return errors.New("allowed hosts must be set to register clusters via the API")
+	}
+	for _, pattern := range c.AllowedHosts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("allowed host %q is invalid: %w", pattern, err)
+		}
+	}
+	if err := c.Discovery.Validate(); err != nil {
+		return fmt.Errorf("failed to validate discovery: %w", err)
+	}
+	return nil
+}
+
+// Validate the Kafka connect cluster discovery configuration.
+func (c *KafkaConnectClusterDiscovery) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	switch c.Type {
+	case KafkaConnectClusterDiscoveryFile:
+		if c.Filepath == "" {
+			return errors.New("a filepath must be set for file based discovery")
+		}
+	case KafkaConnectClusterDiscoveryDNSSRV:
+		if c.SRVRecord == "" {
+			return errors.New("an srv record must be set for DNS SRV based discovery")
+		}
+		if c.Scheme != "http" && c.Scheme != "https" {
+			return fmt.Errorf("scheme %q is invalid, must be either http or https", c.Scheme)
+		}
+	default:
+		return fmt.Errorf("discovery type %q is invalid, must be either %q or %q", c.Type, KafkaConnectClusterDiscoveryFile, KafkaConnectClusterDiscoveryDNSSRV)
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be positive")
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("failed to validate TLS config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// KafkaConnectConfigHistoryStorageKafka stores connector config versions in a compacted Kafka topic.
+	KafkaConnectConfigHistoryStorageKafka = "kafka"
+	// KafkaConnectConfigHistoryStorageFilesystem stores connector config versions in a local directory.
+	KafkaConnectConfigHistoryStorageFilesystem = "filesystem"
+)
+
+// KafkaConnectConfigHistory configures the history of connector configurations
+// that are applied via Console. If enabled, every applied connector config is
+// stored as a new version that can be listed, diffed and rolled back to.
+type KafkaConnectConfigHistory struct {
+	Enabled bool `yaml:"enabled"`
+	// Storage is either "kafka" or "filesystem".
+	Storage string `yaml:"storage"`
+	// Topic is the compacted topic that is used if Storage is "kafka". The topic
+	// is created on first use, if it does not exist.
+	Topic string `yaml:"topic"`
+	// Directory is the local directory that is used if Storage is "filesystem".
+	Directory string `yaml:"directory"`
+	// MaxVersions is the maximum number of versions that are retained per connector.
+	MaxVersions int `yaml:"maxVersions"`
+}
+
+// SetDefaults for the connector config history.
+func (c *KafkaConnectConfigHistory) SetDefaults() {
+	c.Storage = KafkaConnectConfigHistoryStorageKafka
+	c.Topic = "_redpanda.console.connector-config-history"
+	c.MaxVersions = 50
+}
+
+// Validate the connector config history configuration.
+func (c *KafkaConnectConfigHistory) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Storage {
+	case KafkaConnectConfigHistoryStorageKafka:
+		if c.Topic == "" {
+			return errors.New("a topic must be set if the kafka storage is used")
+		}
+	case KafkaConnectConfigHistoryStorageFilesystem:
+		if c.Directory == "" {
+			return errors.New("a directory must be set if the filesystem storage is used")
+		}
+	default:
+		return fmt.Errorf("storage %q is invalid, must be either %q or %q", c.Storage,
+			KafkaConnectConfigHistoryStorageKafka, KafkaConnectConfigHistoryStorageFilesystem)
+	}
+
+	if c.MaxVersions <= 0 {
+		return errors.New("maxVersions must be greater than 0")
+	}
+
+	return nil
+}
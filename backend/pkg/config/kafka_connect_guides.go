@@ -0,0 +1,56 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// KafkaConnectGuides configures declarative connector guides. Each YAML file defines
+// the setup guide and config patches for one connector class, so that guides for
+// in-house connectors can be added without changing Console. The guides are loaded
+// once at startup and take precedence over the built-in guides for the same class.
+type KafkaConnectGuides struct {
+	Enabled    bool       `yaml:"enabled"`
+	Git        Git        `yaml:"git"`
+	FileSystem Filesystem `yaml:"fileSystem"`
+}
+
+// SetDefaults for declarative connector guides.
+func (c *KafkaConnectGuides) SetDefaults() {
+	c.Git.SetDefaults()
+	c.FileSystem.SetDefaults()
+
+	// Guides are only read once at startup, so that periodic refreshes are not required
+	c.Git.RefreshInterval = 0
+	c.Git.IndexByFullFilepath = true
+	c.Git.AllowedFileExtensions = []string{"yaml", "yml"}
+	c.FileSystem.IndexByFullFilepath = true
+	c.FileSystem.AllowedFileExtensions = []string{"yaml", "yml"}
+}
+
+// Validate declarative connector guides configuration.
+func (c *KafkaConnectGuides) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.Git.Enabled && !c.FileSystem.Enabled {
+		return errors.New("connector guides are enabled, but neither git nor fileSystem is enabled. At least one source for connector guides must be configured")
+	}
+	if err := c.Git.Validate(); err != nil {
+		return fmt.Errorf("failed to validate git config: %w", err)
+	}
+	if err := c.FileSystem.Validate(); err != nil {
+		return fmt.Errorf("failed to validate fileSystem config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	// KafkaConnectSecretsStorageFilesystem stores secrets in an encrypted local file.
+	KafkaConnectSecretsStorageFilesystem = "filesystem"
+)
+
+var configProviderNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]*$`)
+
+// KafkaConnectSecrets configures the externalization of sensitive connector
+// config properties. If enabled, the values of all PASSWORD config properties
+// that are submitted via Console are moved into a secret store and replaced with
+// a config provider reference such as ${file:/etc/connect/secrets.properties:MY_SECRET}.
+// The secrets are exported to a properties file that the Kafka connect workers
+// resolve with Kafka's FileConfigProvider, which must be configured on the workers
+// with the same name.
+type KafkaConnectSecrets struct {
+	Enabled bool `yaml:"enabled"`
+	// ConfigProvider is the name of the FileConfigProvider that is configured on
+	// the Kafka connect workers.
+	ConfigProvider string `yaml:"configProvider"`
+	// ConfigProviderPath is the path of the exported properties file on the Kafka
+	// connect workers. References are rendered as ${<provider>:<path>:<id>}. It
+	// defaults to WorkerFilepath, which fits if the shared volume is mounted at
+	// the same path on Console and the workers.
+	ConfigProviderPath string `yaml:"configProviderPath"`
+	// WorkerFilepath is the properties file that Console exports the secrets to
+	// in plaintext. It must be on a volume that is shared with the Kafka connect
+	// workers, otherwise they can't resolve the references.
+	WorkerFilepath string `yaml:"workerFilepath"`
+	// Storage is the secret backend. Currently only "filesystem" is supported.
+	Storage string `yaml:"storage"`
+	// Filepath is the encrypted file that is used if Storage is "filesystem".
+	Filepath string `yaml:"filepath"`
+	// EncryptionKey is the base64 encoded 32 byte AES key that is used to encrypt
+	// the secrets file.
+	EncryptionKey string `yaml:"encryptionKey"`
+}
+
+// SetDefaults for the connector secrets externalization.
+func (c *KafkaConnectSecrets) SetDefaults() {
+	c.ConfigProvider = "file"
+	c.Storage = KafkaConnectSecretsStorageFilesystem
+}
+
+// Validate the connector secrets configuration.
+func (c *KafkaConnectSecrets) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if !configProviderNamePattern.MatchString(c.ConfigProvider) {
+		return fmt.Errorf("config provider %q is not a valid config provider name", c.ConfigProvider)
+	}
+	if c.WorkerFilepath == "" {
+		return errors.New("a workerFilepath must be set, so that the Kafka connect workers can resolve the secrets")
+	}
+
+	switch c.Storage {
+	case KafkaConnectSecretsStorageFilesystem:
+		if c.Filepath == "" {
+			return errors.New("a filepath must be set if the filesystem storage is used")
+		}
+	default:
+		return fmt.Errorf("storage %q is invalid, must be %q", c.Storage, KafkaConnectSecretsStorageFilesystem)
+	}
+
+	if _, err := c.DecodedEncryptionKey(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DecodedEncryptionKey returns the decoded AES key that is used to encrypt the secrets.
+func (c *KafkaConnectSecrets) DecodedEncryptionKey() ([]byte, error) {
+	if c.EncryptionKey == "" {
+		return nil, errors.New("an encryptionKey must be set")
+	}
+	key, err := base64.StdEncoding.DecodeString(c.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryptionKey as base64: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryptionKey must be 32 bytes long, but it is %d bytes long", len(key))
+	}
+	return key, nil
+}
+
+// WorkerConfigProviderPath returns the path of the exported secrets file on the
+// Kafka connect workers.
+func (c *KafkaConnectSecrets) WorkerConfigProviderPath() string {
+	if c.ConfigProviderPath != "" {
+		return c.ConfigProviderPath
+	}
+	return c.WorkerFilepath
+}
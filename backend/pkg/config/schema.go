@@ -25,6 +25,16 @@ type Schema struct {
 
 	// TLS / Custom CA
 	TLS TLS `yaml:"tls"`
+
+	// Sync configures copying subjects between schema registries or contexts.
+	Sync SchemaSync `yaml:"sync"`
+
+	// Lint configures rules that schemas must pass before they are registered.
+	Lint SchemaLint `yaml:"lint"`
+
+	// ProtobufImports configures the sources for imports of Protobuf schemas
+	// that aren't registered as schema references.
+	ProtobufImports SchemaProtobufImports `yaml:"protobufImports"`
 }
 
 // RegisterFlags registers all nested config flags.
@@ -49,5 +59,17 @@ func (c *Schema) Validate() error {
 		}
 	}
 
+	if err := c.Sync.Validate(); err != nil {
+		return fmt.Errorf("failed to validate schema sync config: %w", err)
+	}
+
+	if err := c.Lint.Validate(); err != nil {
+		return fmt.Errorf("failed to validate schema lint config: %w", err)
+	}
+
+	if err := c.ProtobufImports.Validate(); err != nil {
+		return fmt.Errorf("failed to validate protobuf imports config: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,86 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// SchemaLint configures lint rules that schemas must pass before they are
+// registered via Console.
+type SchemaLint struct {
+	Enabled bool `yaml:"enabled"`
+	// AllowOverride allows registering schemas that violate rules with
+	// severity ERROR by setting the override flag. Whether a user may set
+	// the flag can be further restricted by authorization hooks.
+	AllowOverride bool             `yaml:"allowOverride"`
+	Rules         []SchemaLintRule `yaml:"rules"`
+}
+
+// Validate the schema lint configurations.
+func (c *SchemaLint) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	for i, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("failed to validate rule at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// SchemaLintRule is a single schema lint rule. Which of the options are
+// used depends on the rule.
+type SchemaLintRule struct {
+	// Rule is the name of the rule, such as FIELD_NAMING.
+	Rule string `yaml:"rule"`
+	// Severity is either ERROR or WARNING. Defaults to ERROR.
+	Severity string `yaml:"severity"`
+	// Subjects is a regex that restricts the rule to matching subjects. The
+	// rule applies to all subjects if it's empty.
+	Subjects string `yaml:"subjects"`
+	// Convention is the naming convention of FIELD_NAMING. One of
+	// snake_case, camelCase or PascalCase.
+	Convention string `yaml:"convention"`
+	// Pattern is a regex that replaces the convention of FIELD_NAMING, the
+	// money field names of NO_FLOAT_MONEY or the package naming of
+	// PROTOBUF_PACKAGE_NAMING.
+	Pattern string `yaml:"pattern"`
+	// Prefix is the required namespace or package prefix of NAMESPACE_PREFIX.
+	// It may refer to capture groups of the subjects regex, such as $1.
+	Prefix string `yaml:"prefix"`
+}
+
+// Validate the schema lint rule.
+func (c *SchemaLintRule) Validate() error {
+	if c.Rule == "" {
+		return errors.New("rule name must be set")
+	}
+
+	switch c.Severity {
+	case "", "ERROR", "WARNING":
+	default:
+		return fmt.Errorf("invalid severity %q of rule %q, must be ERROR or WARNING", c.Severity, c.Rule)
+	}
+
+	if _, err := regexp.Compile(c.Subjects); err != nil {
+		return fmt.Errorf("failed to compile subjects regex of rule %q: %w", c.Rule, err)
+	}
+	if _, err := regexp.Compile(c.Pattern); err != nil {
+		return fmt.Errorf("failed to compile pattern of rule %q: %w", c.Rule, err)
+	}
+
+	return nil
+}
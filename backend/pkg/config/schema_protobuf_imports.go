@@ -0,0 +1,68 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// ProtobufImportSourceEmbedded resolves imports from the well-known and
+	// common types that are embedded in Console.
+	ProtobufImportSourceEmbedded = "embedded"
+	// ProtobufImportSourceProtobuf resolves imports from the git and filesystem
+	// sources configured in serde.protobuf.
+	ProtobufImportSourceProtobuf = "protobuf"
+	// ProtobufImportSourceBSR resolves imports from the modules of the Buf
+	// Schema Registry configured in serde.protobuf.bufSchemaRegistry.
+	ProtobufImportSourceBSR = "bsr"
+)
+
+// SchemaProtobufImports configures how imports of registered Protobuf schemas
+// are resolved if they are not registered as schema references themselves.
+type SchemaProtobufImports struct {
+	// Sources are tried in the given order. Sources that are not configured
+	// are skipped. Defaults to embedded, protobuf, bsr.
+	Sources []string `yaml:"sources"`
+
+	// BSRModules are the Buf Schema Registry modules whose files can be
+	// imported, in the format owner/module or owner/module:label.
+	BSRModules []string `yaml:"bsrModules"`
+}
+
+// SourcesOrDefault returns the configured sources or the default order.
+func (c *SchemaProtobufImports) SourcesOrDefault() []string {
+	if len(c.Sources) == 0 {
+		return []string{ProtobufImportSourceEmbedded, ProtobufImportSourceProtobuf, ProtobufImportSourceBSR}
+	}
+	return c.Sources
+}
+
+// Validate the Protobuf import configuration.
+func (c *SchemaProtobufImports) Validate() error {
+	known := []string{ProtobufImportSourceEmbedded, ProtobufImportSourceProtobuf, ProtobufImportSourceBSR}
+	for _, source := range c.Sources {
+		if !slices.Contains(known, source) {
+			return fmt.Errorf("unknown protobuf import source %q, must be one of %v", source, known)
+		}
+	}
+
+	for _, module := range c.BSRModules {
+		name, _, _ := strings.Cut(module, ":")
+		owner, repository, found := strings.Cut(name, "/")
+		if !found || owner == "" || repository == "" || strings.Contains(repository, "/") {
+			return fmt.Errorf("invalid bsr module %q, must be in the format owner/module[:label]", module)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// SchemaSync configures copying subjects between schema registries or between
+// contexts of the same schema registry.
+type SchemaSync struct {
+	Enabled bool `yaml:"enabled"`
+	// Registries are additional schema registries that can be used as source
+	// or target of a sync. The schema registry configured in schemaRegistry
+	// is always available and does not need to be listed.
+	Registries []SchemaSyncRegistry `yaml:"registries"`
+}
+
+// Validate the schema sync configurations.
+func (c *SchemaSync) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(c.Registries))
+	for i, registry := range c.Registries {
+		if err := registry.Validate(); err != nil {
+			return fmt.Errorf("failed to validate registry at index %d: %w", i, err)
+		}
+		if _, exists := names[registry.Name]; exists {
+			return fmt.Errorf("registry name %q is used more than once", registry.Name)
+		}
+		names[registry.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// SchemaSyncRegistry is the configuration of a single schema registry that
+// can be used as source or target of a schema sync.
+type SchemaSyncRegistry struct {
+	// Name identifies the registry in sync requests.
+	Name string   `yaml:"name"`
+	URLs []string `yaml:"urls"`
+
+	Authentication HTTPAuthentication `yaml:"authentication"`
+
+	// TLS / Custom CA
+	TLS TLS `yaml:"tls"`
+}
+
+// Validate the schema sync registry configuration.
+func (c *SchemaSyncRegistry) Validate() error {
+	if c.Name == "" {
+		return errors.New("a name must be set to identify the schema registry")
+	}
+
+	if len(c.URLs) == 0 {
+		return fmt.Errorf("no URL is configured for schema registry %q", c.Name)
+	}
+
+	for _, u := range c.URLs {
+		_, err := url.Parse(u)
+		if err != nil {
+			return fmt.Errorf("failed to parse schema registry url %q: %w", u, err)
+		}
+	}
+
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("failed to validate TLS config: %w", err)
+	}
+
+	return nil
+}
@@ -21,6 +21,10 @@ type Serde struct {
 	Protobuf                      Proto   `yaml:"protobuf"`
 	MessagePack                   Msgpack `yaml:"messagePack"`
 	Cbor                          Cbor    `yaml:"cbor"`
+	// DetectionCache remembers the deserializer that succeeded per topic.
+	DetectionCache SerdeDetectionCache `yaml:"detectionCache"`
+	// JSONValidation validates plain JSON payloads against the topic's schema.
+	JSONValidation SerdeJSONValidation `yaml:"jsonValidation"`
 }
 
 // SetDefaults for Serde config
@@ -28,6 +32,7 @@ func (c *Serde) SetDefaults() {
 	c.MaxDeserializationPayloadSize = DefaultMaxDeserializationPayloadSize
 	c.Protobuf.SetDefaults()
 	c.MessagePack.SetDefaults()
+	c.DetectionCache.SetDefaults()
 }
 
 // RegisterFlags registers all nested config flags.
@@ -49,5 +54,9 @@ func (c *Serde) Validate() error {
 		return fmt.Errorf("failed to validate msgpack config: %w", err)
 	}
 
+	if err := c.DetectionCache.Validate(); err != nil {
+		return fmt.Errorf("failed to validate serde detection cache config: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,37 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import "errors"
+
+// SerdeDetectionCache configures the cache that remembers which deserializer
+// succeeded for the keys and values of each topic. The remembered deserializer
+// is tried first for subsequent records, before falling back to trying all
+// deserializers in order.
+type SerdeDetectionCache struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxEntries is the maximum number of remembered topic, payload type and
+	// schema ID combinations.
+	MaxEntries int `yaml:"maxEntries"`
+}
+
+// SetDefaults for the serde detection cache.
+func (c *SerdeDetectionCache) SetDefaults() {
+	c.Enabled = true
+	c.MaxEntries = 10000
+}
+
+// Validate the serde detection cache config.
+func (c *SerdeDetectionCache) Validate() error {
+	if c.Enabled && c.MaxEntries <= 0 {
+		return errors.New("maxEntries must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+// SerdeJSONValidation configures the validation of plain JSON payloads, that
+// don't carry a schema ID, against the latest JSON schema registered for the
+// topic's subject (<topic>-key or <topic>-value). This requires the schema
+// registry to be configured.
+type SerdeJSONValidation struct {
+	Enabled bool `yaml:"enabled"`
+
+	// TopicName restricts the validation to the topics matching the given name.
+	// This supports regex. All topics are validated if it's empty.
+	TopicName RegexpOrLiteral `yaml:"topicName"`
+}
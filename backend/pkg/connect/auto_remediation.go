@@ -0,0 +1,463 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+
+	"github.com/redpanda-data/console/backend/pkg/backoff"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// RemediationAction describes what the auto remediation did about a failed connector or task.
+type RemediationAction string
+
+const (
+	// RemediationActionRestarted is a successfully requested restart.
+	RemediationActionRestarted RemediationAction = "RESTARTED"
+	// RemediationActionRestartFailed is a restart that has been rejected by the connect cluster.
+	RemediationActionRestartFailed RemediationAction = "RESTART_FAILED"
+	// RemediationActionSkipped is a failure that is not restarted, because its
+	// error trace is not allowed by the policy.
+	RemediationActionSkipped RemediationAction = "SKIPPED"
+	// RemediationActionGaveUp is a failure that is not restarted anymore, because
+	// the maximum number of attempts has been reached.
+	RemediationActionGaveUp RemediationAction = "GAVE_UP"
+)
+
+// remediationConnectorTaskID is used as task ID for remediating the connector instance itself.
+const remediationConnectorTaskID = -1
+
+// RemediationEvent is a single action of the auto remediation.
+type RemediationEvent struct {
+	Timestamp     time.Time `json:"timestamp"`
+	ClusterName   string    `json:"clusterName"`
+	ConnectorName string    `json:"connectorName"`
+	// TaskID is nil if the connector instance itself has failed.
+	TaskID      *int              `json:"taskId,omitempty"`
+	Action      RemediationAction `json:"action"`
+	Attempt     int               `json:"attempt"`
+	MaxAttempts int               `json:"maxAttempts"`
+	// NextAttemptAt is the earliest time of the next restart, if the restart
+	// does not resolve the failure.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	// Trace is the first line of the error trace reported by the connect cluster.
+	Trace string `json:"trace,omitempty"`
+	// Error is the reason a restart has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// remediationPolicy is the compiled form of config.KafkaConnectRemediationPolicy.
+type remediationPolicy struct {
+	cluster       *regexp.Regexp
+	connector     *regexp.Regexp
+	maxAttempts   int
+	backoff       backoff.ExponentialBackoff
+	resetAfter    time.Duration
+	allowedTraces []*regexp.Regexp
+	deniedTraces  []*regexp.Regexp
+}
+
+// remediationTarget identifies a connector or a single task of a connector.
+type remediationTarget struct {
+	clusterName   string
+	connectorName string
+	taskID        int
+}
+
+// remediationState tracks the consecutive restarts of a remediation target.
+type remediationState struct {
+	attempts    int
+	lastAttempt time.Time
+	// reported is set once a skip or give up has been recorded, so that it is
+	// not recorded again on every poll.
+	reported bool
+}
+
+// remediationDecision is the outcome of evaluating a failed target against its policy.
+type remediationDecision int
+
+const (
+	remediationDecisionWait remediationDecision = iota
+	remediationDecisionRestart
+	remediationDecisionSkip
+	remediationDecisionGiveUp
+)
+
+// RemediationSupervisor polls the status of all connectors in all connect clusters
+// and restarts failed connectors and tasks according to the configured policies.
+type RemediationSupervisor struct {
+	svc           *Service
+	logger        *slog.Logger
+	pollInterval  time.Duration
+	maxEvents     int
+	defaultPolicy *remediationPolicy
+	policies      []*remediationPolicy
+
+	mu     sync.Mutex
+	states map[remediationTarget]*remediationState
+	events []RemediationEvent
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRemediationSupervisor creates a supervisor for all clusters of the given service.
+// It does not poll until Start is called.
+func NewRemediationSupervisor(svc *Service, cfg config.KafkaConnectAutoRemediation) (*RemediationSupervisor, error) {
+	defaultPolicy, err := compileRemediationPolicy(cfg.DefaultPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile default policy: %w", err)
+	}
+	// The default policy applies to all connectors
+	defaultPolicy.cluster = nil
+	defaultPolicy.connector = nil
+
+	policies := make([]*remediationPolicy, len(cfg.Policies))
+	for i, policyCfg := range cfg.Policies {
+		policy, err := compileRemediationPolicy(policyCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile policy at index '%d': %w", i, err)
+		}
+		policies[i] = policy
+	}
+
+	return &RemediationSupervisor{
+		svc:           svc,
+		logger:        svc.Logger.With(slog.String("component", "connect_auto_remediation")),
+		pollInterval:  cfg.PollInterval,
+		maxEvents:     cfg.MaxEvents,
+		defaultPolicy: defaultPolicy,
+		policies:      policies,
+		states:        make(map[remediationTarget]*remediationState),
+		events:        make([]RemediationEvent, 0),
+	}, nil
+}
+
+func compileRemediationPolicy(cfg config.KafkaConnectRemediationPolicy) (*remediationPolicy, error) {
+	policy := &remediationPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		backoff: backoff.ExponentialBackoff{
+			BaseInterval: cfg.BaseInterval,
+			MaxInterval:  cfg.MaxInterval,
+			Multiplier:   cfg.Multiplier,
+		},
+		resetAfter: cfg.ResetAfter,
+	}
+
+	var err error
+	if cfg.Cluster != "" {
+		if policy.cluster, err = config.CompileRegex(cfg.Cluster); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Connector != "" {
+		if policy.connector, err = config.CompileRegex(cfg.Connector); err != nil {
+			return nil, err
+		}
+	}
+	for _, expr := range cfg.AllowedTraces {
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		policy.allowedTraces = append(policy.allowedTraces, regex)
+	}
+	for _, expr := range cfg.DeniedTraces {
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		policy.deniedTraces = append(policy.deniedTraces, regex)
+	}
+
+	return policy, nil
+}
+
+// Start polls the connector status in the background until Stop is called.
+func (r *RemediationSupervisor) Start(ctx context.Context) {
+	// The start context expires once the startup has finished
+	ctx, r.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.poll(ctx)
+			}
+		}
+	}()
+	r.logger.InfoContext(ctx, "started Kafka connect auto remediation", slog.Duration("poll_interval", r.pollInterval))
+}
+
+// Stop stops polling and waits for an ongoing poll to finish.
+func (r *RemediationSupervisor) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
+
+// Events returns the recorded remediation events, most recent first. Empty
+// cluster or connector names match all clusters or connectors.
+func (r *RemediationSupervisor) Events(clusterName, connectorName string) []RemediationEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]RemediationEvent, 0)
+	for _, event := range slices.Backward(r.events) {
+		if clusterName != "" && event.ClusterName != clusterName {
+			continue
+		}
+		if connectorName != "" && event.ConnectorName != connectorName {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// poll checks the connector status of all clusters once.
+func (r *RemediationSupervisor) poll(ctx context.Context) {
+	for clusterName, cluster := range r.svc.Clusters() {
+		childCtx, cancel := context.WithTimeout(ctx, r.svc.Cfg.RequestTimeout)
+		connectors, err := cluster.Client.ListConnectorsExpanded(childCtx)
+		cancel()
+		if err != nil {
+			r.logger.WarnContext(ctx, "failed to list connectors for auto remediation",
+				slog.String("cluster_name", clusterName), slog.Any("error", err))
+			continue
+		}
+		r.remediateCluster(ctx, clusterName, cluster.Client, connectors, time.Now())
+	}
+}
+
+func (r *RemediationSupervisor) remediateCluster(ctx context.Context, clusterName string, client *con.Client, connectors map[string]con.ListConnectorsResponseExpanded, now time.Time) {
+	seen := make(map[remediationTarget]bool)
+	for connectorName, connector := range connectors {
+		policy := r.policyFor(clusterName, connectorName)
+
+		status := connector.Status
+		target := remediationTarget{clusterName, connectorName, remediationConnectorTaskID}
+		seen[target] = true
+		r.remediate(ctx, client, policy, target, status.Connector.State, status.Connector.Trace, now)
+
+		for _, task := range status.Tasks {
+			target := remediationTarget{clusterName, connectorName, task.ID}
+			seen[target] = true
+			r.remediate(ctx, client, policy, target, task.State, task.Trace, now)
+		}
+	}
+
+	// Forget connectors and tasks that do not exist anymore
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for target := range r.states {
+		if target.clusterName == clusterName && !seen[target] {
+			delete(r.states, target)
+		}
+	}
+}
+
+// policyFor returns the first policy that matches the connector or the default policy.
+func (r *RemediationSupervisor) policyFor(clusterName, connectorName string) *remediationPolicy {
+	for _, policy := range r.policies {
+		if policy.matches(clusterName, connectorName) {
+			return policy
+		}
+	}
+	return r.defaultPolicy
+}
+
+func (r *RemediationSupervisor) remediate(ctx context.Context, client *con.Client, policy *remediationPolicy, target remediationTarget, state, trace string, now time.Time) {
+	r.mu.Lock()
+	targetState, exists := r.states[target]
+	if state != connectorStateFailed {
+		if exists && policy.isRecovered(targetState, now) {
+			delete(r.states, target)
+		}
+		r.mu.Unlock()
+		return
+	}
+	if !exists {
+		targetState = &remediationState{}
+		r.states[target] = targetState
+	}
+	decision := policy.decide(targetState, trace, now)
+	r.mu.Unlock()
+
+	event := RemediationEvent{
+		Timestamp:     now.UTC(),
+		ClusterName:   target.clusterName,
+		ConnectorName: target.connectorName,
+		Attempt:       targetState.attempts,
+		MaxAttempts:   policy.maxAttempts,
+		Trace:         firstLine(trace),
+	}
+	if target.taskID != remediationConnectorTaskID {
+		event.TaskID = &target.taskID
+	}
+
+	switch decision {
+	case remediationDecisionWait:
+		return
+	case remediationDecisionSkip:
+		event.Action = RemediationActionSkipped
+	case remediationDecisionGiveUp:
+		event.Action = RemediationActionGaveUp
+	case remediationDecisionRestart:
+		event.Attempt++
+		event.Action = RemediationActionRestarted
+		if event.Attempt < policy.maxAttempts {
+			nextAttemptAt := now.Add(policy.backoff.Backoff(event.Attempt - 1)).UTC()
+			event.NextAttemptAt = &nextAttemptAt
+		}
+		if err := r.restart(ctx, client, target); err != nil {
+			event.Action = RemediationActionRestartFailed
+			event.Error = err.Error()
+		}
+
+		r.mu.Lock()
+		targetState.attempts = event.Attempt
+		targetState.lastAttempt = now
+		r.mu.Unlock()
+	}
+
+	r.recordEvent(event)
+	r.logger.InfoContext(ctx, "auto remediation of failed connector",
+		slog.String("cluster_name", event.ClusterName),
+		slog.String("connector_name", event.ConnectorName),
+		slog.Int("task_id", target.taskID),
+		slog.String("action", string(event.Action)),
+		slog.Int("attempt", event.Attempt),
+		slog.String("error", event.Error))
+}
+
+func (r *RemediationSupervisor) restart(ctx context.Context, client *con.Client, target remediationTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, r.svc.Cfg.RequestTimeout)
+	defer cancel()
+
+	if target.taskID == remediationConnectorTaskID {
+		return client.RestartConnector(ctx, target.connectorName, con.RestartConnectorOptions{})
+	}
+	return client.RestartConnectorTask(ctx, target.connectorName, target.taskID)
+}
+
+// recordEvent appends the event and drops the oldest events beyond maxEvents.
+func (r *RemediationSupervisor) recordEvent(event RemediationEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+	if len(r.events) > r.maxEvents {
+		r.events = slices.Delete(r.events, 0, len(r.events)-r.maxEvents)
+	}
+}
+
+func (p *remediationPolicy) matches(clusterName, connectorName string) bool {
+	if p.cluster != nil && !p.cluster.MatchString(clusterName) {
+		return false
+	}
+	if p.connector != nil && !p.connector.MatchString(connectorName) {
+		return false
+	}
+	return true
+}
+
+// isTraceAllowed returns whether a failure with the given error trace may be
+// restarted. Denied traces take precedence over allowed traces.
+func (p *remediationPolicy) isTraceAllowed(trace string) bool {
+	for _, regex := range p.deniedTraces {
+		if regex.MatchString(trace) {
+			return false
+		}
+	}
+	if len(p.allowedTraces) == 0 {
+		return true
+	}
+	for _, regex := range p.allowedTraces {
+		if regex.MatchString(trace) {
+			return true
+		}
+	}
+	return false
+}
+
+// decide returns what to do about a target that is currently failed. Skips and
+// give ups are only returned once per failure, subsequent calls return wait.
+func (p *remediationPolicy) decide(state *remediationState, trace string, now time.Time) remediationDecision {
+	if !p.isTraceAllowed(trace) {
+		if state.reported {
+			return remediationDecisionWait
+		}
+		state.reported = true
+		return remediationDecisionSkip
+	}
+	if state.attempts >= p.maxAttempts {
+		if state.reported {
+			return remediationDecisionWait
+		}
+		state.reported = true
+		return remediationDecisionGiveUp
+	}
+	if state.attempts > 0 && now.Before(state.lastAttempt.Add(p.backoff.Backoff(state.attempts-1))) {
+		return remediationDecisionWait
+	}
+	return remediationDecisionRestart
+}
+
+// isRecovered returns whether a target that is not failed anymore has been
+// healthy long enough to reset its consecutive attempts.
+func (p *remediationPolicy) isRecovered(state *remediationState, now time.Time) bool {
+	return !now.Before(state.lastAttempt.Add(p.resetAfter))
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
+
+// ListRemediationEvents returns the recorded auto remediation events, most recent first.
+func (s *Service) ListRemediationEvents(clusterName, connectorName string) ([]RemediationEvent, *rest.Error) {
+	if s.Remediation == nil {
+		return nil, &rest.Error{
+			Err:      errors.New("connect auto remediation is not enabled"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Kafka connect auto remediation is not enabled. Set kafkaConnect.autoRemediation.enabled to restart failed connectors and tasks",
+			IsSilent: true,
+		}
+	}
+	if clusterName != "" {
+		if _, restErr := s.getConnectClusterByName(clusterName); restErr != nil {
+			return nil, restErr
+		}
+	}
+	return s.Remediation.Events(clusterName, connectorName), nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func newTestRemediationSupervisor(t *testing.T, cfg config.KafkaConnectAutoRemediation) *RemediationSupervisor {
+	t.Helper()
+
+	cfg.Enabled = true
+	require.NoError(t, cfg.Validate())
+	svc := &Service{
+		Cfg:              config.KafkaConnect{RequestTimeout: time.Second},
+		Logger:           slog.New(slog.DiscardHandler),
+		ClientsByCluster: map[string]*ClientWithConfig{},
+	}
+	supervisor, err := NewRemediationSupervisor(svc, cfg)
+	require.NoError(t, err)
+	return supervisor
+}
+
+func TestRemediationPolicyDecide(t *testing.T) {
+	cfg := config.KafkaConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.DefaultPolicy.MaxAttempts = 3
+	cfg.DefaultPolicy.BaseInterval = time.Minute
+	cfg.DefaultPolicy.MaxInterval = 10 * time.Minute
+	cfg.DefaultPolicy.DeniedTraces = []string{"OutOfMemoryError"}
+	supervisor := newTestRemediationSupervisor(t, cfg)
+	policy := supervisor.defaultPolicy
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		state    remediationState
+		trace    string
+		now      time.Time
+		expected remediationDecision
+	}{
+		{
+			name:     "first failure is restarted immediately",
+			state:    remediationState{},
+			now:      now,
+			expected: remediationDecisionRestart,
+		},
+		{
+			name:     "waits for backoff after first attempt",
+			state:    remediationState{attempts: 1, lastAttempt: now},
+			now:      now.Add(59 * time.Second),
+			expected: remediationDecisionWait,
+		},
+		{
+			name:     "restarts after backoff of first attempt",
+			state:    remediationState{attempts: 1, lastAttempt: now},
+			now:      now.Add(time.Minute),
+			expected: remediationDecisionRestart,
+		},
+		{
+			name:     "backoff grows exponentially",
+			state:    remediationState{attempts: 2, lastAttempt: now},
+			now:      now.Add(90 * time.Second),
+			expected: remediationDecisionWait,
+		},
+		{
+			name:     "gives up after max attempts",
+			state:    remediationState{attempts: 3, lastAttempt: now},
+			now:      now.Add(time.Hour),
+			expected: remediationDecisionGiveUp,
+		},
+		{
+			name:     "give up is reported once",
+			state:    remediationState{attempts: 3, lastAttempt: now, reported: true},
+			now:      now.Add(time.Hour),
+			expected: remediationDecisionWait,
+		},
+		{
+			name:     "denied trace is skipped",
+			state:    remediationState{},
+			trace:    "java.lang.OutOfMemoryError: Java heap space",
+			now:      now,
+			expected: remediationDecisionSkip,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			assert.Equal(t, tt.expected, policy.decide(&state, tt.trace, tt.now))
+		})
+	}
+}
+
+func TestRemediationPolicyIsTraceAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		denied   []string
+		trace    string
+		expected bool
+	}{
+		{
+			name:     "no lists allow all traces",
+			trace:    "org.apache.kafka.connect.errors.ConnectException: failed",
+			expected: true,
+		},
+		{
+			name:     "trace matches allow list",
+			allowed:  []string{"SQLTransient.*Exception"},
+			trace:    "java.sql.SQLTransientConnectionException: connection refused",
+			expected: true,
+		},
+		{
+			name:     "trace does not match allow list",
+			allowed:  []string{"SQLTransient.*Exception"},
+			trace:    "org.apache.kafka.connect.errors.DataException: invalid record",
+			expected: false,
+		},
+		{
+			name:     "deny list takes precedence",
+			allowed:  []string{"Exception"},
+			denied:   []string{"DataException"},
+			trace:    "org.apache.kafka.connect.errors.DataException: invalid record",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.KafkaConnectAutoRemediation{}
+			cfg.SetDefaults()
+			cfg.DefaultPolicy.AllowedTraces = tt.allowed
+			cfg.DefaultPolicy.DeniedTraces = tt.denied
+			supervisor := newTestRemediationSupervisor(t, cfg)
+
+			assert.Equal(t, tt.expected, supervisor.defaultPolicy.isTraceAllowed(tt.trace))
+		})
+	}
+}
+
+func TestRemediationSupervisorPolicyFor(t *testing.T) {
+	cfg := config.KafkaConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.Policies = []config.KafkaConnectRemediationPolicy{
+		{Cluster: "analytics", Connector: "/^jdbc-/", MaxAttempts: 10},
+		{Connector: "/^jdbc-/", MaxAttempts: 7},
+	}
+	supervisor := newTestRemediationSupervisor(t, cfg)
+
+	assert.Equal(t, 10, supervisor.policyFor("analytics", "jdbc-orders").maxAttempts)
+	assert.Equal(t, 7, supervisor.policyFor("billing", "jdbc-orders").maxAttempts)
+	assert.Equal(t, 5, supervisor.policyFor("billing", "s3-sink").maxAttempts)
+	// Unset properties are inherited from the default policy
+	assert.Equal(t, cfg.DefaultPolicy.BaseInterval, supervisor.policyFor("billing", "jdbc-orders").backoff.BaseInterval)
+}
+
+func TestRemediationSupervisorRemediateCluster(t *testing.T) {
+	var mu sync.Mutex
+	restarts := make([]string, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		restarts = append(restarts, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	client := con.NewClient(con.WithHost(srv.URL))
+
+	cfg := config.KafkaConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.DefaultPolicy.MaxAttempts = 2
+	cfg.DefaultPolicy.BaseInterval = time.Minute
+	cfg.DefaultPolicy.ResetAfter = 10 * time.Minute
+	supervisor := newTestRemediationSupervisor(t, cfg)
+
+	connectors := func(taskState string) map[string]con.ListConnectorsResponseExpanded {
+		return map[string]con.ListConnectorsResponseExpanded{
+			"jdbc-orders": {
+				Status: con.ConnectorStateInfo{
+					Name:      "jdbc-orders",
+					Connector: con.ConnectorState{State: connectorStateRunning},
+					Tasks: []con.TaskState{
+						{ID: 0, State: connectorStateRunning},
+						{ID: 1, State: taskState, Trace: "java.sql.SQLTransientConnectionException: refused\n\tat ..."},
+					},
+				},
+			},
+		}
+	}
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	failed := connectors(connectorStateFailed)
+
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now)
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now.Add(30*time.Second))
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now.Add(time.Minute))
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now.Add(time.Hour))
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now.Add(2*time.Hour))
+
+	assert.Equal(t, []string{
+		"POST /connectors/jdbc-orders/tasks/1/restart",
+		"POST /connectors/jdbc-orders/tasks/1/restart",
+	}, restarts)
+
+	events := supervisor.Events("", "jdbc-orders")
+	require.Len(t, events, 3)
+	assert.Equal(t, RemediationActionGaveUp, events[0].Action)
+	assert.Equal(t, RemediationActionRestarted, events[1].Action)
+	assert.Equal(t, 2, events[1].Attempt)
+	assert.Nil(t, events[1].NextAttemptAt)
+	assert.Equal(t, RemediationActionRestarted, events[2].Action)
+	assert.Equal(t, 1, events[2].Attempt)
+	require.NotNil(t, events[2].NextAttemptAt)
+	assert.Equal(t, now.Add(time.Minute), *events[2].NextAttemptAt)
+	require.NotNil(t, events[2].TaskID)
+	assert.Equal(t, 1, *events[2].TaskID)
+	assert.Equal(t, "java.sql.SQLTransientConnectionException: refused", events[2].Trace)
+	assert.Empty(t, supervisor.Events("billing", ""))
+
+	// Once the task has been healthy for resetAfter, attempts start over
+	supervisor.remediateCluster(ctx, "analytics", client, connectors(connectorStateRunning), now.Add(3*time.Hour))
+	supervisor.remediateCluster(ctx, "analytics", client, failed, now.Add(4*time.Hour))
+	assert.Len(t, restarts, 3)
+	assert.Equal(t, 1, supervisor.Events("analytics", "")[0].Attempt)
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// clusterDiscoverer returns the currently available Kafka connect clusters.
+type clusterDiscoverer interface {
+	Discover(ctx context.Context) ([]config.KafkaConnectCluster, error)
+}
+
+func newClusterDiscoverer(cfg config.KafkaConnectClusterDiscovery) (clusterDiscoverer, error) {
+	switch cfg.Type {
+	case config.KafkaConnectClusterDiscoveryFile:
+		return &fileClusterDiscoverer{cfg: cfg}, nil
+	case config.KafkaConnectClusterDiscoveryDNSSRV:
+		return &srvClusterDiscoverer{cfg: cfg, resolver: net.DefaultResolver}, nil
+	default:
+		return nil, fmt.Errorf("unknown discovery type %q", cfg.Type)
+	}
+}
+
+// discoveredCluster creates the cluster config for a discovered cluster, using the
+// authentication settings of the discovery config.
+func discoveredCluster(cfg config.KafkaConnectClusterDiscovery, name, url string) config.KafkaConnectCluster {
+	return config.KafkaConnectCluster{
+		Name:     name,
+		URL:      url,
+		TLS:      cfg.TLS,
+		Username: cfg.Username,
+		Password: cfg.Password,
+		Token:    cfg.Token,
+	}
+}
+
+// fileClusterDiscoverer reads the clusters from a YAML file that contains a list
+// of clusters, each with a name and url.
+type fileClusterDiscoverer struct {
+	cfg config.KafkaConnectClusterDiscovery
+}
+
+func (f *fileClusterDiscoverer) Discover(_ context.Context) ([]config.KafkaConnectCluster, error) {
+	content, err := os.ReadFile(f.cfg.Filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read discovery file: %w", err)
+	}
+
+	var entries []struct {
+		Name string `yaml:"name"`
+		URL  string `yaml:"url"`
+	}
+	if err := yaml.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery file: %w", err)
+	}
+
+	clusters := make([]config.KafkaConnectCluster, 0, len(entries))
+	for i, entry := range entries {
+		if entry.Name == "" || entry.URL == "" {
+			return nil, fmt.Errorf("cluster at index '%d' in discovery file must have a name and url", i)
+		}
+		clusters = append(clusters, discoveredCluster(f.cfg, entry.Name, entry.URL))
+	}
+	return clusters, nil
+}
+
+// srvResolver is the subset of net.Resolver that is used for the DNS SRV discovery.
+type srvResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// srvClusterDiscoverer discovers one cluster for each target of a DNS SRV record.
+// Clusters are named after the target host.
+type srvClusterDiscoverer struct {
+	cfg      config.KafkaConnectClusterDiscovery
+	resolver srvResolver
+}
+
+func (s *srvClusterDiscoverer) Discover(ctx context.Context) ([]config.KafkaConnectCluster, error) {
+	_, records, err := s.resolver.LookupSRV(ctx, "", "", s.cfg.SRVRecord)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			// No clusters are available at the moment
+			return []config.KafkaConnectCluster{}, nil
+		}
+		return nil, fmt.Errorf("failed to lookup srv record: %w", err)
+	}
+
+	clusters := make([]config.KafkaConnectCluster, 0, len(records))
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		url := fmt.Sprintf("%s://%s", s.cfg.Scheme, net.JoinHostPort(host, strconv.Itoa(int(record.Port))))
+		clusters = append(clusters, discoveredCluster(s.cfg, host, url))
+	}
+	return clusters, nil
+}
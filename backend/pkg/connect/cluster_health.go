@@ -0,0 +1,107 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ClusterHealthStatus is the result of the latest health check of a cluster.
+type ClusterHealthStatus string
+
+const (
+	// ClusterHealthStatusUnknown is reported until the cluster has been probed.
+	ClusterHealthStatusUnknown ClusterHealthStatus = "UNKNOWN"
+	// ClusterHealthStatusHealthy is reported if the cluster's REST API was reachable.
+	ClusterHealthStatusHealthy ClusterHealthStatus = "HEALTHY"
+	// ClusterHealthStatusUnhealthy is reported if the cluster's REST API was not reachable.
+	ClusterHealthStatusUnhealthy ClusterHealthStatus = "UNHEALTHY"
+)
+
+// ClusterHealth is the health of a Kafka connect cluster as determined by the
+// periodic health checks.
+type ClusterHealth struct {
+	Status              ClusterHealthStatus `json:"status"`
+	Version             string              `json:"version,omitempty"`
+	LastCheckedAt       *time.Time          `json:"lastCheckedAt,omitempty"`
+	LastHealthyAt       *time.Time          `json:"lastHealthyAt,omitempty"`
+	ConsecutiveFailures int                 `json:"consecutiveFailures"`
+	Error               string              `json:"error,omitempty"`
+}
+
+// Health returns the health of the cluster with the given name.
+func (r *ClusterRegistry) Health(clusterName string) ClusterHealth {
+	r.healthMu.RLock()
+	defer r.healthMu.RUnlock()
+
+	health, exists := r.health[clusterName]
+	if !exists {
+		return ClusterHealth{Status: ClusterHealthStatusUnknown}
+	}
+	return health
+}
+
+// probeAll probes all clusters concurrently.
+func (r *ClusterRegistry) probeAll(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, c := range r.svc.Clusters() {
+		wg.Go(func() {
+			r.probe(ctx, c)
+		})
+	}
+	wg.Wait()
+}
+
+// probe requests the root resource of the cluster and records the cluster's health.
+func (r *ClusterRegistry) probe(ctx context.Context, c *ClientWithConfig) {
+	childCtx, cancel := context.WithTimeout(ctx, r.svc.Cfg.ConnectTimeout)
+	defer cancel()
+	root, err := c.Client.GetRoot(childCtx)
+	now := time.Now()
+
+	r.healthMu.Lock()
+	defer r.healthMu.Unlock()
+
+	// The cluster may have been removed while it was probed
+	if current, exists := r.svc.Clusters()[c.Cfg.Name]; !exists || current != c {
+		return
+	}
+
+	health := r.health[c.Cfg.Name]
+	health.LastCheckedAt = &now
+	if err != nil {
+		if health.Status != ClusterHealthStatusUnhealthy {
+			r.logger.WarnContext(ctx, "connect cluster is not reachable",
+				slog.String("cluster_name", c.Cfg.Name),
+				slog.String("cluster_address", c.Cfg.URL),
+				slog.Any("error", err))
+		}
+		health.Status = ClusterHealthStatusUnhealthy
+		health.ConsecutiveFailures++
+		health.Error = err.Error()
+	} else {
+		health.Status = ClusterHealthStatusHealthy
+		health.Version = root.Version
+		health.LastHealthyAt = &now
+		health.ConsecutiveFailures = 0
+		health.Error = ""
+	}
+	r.health[c.Cfg.Name] = health
+}
+
+func (r *ClusterRegistry) removeHealth(clusterName string) {
+	r.healthMu.Lock()
+	defer r.healthMu.Unlock()
+
+	delete(r.health, clusterName)
+}
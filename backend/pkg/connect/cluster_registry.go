@@ -0,0 +1,493 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// ClusterSource describes where a Kafka connect cluster has been configured.
+type ClusterSource string
+
+const (
+	// ClusterSourceStatic is a cluster from Console's configuration.
+	ClusterSourceStatic ClusterSource = "STATIC"
+	// ClusterSourceRuntime is a cluster that has been registered via the API.
+	ClusterSourceRuntime ClusterSource = "RUNTIME"
+	// ClusterSourceDiscovery is a cluster that has been discovered.
+	ClusterSourceDiscovery ClusterSource = "DISCOVERY"
+)
+
+// ClusterStatus describes a registered Kafka connect cluster along with its health.
+// It does not contain any credentials.
+type ClusterStatus struct {
+	Name   string        `json:"name"`
+	URL    string        `json:"url"`
+	Source ClusterSource `json:"source"`
+	Health ClusterHealth `json:"health"`
+}
+
+// ClusterRegistry adds, updates and removes Kafka connect clusters while Console is
+// running. Clusters registered via the API are persisted in a local file, discovered
+// clusters are synced with the discovery source. It also probes the health of all
+// clusters periodically.
+type ClusterRegistry struct {
+	svc    *Service
+	cfg    config.KafkaConnectClusterRegistry
+	logger *slog.Logger
+
+	// store is nil if clusters can't be registered via the API.
+	store *FileClusterStore
+	// discoverer is nil if discovery is disabled.
+	discoverer clusterDiscoverer
+
+	// mu serializes all changes to the registered clusters.
+	mu sync.Mutex
+
+	healthMu sync.RWMutex
+	health   map[string]ClusterHealth
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewClusterRegistry creates a cluster registry for the given service and registers all
+// clusters that have been persisted before. It does not probe or discover clusters
+// until Start is called.
+func NewClusterRegistry(svc *Service, cfg config.KafkaConnectClusterRegistry) (*ClusterRegistry, error) {
+	r := &ClusterRegistry{
+		svc:    svc,
+		cfg:    cfg,
+		logger: svc.Logger.With(slog.String("component", "connect_cluster_registry")),
+		health: make(map[string]ClusterHealth),
+	}
+
+	if cfg.Discovery.Enabled {
+		discoverer, err := newClusterDiscoverer(cfg.Discovery)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cluster discovery: %w", err)
+		}
+		r.discoverer = discoverer
+	}
+
+	if !cfg.Enabled {
+		return r, nil
+	}
+	r.store = NewFileClusterStore(cfg.StoreFilepath)
+	clusters, err := r.store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registered clusters: %w", err)
+	}
+	for _, clusterCfg := range clusters {
+		c := newClientWithConfig(clusterCfg, ClusterSourceRuntime, svc.Cfg.ReadTimeout, svc.Logger)
+		if !svc.addCluster(c) {
+			r.logger.Warn("skipping registered cluster, because a cluster with the same name is already configured",
+				slog.String("cluster_name", clusterCfg.Name))
+		}
+	}
+	r.logger.Info("loaded registered Kafka connect clusters", slog.Int("clusters", len(clusters)))
+
+	return r, nil
+}
+
+// Start probes the health of all clusters and syncs the discovered clusters in the
+// background until Stop is called.
+func (r *ClusterRegistry) Start(ctx context.Context) {
+	// The start context expires once the startup has finished
+	ctx, r.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	if r.cfg.HealthCheckInterval > 0 {
+		r.runPeriodically(ctx, r.cfg.HealthCheckInterval, r.probeAll)
+		r.logger.InfoContext(ctx, "started Kafka connect cluster health checks", slog.Duration("interval", r.cfg.HealthCheckInterval))
+	}
+	if r.discoverer != nil {
+		r.runPeriodically(ctx, r.cfg.Discovery.RefreshInterval, r.discover)
+		r.logger.InfoContext(ctx, "started Kafka connect cluster discovery",
+			slog.String("type", r.cfg.Discovery.Type), slog.Duration("refresh_interval", r.cfg.Discovery.RefreshInterval))
+	}
+}
+
+// Stop stops all background tasks and waits for them to finish.
+func (r *ClusterRegistry) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+}
+
+// runPeriodically calls fn immediately and then in the given interval until the
+// context is cancelled.
+func (r *ClusterRegistry) runPeriodically(ctx context.Context, interval time.Duration, fn func(context.Context)) {
+	r.wg.Go(func() {
+		fn(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	})
+}
+
+// Clusters returns a snapshot of all Kafka connect clusters, keyed by their name.
+func (s *Service) Clusters() map[string]*ClientWithConfig {
+	s.clustersMutex.RLock()
+	defer s.clustersMutex.RUnlock()
+
+	return maps.Clone(s.ClientsByCluster)
+}
+
+// addCluster adds the cluster unless a cluster with the same name exists already.
+func (s *Service) addCluster(c *ClientWithConfig) bool {
+	s.clustersMutex.Lock()
+	defer s.clustersMutex.Unlock()
+
+	if _, exists := s.ClientsByCluster[c.Cfg.Name]; exists {
+		return false
+	}
+	s.ClientsByCluster[c.Cfg.Name] = c
+	return true
+}
+
+// setCluster adds or replaces the cluster.
+func (s *Service) setCluster(c *ClientWithConfig) {
+	s.clustersMutex.Lock()
+	defer s.clustersMutex.Unlock()
+
+	s.ClientsByCluster[c.Cfg.Name] = c
+}
+
+// removeCluster removes the cluster with the given name.
+func (s *Service) removeCluster(clusterName string) {
+	s.clustersMutex.Lock()
+	defer s.clustersMutex.Unlock()
+
+	delete(s.ClientsByCluster, clusterName)
+}
+
+// ListClusterStatuses returns all Kafka connect clusters along with their health,
+// ordered by their name.
+func (s *Service) ListClusterStatuses() []ClusterStatus {
+	clusters := s.Clusters()
+	statuses := make([]ClusterStatus, 0, len(clusters))
+	for _, name := range slices.Sorted(maps.Keys(clusters)) {
+		statuses = append(statuses, s.clusterStatus(clusters[name]))
+	}
+	return statuses
+}
+
+// RegisterCluster adds a Kafka connect cluster and persists it, so that it is still
+// registered after Console has been restarted.
+func (s *Service) RegisterCluster(ctx context.Context, clusterCfg config.KafkaConnectCluster) (ClusterStatus, *rest.Error) {
+	r, restErr := s.clusterRegistryWithStore()
+	if restErr != nil {
+		return ClusterStatus{}, restErr
+	}
+	if err := r.checkRuntimeCluster(&clusterCfg); err != nil {
+		return ClusterStatus{}, invalidClusterConfigError(clusterCfg.Name, err)
+	}
+	if err := clusterCfg.Validate(); err != nil {
+		return ClusterStatus{}, invalidClusterConfigError(clusterCfg.Name, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := newClientWithConfig(clusterCfg, ClusterSourceRuntime, s.Cfg.ReadTimeout, s.Logger)
+	if !s.addCluster(c) {
+		return ClusterStatus{}, &rest.Error{
+			Err:          errors.New("a cluster with the given name exists already"),
+			Status:       http.StatusConflict,
+			Message:      fmt.Sprintf("A Kafka connect cluster with the name %q exists already", clusterCfg.Name),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterCfg.Name)},
+			IsSilent:     false,
+		}
+	}
+	if err := r.persist(); err != nil {
+		s.removeCluster(clusterCfg.Name)
+		return ClusterStatus{}, persistClustersError(clusterCfg.Name, err)
+	}
+	r.logger.InfoContext(ctx, "registered Kafka connect cluster",
+		slog.String("cluster_name", clusterCfg.Name), slog.String("cluster_address", clusterCfg.URL))
+
+	r.probe(ctx, c)
+	return s.clusterStatus(c), nil
+}
+
+// UpdateCluster replaces the configuration of a Kafka connect cluster that has been
+// registered via the API. Statically configured and discovered clusters can't be updated.
+func (s *Service) UpdateCluster(ctx context.Context, clusterName string, clusterCfg config.KafkaConnectCluster) (ClusterStatus, *rest.Error) {
+	r, restErr := s.clusterRegistryWithStore()
+	if restErr != nil {
+		return ClusterStatus{}, restErr
+	}
+	clusterCfg.Name = clusterName
+	if err := r.checkRuntimeCluster(&clusterCfg); err != nil {
+		return ClusterStatus{}, invalidClusterConfigError(clusterName, err)
+	}
+	if err := clusterCfg.Validate(); err != nil {
+		return ClusterStatus{}, invalidClusterConfigError(clusterName, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, restErr := s.getRuntimeCluster(clusterName)
+	if restErr != nil {
+		return ClusterStatus{}, restErr
+	}
+	c := newClientWithConfig(clusterCfg, ClusterSourceRuntime, s.Cfg.ReadTimeout, s.Logger)
+	s.setCluster(c)
+	if err := r.persist(); err != nil {
+		s.setCluster(previous)
+		return ClusterStatus{}, persistClustersError(clusterName, err)
+	}
+	r.logger.InfoContext(ctx, "updated Kafka connect cluster",
+		slog.String("cluster_name", clusterName), slog.String("cluster_address", clusterCfg.URL))
+
+	r.probe(ctx, c)
+	return s.clusterStatus(c), nil
+}
+
+// RemoveCluster removes a Kafka connect cluster that has been registered via the API.
+// Statically configured and discovered clusters can't be removed.
+func (s *Service) RemoveCluster(ctx context.Context, clusterName string) *rest.Error {
+	r, restErr := s.clusterRegistryWithStore()
+	if restErr != nil {
+		return restErr
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, restErr := s.getRuntimeCluster(clusterName)
+	if restErr != nil {
+		return restErr
+	}
+	s.removeCluster(clusterName)
+	if err := r.persist(); err != nil {
+		s.setCluster(previous)
+		return persistClustersError(clusterName, err)
+	}
+	r.removeHealth(clusterName)
+	r.logger.InfoContext(ctx, "removed Kafka connect cluster", slog.String("cluster_name", clusterName))
+
+	return nil
+}
+
+func (s *Service) clusterRegistryWithStore() (*ClusterRegistry, *rest.Error) {
+	if s.Registry == nil || s.Registry.store == nil {
+		return nil, &rest.Error{
+			Err:      errors.New("kafka connect cluster registry is disabled"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Registering Kafka connect clusters is not enabled in Redpanda Console",
+			IsSilent: false,
+		}
+	}
+	return s.Registry, nil
+}
+
+// checkRuntimeCluster restricts the clusters that can be registered via the API
+// to the allowed hosts, and their TLS files to the TLS directory. The relative
+// TLS file paths are replaced with the resolved paths.
+func (r *ClusterRegistry) checkRuntimeCluster(clusterCfg *config.KafkaConnectCluster) error {
+	u, err := url.Parse(clusterCfg.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme %q is invalid, must be either http or https", u.Scheme)
+	}
+	if u.User != nil {
+		return errors.New("url must not contain credentials, use the username and password fields instead")
+	}
+	if !r.isAllowedHost(u) {
+		return fmt.Errorf("host %q is not allowed", u.Host)
+	}
+
+	for _, file := range []*string{&clusterCfg.TLS.CaFilepath, &clusterCfg.TLS.CertFilepath, &clusterCfg.TLS.KeyFilepath} {
+		if *file == "" {
+			continue
+		}
+		resolved, err := r.resolveTLSFile(*file)
+		if err != nil {
+			return err
+		}
+		*file = resolved
+	}
+	return nil
+}
+
+func (r *ClusterRegistry) isAllowedHost(u *url.URL) bool {
+	for _, pattern := range r.cfg.AllowedHosts {
+		host := u.Hostname()
+		if strings.Contains(pattern, ":") {
+			host = u.Host
+		}
+		if matched, _ := path.Match(pattern, host); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTLSFile returns the path of a TLS file relative to the TLS directory.
+// Paths that leave the directory, including via symlinks, are rejected.
+func (r *ClusterRegistry) resolveTLSFile(file string) (string, error) {
+	if r.cfg.TLSDirectory == "" {
+		return "", errors.New("tls files can't be used, because no tls directory is configured")
+	}
+	if !filepath.IsLocal(file) {
+		return "", fmt.Errorf("tls file %q must be a relative path within the tls directory", file)
+	}
+	dir, err := filepath.EvalSymlinks(r.cfg.TLSDirectory)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve tls directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(dir, file))
+	if err != nil {
+		return "", fmt.Errorf("tls file %q can't be resolved: %w", file, err)
+	}
+	if rel, err := filepath.Rel(dir, resolved); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("tls file %q must be within the tls directory", file)
+	}
+	return resolved, nil
+}
+
+// getRuntimeCluster returns the cluster with the given name if it has been registered via the API.
+func (s *Service) getRuntimeCluster(clusterName string) (*ClientWithConfig, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if c.Source != ClusterSourceRuntime {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("cluster source is %s", c.Source),
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("The Kafka connect cluster %q is not registered via the API and can't be modified", clusterName),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	return c, nil
+}
+
+func (s *Service) clusterStatus(c *ClientWithConfig) ClusterStatus {
+	status := ClusterStatus{
+		Name:   c.Cfg.Name,
+		URL:    c.Cfg.URL,
+		Source: c.Source,
+		Health: ClusterHealth{Status: ClusterHealthStatusUnknown},
+	}
+	if s.Registry != nil {
+		status.Health = s.Registry.Health(c.Cfg.Name)
+	}
+	return status
+}
+
+// persist stores all clusters that have been registered via the API. The caller
+// must hold the registry's mutex.
+func (r *ClusterRegistry) persist() error {
+	clusters := r.svc.Clusters()
+	runtimeClusters := make([]config.KafkaConnectCluster, 0)
+	for _, name := range slices.Sorted(maps.Keys(clusters)) {
+		if clusters[name].Source == ClusterSourceRuntime {
+			runtimeClusters = append(runtimeClusters, clusters[name].Cfg)
+		}
+	}
+	return r.store.Save(runtimeClusters)
+}
+
+// discover syncs the discovered clusters with the discovery source. Clusters that
+// are not discovered anymore are removed. If the discovery fails, the previously
+// discovered clusters are kept.
+func (r *ClusterRegistry) discover(ctx context.Context) {
+	discovered, err := r.discoverer.Discover(ctx)
+	if err != nil {
+		r.logger.WarnContext(ctx, "failed to discover Kafka connect clusters", slog.Any("error", err))
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clusters := r.svc.Clusters()
+	discoveredNames := make(map[string]struct{}, len(discovered))
+	for _, clusterCfg := range discovered {
+		discoveredNames[clusterCfg.Name] = struct{}{}
+		existing, exists := clusters[clusterCfg.Name]
+		switch {
+		case !exists:
+			r.logger.InfoContext(ctx, "discovered Kafka connect cluster",
+				slog.String("cluster_name", clusterCfg.Name), slog.String("cluster_address", clusterCfg.URL))
+		case existing.Source != ClusterSourceDiscovery:
+			r.logger.DebugContext(ctx, "skipping discovered cluster, because a cluster with the same name is already configured",
+				slog.String("cluster_name", clusterCfg.Name))
+			continue
+		case existing.Cfg.URL == clusterCfg.URL:
+			continue
+		default:
+			r.logger.InfoContext(ctx, "address of discovered Kafka connect cluster changed",
+				slog.String("cluster_name", clusterCfg.Name), slog.String("cluster_address", clusterCfg.URL))
+		}
+		r.svc.setCluster(newClientWithConfig(clusterCfg, ClusterSourceDiscovery, r.svc.Cfg.ReadTimeout, r.svc.Logger))
+	}
+
+	for name, c := range clusters {
+		if _, exists := discoveredNames[name]; exists || c.Source != ClusterSourceDiscovery {
+			continue
+		}
+		r.svc.removeCluster(name)
+		r.removeHealth(name)
+		r.logger.InfoContext(ctx, "removed Kafka connect cluster that is no longer discovered", slog.String("cluster_name", name))
+	}
+}
+
+func invalidClusterConfigError(clusterName string, err error) *rest.Error {
+	return &rest.Error{
+		Err:          err,
+		Status:       http.StatusBadRequest,
+		Message:      fmt.Sprintf("Invalid Kafka connect cluster configuration: %v", err.Error()),
+		InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName)},
+		IsSilent:     false,
+	}
+}
+
+func persistClustersError(clusterName string, err error) *rest.Error {
+	return &rest.Error{
+		Err:          err,
+		Status:       http.StatusInternalServerError,
+		Message:      fmt.Sprintf("Failed to persist registered Kafka connect clusters: %v", err.Error()),
+		InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName)},
+		IsSilent:     false,
+	}
+}
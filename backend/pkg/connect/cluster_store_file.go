@@ -0,0 +1,105 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// FileClusterStore persists the Kafka connect clusters that have been registered
+// via the API in a local YAML file. The file uses the same format as the clusters
+// in Console's configuration, including the cluster credentials in plaintext.
+// Therefore the file must only be accessible by the user that runs Console.
+type FileClusterStore struct {
+	path string
+
+	mu sync.Mutex
+}
+
+// NewFileClusterStore creates a cluster store that persists clusters in the given file.
+func NewFileClusterStore(path string) *FileClusterStore {
+	return &FileClusterStore{path: path}
+}
+
+// Load returns all persisted clusters. It returns no clusters if the file does not exist
+// and an error if the file is accessible by other users than its owner.
+func (f *FileClusterStore) Load() ([]config.KafkaConnectCluster, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.checkPermissions(); err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []config.KafkaConnectCluster{}, nil
+		}
+		return nil, fmt.Errorf("failed to read cluster store: %w", err)
+	}
+
+	var clusters []config.KafkaConnectCluster
+	if err := yaml.Unmarshal(content, &clusters); err != nil {
+		return nil, fmt.Errorf("failed to decode cluster store: %w", err)
+	}
+	return clusters, nil
+}
+
+// Save replaces all persisted clusters atomically.
+func (f *FileClusterStore) Save(clusters []config.KafkaConnectCluster) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	content, err := yaml.Marshal(clusters)
+	if err != nil {
+		return fmt.Errorf("failed to encode cluster store: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create cluster store directory: %w", err)
+	}
+	tmpPath := f.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write cluster store: %w", err)
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return fmt.Errorf("failed to write cluster store: %w", err)
+	}
+	return nil
+}
+
+// checkPermissions returns an error if the store file exists and its permissions are
+// wider than 0600. Windows does not support Unix permissions, so it is not checked there.
+func (f *FileClusterStore) checkPermissions() error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	info, err := os.Stat(f.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read cluster store: %w", err)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return fmt.Errorf("cluster store %q contains credentials, but its permissions %#o are wider than 0600", f.path, perm)
+	}
+	return nil
+}
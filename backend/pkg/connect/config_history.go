@@ -0,0 +1,253 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+
+	"github.com/redpanda-data/console/backend/pkg/actor"
+)
+
+// ConnectorConfigOperation describes how a connector config version has been applied.
+type ConnectorConfigOperation string
+
+const (
+	// ConnectorConfigOperationCreate is a config that has been applied by creating a connector.
+	ConnectorConfigOperationCreate ConnectorConfigOperation = "CREATE"
+	// ConnectorConfigOperationUpdate is a config that has been applied by updating a connector.
+	ConnectorConfigOperationUpdate ConnectorConfigOperation = "UPDATE"
+	// ConnectorConfigOperationRollback is a config that has been applied by rolling back
+	// to a previous version.
+	ConnectorConfigOperationRollback ConnectorConfigOperation = "ROLLBACK"
+)
+
+// redactedConfigValue replaces the values of sensitive connector config properties.
+const redactedConfigValue = "[REDACTED]"
+
+// sensitiveConfigKeyPattern matches connector config properties that are likely to
+// contain credentials. Values that reference a config provider, such as
+// ${secretsManager:...}, are not considered sensitive.
+var sensitiveConfigKeyPattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|private\.key|api\.key|access\.key|sasl\.jaas\.config|\.key$)`)
+
+// ConnectorConfigVersion is a single connector configuration that has been applied via Console.
+type ConnectorConfigVersion struct {
+	ClusterName   string                   `json:"clusterName"`
+	ConnectorName string                   `json:"connectorName"`
+	Version       int                      `json:"version"`
+	Operation     ConnectorConfigOperation `json:"operation"`
+	// RolledBackFrom is the version whose config has been restored, if
+	// Operation is ROLLBACK.
+	RolledBackFrom int       `json:"rolledBackFrom,omitempty"`
+	Actor          string    `json:"actor"`
+	Timestamp      time.Time `json:"timestamp"`
+	// Config is the applied connector config with sensitive values redacted.
+	Config map[string]string `json:"config"`
+}
+
+// ConfigHistoryStore persists connector config versions.
+type ConfigHistoryStore interface {
+	// Append stores the given config as the next version of the connector
+	// and sets the assigned version number on it.
+	Append(ctx context.Context, version *ConnectorConfigVersion) error
+	// ListVersions returns all retained versions of the connector, ordered
+	// by version ascending.
+	ListVersions(ctx context.Context, clusterName, connectorName string) ([]ConnectorConfigVersion, error)
+}
+
+// ConnectorConfigDiff describes the changes between two connector config versions.
+type ConnectorConfigDiff struct {
+	FromVersion int                                 `json:"fromVersion"`
+	ToVersion   int                                 `json:"toVersion"`
+	Added       map[string]string                   `json:"added"`
+	Removed     map[string]string                   `json:"removed"`
+	Changed     map[string]ConnectorConfigDiffValue `json:"changed"`
+}
+
+// ConnectorConfigDiffValue is a config property whose value has changed.
+type ConnectorConfigDiffValue struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// redactConnectorConfig returns a copy of the config with the values of all
+// sensitive properties replaced. Properties are sensitive if their name looks like
+// they contain credentials or if they are part of the given sensitive keys.
+func redactConnectorConfig(config map[string]string, sensitiveKeys ...string) map[string]string {
+	redacted := make(map[string]string, len(config))
+	for k, v := range config {
+		isSensitive := sensitiveConfigKeyPattern.MatchString(k) || slices.Contains(sensitiveKeys, k)
+		if isSensitive && !isConfigProviderReference(v) {
+			v = redactedConfigValue
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// diffConnectorConfigs returns the changes from one connector config to another.
+func diffConnectorConfigs(from, to map[string]string) (added, removed map[string]string, changed map[string]ConnectorConfigDiffValue) {
+	added = make(map[string]string)
+	removed = make(map[string]string)
+	changed = make(map[string]ConnectorConfigDiffValue)
+	for k, toValue := range to {
+		fromValue, exists := from[k]
+		switch {
+		case !exists:
+			added[k] = toValue
+		case fromValue != toValue:
+			changed[k] = ConnectorConfigDiffValue{From: fromValue, To: toValue}
+		}
+	}
+	for k, fromValue := range from {
+		if _, exists := to[k]; !exists {
+			removed[k] = fromValue
+		}
+	}
+	return added, removed, changed
+}
+
+// recordConnectorConfig stores the applied connector config as a new version, unless
+// it is identical to the latest version. Failures are logged but not returned, because
+// the config has already been applied to the connect cluster at this point.
+func (s *Service) recordConnectorConfig(ctx context.Context, clusterName, connectorName string, op ConnectorConfigOperation, rolledBackFrom int, config map[string]string) {
+	if s.ConfigHistory == nil {
+		return
+	}
+	logger := s.Logger.With(slog.String("cluster_name", clusterName), slog.String("connector_name", connectorName))
+
+	redacted := redactConnectorConfig(config, s.sensitiveConfigKeys(ctx, clusterName, config["connector.class"])...)
+	versions, err := s.ConfigHistory.ListVersions(ctx, clusterName, connectorName)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to list connector config versions", slog.Any("error", err))
+		return
+	}
+	if len(versions) > 0 && op != ConnectorConfigOperationRollback && maps.Equal(versions[len(versions)-1].Config, redacted) {
+		return
+	}
+
+	version := &ConnectorConfigVersion{
+		ClusterName:    clusterName,
+		ConnectorName:  connectorName,
+		Operation:      op,
+		RolledBackFrom: rolledBackFrom,
+		Actor:          actor.FromContext(ctx),
+		Timestamp:      time.Now().UTC(),
+		Config:         redacted,
+	}
+	if err := s.ConfigHistory.Append(ctx, version); err != nil {
+		logger.WarnContext(ctx, "failed to store connector config version", slog.Any("error", err))
+	}
+}
+
+// ListConnectorConfigVersions returns all retained config versions of a connector.
+func (s *Service) ListConnectorConfigVersions(ctx context.Context, clusterName, connectorName string) ([]ConnectorConfigVersion, *rest.Error) {
+	if restErr := s.checkConfigHistoryEnabled(); restErr != nil {
+		return nil, restErr
+	}
+	if _, restErr := s.getConnectClusterByName(clusterName); restErr != nil {
+		return nil, restErr
+	}
+
+	versions, err := s.ConfigHistory.ListVersions(ctx, clusterName, connectorName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to list connector config versions: %w", err),
+			Status:       http.StatusServiceUnavailable,
+			Message:      fmt.Sprintf("Failed to list connector config versions: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector_name", connectorName)},
+			IsSilent:     false,
+		}
+	}
+	return versions, nil
+}
+
+// DiffConnectorConfigVersions compares two config versions of a connector.
+func (s *Service) DiffConnectorConfigVersions(ctx context.Context, clusterName, connectorName string, fromVersion, toVersion int) (ConnectorConfigDiff, *rest.Error) {
+	versions, restErr := s.ListConnectorConfigVersions(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return ConnectorConfigDiff{}, restErr
+	}
+	from, restErr := findConnectorConfigVersion(versions, connectorName, fromVersion)
+	if restErr != nil {
+		return ConnectorConfigDiff{}, restErr
+	}
+	to, restErr := findConnectorConfigVersion(versions, connectorName, toVersion)
+	if restErr != nil {
+		return ConnectorConfigDiff{}, restErr
+	}
+
+	added, removed, changed := diffConnectorConfigs(from.Config, to.Config)
+	return ConnectorConfigDiff{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Added:       added,
+		Removed:     removed,
+		Changed:     changed,
+	}, nil
+}
+
+// RollbackConnectorConfig applies the config of a previous version to the connector.
+// Because sensitive values are redacted in the history, they are taken from the
+// connector's current config. If the current config does not contain a redacted
+// property anymore, the rollback is rejected (see restoreRedactedConfigValues).
+func (s *Service) RollbackConnectorConfig(ctx context.Context, clusterName, connectorName string, version int) (con.ConnectorInfo, *rest.Error) {
+	versions, restErr := s.ListConnectorConfigVersions(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	target, restErr := findConnectorConfigVersion(versions, connectorName, version)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+
+	config := make(map[string]any, len(target.Config))
+	for k, v := range target.Config {
+		config[k] = v
+	}
+
+	return s.putConnectorConfig(ctx, clusterName, connectorName, con.PutConnectorConfigOptions{Config: config}, ConnectorConfigOperationRollback, version)
+}
+
+func (s *Service) checkConfigHistoryEnabled() *rest.Error {
+	if s.ConfigHistory == nil {
+		return &rest.Error{
+			Err:      errors.New("connector config history is not enabled"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Connector config history is not enabled. Set kafkaConnect.configHistory.enabled to keep track of connector configs",
+			IsSilent: true,
+		}
+	}
+	return nil
+}
+
+func findConnectorConfigVersion(versions []ConnectorConfigVersion, connectorName string, version int) (ConnectorConfigVersion, *rest.Error) {
+	for _, v := range versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return ConnectorConfigVersion{}, &rest.Error{
+		Err:      fmt.Errorf("config version %d of connector %q not found", version, connectorName),
+		Status:   http.StatusNotFound,
+		Message:  fmt.Sprintf("Config version %d of connector %q does not exist", version, connectorName),
+		IsSilent: false,
+	}
+}
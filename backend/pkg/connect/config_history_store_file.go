@@ -0,0 +1,113 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var _ ConfigHistoryStore = (*FileConfigHistoryStore)(nil)
+
+// FileConfigHistoryStore stores the config versions of each connector in a JSON
+// file inside a local directory. It is meant for single instance deployments.
+type FileConfigHistoryStore struct {
+	dir         string
+	maxVersions int
+
+	mu sync.Mutex
+}
+
+// NewFileConfigHistoryStore creates a config history store that persists versions
+// in the given directory. The directory is created if it does not exist.
+func NewFileConfigHistoryStore(dir string, maxVersions int) (*FileConfigHistoryStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create config history directory: %w", err)
+	}
+	return &FileConfigHistoryStore{dir: dir, maxVersions: maxVersions}, nil
+}
+
+// Append stores the config as the next version of the connector.
+func (f *FileConfigHistoryStore) Append(_ context.Context, version *ConnectorConfigVersion) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	versions, err := f.read(version.ClusterName, version.ConnectorName)
+	if err != nil {
+		return err
+	}
+	version.Version = 1
+	if len(versions) > 0 {
+		version.Version = versions[len(versions)-1].Version + 1
+	}
+	versions = append(versions, *version)
+	if len(versions) > f.maxVersions {
+		versions = versions[len(versions)-f.maxVersions:]
+	}
+
+	return f.write(version.ClusterName, version.ConnectorName, versions)
+}
+
+// ListVersions returns all retained versions of the connector.
+func (f *FileConfigHistoryStore) ListVersions(_ context.Context, clusterName, connectorName string) ([]ConnectorConfigVersion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.read(clusterName, connectorName)
+}
+
+func (f *FileConfigHistoryStore) path(clusterName, connectorName string) string {
+	// Cluster and connector names are escaped, so that they can't escape the directory.
+	return filepath.Join(f.dir, url.PathEscape(clusterName), url.PathEscape(connectorName)+".json")
+}
+
+func (f *FileConfigHistoryStore) read(clusterName, connectorName string) ([]ConnectorConfigVersion, error) {
+	content, err := os.ReadFile(f.path(clusterName, connectorName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []ConnectorConfigVersion{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config history: %w", err)
+	}
+
+	var versions []ConnectorConfigVersion
+	if err := json.Unmarshal(content, &versions); err != nil {
+		return nil, fmt.Errorf("failed to decode config history: %w", err)
+	}
+	return versions, nil
+}
+
+// write replaces the connector's history file atomically.
+func (f *FileConfigHistoryStore) write(clusterName, connectorName string, versions []ConnectorConfigVersion) error {
+	content, err := json.MarshalIndent(versions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config history: %w", err)
+	}
+
+	path := f.path(clusterName, connectorName)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create config history directory: %w", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write config history: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write config history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+var _ ConfigHistoryStore = (*KafkaConfigHistoryStore)(nil)
+
+const (
+	// kafkaConfigHistoryReadTimeout is the maximum duration for reading new records
+	// from the history topic.
+	kafkaConfigHistoryReadTimeout = 10 * time.Second
+	// kafkaConfigHistoryAppendAttempts is the number of versions that are tried
+	// if other Console instances assigned the same version concurrently.
+	kafkaConfigHistoryAppendAttempts = 3
+	// kafkaConfigHistoryWriterHeader is the record header that identifies the
+	// store instance that assigned the version.
+	kafkaConfigHistoryWriterHeader = "writer"
+)
+
+// KafkaConfigHistoryStore stores connector config versions in a compacted Kafka topic.
+// Each version is stored in its own record that is keyed by cluster, connector and
+// version. Versions that exceed the retention are deleted with tombstones.
+//
+// The versions are served from an in-memory index that is built by reading the
+// topic once and then only updated with records that have been appended since,
+// including the ones of other Console instances. Each instance marks its records
+// with a random writer ID. If two instances assign the same version concurrently,
+// the record with the lower offset wins, and the other instance restores the
+// winning record, so that compaction retains it, and retries with the next version.
+type KafkaConfigHistoryStore struct {
+	topic       string
+	maxVersions int
+	getClients  func(ctx context.Context) (*kgo.Client, *kadm.Client, error)
+	writerID    string
+
+	// mu guards the index and serializes appends of this instance.
+	mu           sync.Mutex
+	topicEnsured bool
+	// index contains all retained versions by connector, ordered by version.
+	index map[kafkaConfigHistoryConnector][]kafkaConfigHistoryEntry
+	// nextOffsets are the offsets by partition up to which the index has been built.
+	nextOffsets map[int32]int64
+}
+
+type kafkaConfigHistoryConnector struct {
+	ClusterName   string
+	ConnectorName string
+}
+
+type kafkaConfigHistoryKey struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+	Version       int    `json:"version"`
+}
+
+// kafkaConfigHistoryEntry is a version in the index along with the record it has
+// been read from.
+type kafkaConfigHistoryEntry struct {
+	version   ConnectorConfigVersion
+	value     []byte
+	writer    string
+	partition int32
+	offset    int64
+}
+
+// NewKafkaConfigHistoryStore creates a config history store that persists versions in
+// the given topic. The clients are retrieved on each access via getClients, so that
+// the store works with the same client factories as the rest of Console.
+func NewKafkaConfigHistoryStore(topic string, maxVersions int, getClients func(ctx context.Context) (*kgo.Client, *kadm.Client, error)) *KafkaConfigHistoryStore {
+	writerID := make([]byte, 8)
+	_, _ = rand.Read(writerID)
+
+	return &KafkaConfigHistoryStore{
+		topic:       topic,
+		maxVersions: maxVersions,
+		getClients:  getClients,
+		writerID:    hex.EncodeToString(writerID),
+		index:       make(map[kafkaConfigHistoryConnector][]kafkaConfigHistoryEntry),
+		nextOffsets: make(map[int32]int64),
+	}
+}
+
+// Append stores the config as the next version of the connector.
+func (k *KafkaConfigHistoryStore) Append(ctx context.Context, version *ConnectorConfigVersion) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	cl, adminCl, err := k.getClients(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.ensureTopic(ctx, adminCl); err != nil {
+		return err
+	}
+
+	for range kafkaConfigHistoryAppendAttempts {
+		if err := k.catchUp(ctx, cl, adminCl); err != nil {
+			return err
+		}
+		assigned, err := k.appendOnce(ctx, cl, adminCl, version)
+		if err != nil {
+			return err
+		}
+		if assigned {
+			return k.prune(ctx, cl, version.ClusterName, version.ConnectorName)
+		}
+	}
+	return errors.New("failed to assign a version to the connector config, because other instances assigned the same versions concurrently")
+}
+
+// ListVersions returns all retained versions of the connector.
+func (k *KafkaConfigHistoryStore) ListVersions(ctx context.Context, clusterName, connectorName string) ([]ConnectorConfigVersion, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	cl, adminCl, err := k.getClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.catchUp(ctx, cl, adminCl); err != nil {
+		return nil, err
+	}
+
+	entries := k.index[kafkaConfigHistoryConnector{clusterName, connectorName}]
+	versions := make([]ConnectorConfigVersion, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.version
+	}
+	return versions, nil
+}
+
+// appendOnce produces the config as the version that follows the latest indexed
+// version and reads the topic up to the produced record. It returns false if
+// another instance assigned the same version first. In this case the record of
+// the other instance is restored and the index is up to date to retry.
+func (k *KafkaConfigHistoryStore) appendOnce(ctx context.Context, cl *kgo.Client, adminCl *kadm.Client, version *ConnectorConfigVersion) (bool, error) {
+	connector := kafkaConfigHistoryConnector{version.ClusterName, version.ConnectorName}
+	version.Version = 1
+	if entries := k.index[connector]; len(entries) > 0 {
+		version.Version = entries[len(entries)-1].version.Version + 1
+	}
+
+	key, err := json.Marshal(kafkaConfigHistoryKey{version.ClusterName, version.ConnectorName, version.Version})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode record key: %w", err)
+	}
+	value, err := json.Marshal(version)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode config version: %w", err)
+	}
+	produced, err := cl.ProduceSync(ctx, k.record(key, value, k.writerID)).First()
+	if err != nil {
+		return false, fmt.Errorf("failed to produce config version: %w", err)
+	}
+	if err := k.catchUp(ctx, cl, adminCl); err != nil {
+		return false, err
+	}
+
+	entry, exists := k.entry(connector, version.Version)
+	if !exists {
+		return false, fmt.Errorf("config version %d has been deleted concurrently", version.Version)
+	}
+	if entry.writer == k.writerID && entry.partition == produced.Partition && entry.offset == produced.Offset {
+		return true, nil
+	}
+
+	// Another instance produced the same version before us. Compaction retains the
+	// last record per key, hence its record is produced again before retrying.
+	if err := cl.ProduceSync(ctx, k.record(key, entry.value, entry.writer)).FirstErr(); err != nil {
+		return false, fmt.Errorf("failed to restore concurrently assigned config version: %w", err)
+	}
+	return false, k.catchUp(ctx, cl, adminCl)
+}
+
+// prune deletes the oldest versions of the connector that exceed maxVersions.
+func (k *KafkaConfigHistoryStore) prune(ctx context.Context, cl *kgo.Client, clusterName, connectorName string) error {
+	connector := kafkaConfigHistoryConnector{clusterName, connectorName}
+	entries := k.index[connector]
+	if len(entries) <= k.maxVersions {
+		return nil
+	}
+
+	expired := entries[:len(entries)-k.maxVersions]
+	tombstones := make([]*kgo.Record, 0, len(expired))
+	for _, entry := range expired {
+		key, err := json.Marshal(kafkaConfigHistoryKey{clusterName, connectorName, entry.version.Version})
+		if err != nil {
+			return fmt.Errorf("failed to encode record key: %w", err)
+		}
+		tombstones = append(tombstones, k.record(key, nil, k.writerID))
+	}
+	if err := cl.ProduceSync(ctx, tombstones...).FirstErr(); err != nil {
+		return fmt.Errorf("failed to delete expired config versions: %w", err)
+	}
+	k.index[connector] = slices.Clone(entries[len(expired):])
+	return nil
+}
+
+func (k *KafkaConfigHistoryStore) record(key, value []byte, writer string) *kgo.Record {
+	return &kgo.Record{
+		Topic:   k.topic,
+		Key:     key,
+		Value:   value,
+		Headers: []kgo.RecordHeader{{Key: kafkaConfigHistoryWriterHeader, Value: []byte(writer)}},
+	}
+}
+
+func (k *KafkaConfigHistoryStore) entry(connector kafkaConfigHistoryConnector, version int) (kafkaConfigHistoryEntry, bool) {
+	entries := k.index[connector]
+	i, found := slices.BinarySearchFunc(entries, version, func(e kafkaConfigHistoryEntry, v int) int {
+		return e.version.Version - v
+	})
+	if !found {
+		return kafkaConfigHistoryEntry{}, false
+	}
+	return entries[i], true
+}
+
+// ensureTopic creates the compacted history topic if it does not exist yet.
+func (k *KafkaConfigHistoryStore) ensureTopic(ctx context.Context, adminCl *kadm.Client) error {
+	if k.topicEnsured {
+		return nil
+	}
+	cleanupPolicy := "compact"
+	res, err := adminCl.CreateTopic(ctx, 1, -1, map[string]*string{"cleanup.policy": &cleanupPolicy}, k.topic)
+	if err == nil {
+		err = res.Err
+	}
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create config history topic %q: %w", k.topic, err)
+	}
+	k.topicEnsured = true
+	return nil
+}
+
+// catchUp reads all records that have been appended to the history topic since
+// the last call and applies them to the index. The first call reads the whole topic.
+func (k *KafkaConfigHistoryStore) catchUp(ctx context.Context, cl *kgo.Client, adminCl *kadm.Client) error {
+	endOffsets, err := adminCl.ListEndOffsets(ctx, k.topic)
+	if err != nil {
+		return fmt.Errorf("failed to list end offsets of config history topic: %w", err)
+	}
+	if err := endOffsets.Error(); err != nil {
+		if errors.Is(err, kerr.UnknownTopicOrPartition) {
+			return nil
+		}
+		return fmt.Errorf("failed to list end offsets of config history topic: %w", err)
+	}
+
+	partitionOffsets := make(map[int32]kgo.Offset)
+	remaining := make(map[int32]int64)
+	endOffsets.Each(func(o kadm.ListedOffset) {
+		if o.Offset > k.nextOffsets[o.Partition] {
+			partitionOffsets[o.Partition] = kgo.NewOffset().At(k.nextOffsets[o.Partition])
+			remaining[o.Partition] = o.Offset
+		}
+	})
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	consumer, err := kgo.NewClient(append(cl.Opts(),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{k.topic: partitionOffsets}),
+		kgo.KeepControlRecords(),
+	)...)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer for config history topic: %w", err)
+	}
+	defer consumer.Close()
+
+	readCtx, cancel := context.WithTimeout(ctx, kafkaConfigHistoryReadTimeout)
+	defer cancel()
+
+	for len(remaining) > 0 {
+		fetches := consumer.PollFetches(readCtx)
+		if readCtx.Err() != nil {
+			return fmt.Errorf("failed to read config history topic: %w", readCtx.Err())
+		}
+		if errs := fetches.Errors(); len(errs) > 0 {
+			return fmt.Errorf("failed to read config history topic: %w", errs[0].Err)
+		}
+		fetches.EachRecord(func(r *kgo.Record) {
+			end, ok := remaining[r.Partition]
+			if !ok || r.Offset >= end {
+				return
+			}
+			k.nextOffsets[r.Partition] = r.Offset + 1
+			if r.Offset >= end-1 {
+				delete(remaining, r.Partition)
+			}
+			if !r.Attrs.IsControl() {
+				k.apply(r)
+			}
+		})
+	}
+
+	return nil
+}
+
+// apply updates the index with a record of the history topic. Tombstones delete
+// the version. If a version has been assigned by multiple writers, the record
+// with the lowest offset wins. Records that can't be decoded are skipped.
+func (k *KafkaConfigHistoryStore) apply(r *kgo.Record) {
+	var key kafkaConfigHistoryKey
+	if err := json.Unmarshal(r.Key, &key); err != nil || key.Version <= 0 {
+		return
+	}
+	connector := kafkaConfigHistoryConnector{key.ClusterName, key.ConnectorName}
+	entries := k.index[connector]
+	i, found := slices.BinarySearchFunc(entries, key.Version, func(e kafkaConfigHistoryEntry, v int) int {
+		return e.version.Version - v
+	})
+
+	if r.Value == nil {
+		if found {
+			k.index[connector] = slices.Delete(entries, i, i+1)
+		}
+		return
+	}
+
+	var writer string
+	for _, h := range r.Headers {
+		if h.Key == kafkaConfigHistoryWriterHeader {
+			writer = string(h.Value)
+		}
+	}
+	if found && entries[i].writer != writer {
+		// Duplicate version of another writer, the earlier record wins
+		return
+	}
+
+	var version ConnectorConfigVersion
+	if err := json.Unmarshal(r.Value, &version); err != nil {
+		return
+	}
+	version.Version = key.Version
+	entry := kafkaConfigHistoryEntry{
+		version:   version,
+		value:     bytes.Clone(r.Value),
+		writer:    writer,
+		partition: r.Partition,
+		offset:    r.Offset,
+	}
+	if found {
+		entries[i] = entry
+		return
+	}
+	k.index[connector] = slices.Insert(entries, i, entry)
+}
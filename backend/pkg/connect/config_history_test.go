@@ -0,0 +1,222 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestRedactConnectorConfig(t *testing.T) {
+	config := map[string]string{
+		"connector.class":           "io.debezium.connector.postgresql.PostgresConnector",
+		"database.password":         "hunter2",
+		"aws.secret.access.key":     "abc",
+		"sasl.jaas.config":          "org.apache.kafka.common.security.plain.PlainLoginModule required;",
+		"ssl.key.password":          "${secretsManager:connector:ssl-key-password}",
+		"tasks.max":                 "1",
+		"key.converter":             "org.apache.kafka.connect.storage.StringConverter",
+		"schema.registry.api.token": "token",
+	}
+
+	redacted := redactConnectorConfig(config)
+
+	assert.Equal(t, map[string]string{
+		"connector.class":           "io.debezium.connector.postgresql.PostgresConnector",
+		"database.password":         redactedConfigValue,
+		"aws.secret.access.key":     redactedConfigValue,
+		"sasl.jaas.config":          redactedConfigValue,
+		"ssl.key.password":          "${secretsManager:connector:ssl-key-password}",
+		"tasks.max":                 "1",
+		"key.converter":             "org.apache.kafka.connect.storage.StringConverter",
+		"schema.registry.api.token": redactedConfigValue,
+	}, redacted)
+	assert.Equal(t, "hunter2", config["database.password"], "input must not be modified")
+}
+
+func TestRecordConnectorConfigRedactsPasswordProperties(t *testing.T) {
+	ctx := t.Context()
+	svc, cluster, _ := newTestMigrationService(t)
+	store, err := NewFileConfigHistoryStore(t.TempDir(), 3)
+	require.NoError(t, err)
+	svc.ConfigHistory = store
+	// The PASSWORD property does not match sensitiveConfigKeyPattern
+	cluster.passwordConfigs = []string{"ssl.keystore.certificate.chain"}
+	require.False(t, sensitiveConfigKeyPattern.MatchString("ssl.keystore.certificate.chain"))
+
+	svc.recordConnectorConfig(ctx, "old", "jdbc-orders", ConnectorConfigOperationCreate, 0, map[string]string{
+		"connector.class":                "io.example.JdbcSinkConnector",
+		"connection.password":            "hunter2",
+		"ssl.keystore.certificate.chain": "-----BEGIN CERTIFICATE-----",
+		"tasks.max":                      "1",
+	})
+
+	versions, err := store.ListVersions(ctx, "old", "jdbc-orders")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, map[string]string{
+		"connector.class":                "io.example.JdbcSinkConnector",
+		"connection.password":            redactedConfigValue,
+		"ssl.keystore.certificate.chain": redactedConfigValue,
+		"tasks.max":                      "1",
+	}, versions[0].Config)
+}
+
+func TestDiffConnectorConfigs(t *testing.T) {
+	from := map[string]string{"tasks.max": "1", "topics": "a", "removed": "x"}
+	to := map[string]string{"tasks.max": "2", "topics": "a", "added": "y"}
+
+	added, removed, changed := diffConnectorConfigs(from, to)
+
+	assert.Equal(t, map[string]string{"added": "y"}, added)
+	assert.Equal(t, map[string]string{"removed": "x"}, removed)
+	assert.Equal(t, map[string]ConnectorConfigDiffValue{"tasks.max": {From: "1", To: "2"}}, changed)
+}
+
+func testConfigHistoryStore(t *testing.T, store ConfigHistoryStore) {
+	t.Helper()
+	ctx := t.Context()
+
+	for i := range 4 {
+		err := store.Append(ctx, &ConnectorConfigVersion{
+			ClusterName:   "local",
+			ConnectorName: "sink/1",
+			Operation:     ConnectorConfigOperationUpdate,
+			Timestamp:     time.Now().UTC(),
+			Config:        map[string]string{"tasks.max": string(rune('1' + i))},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.Append(ctx, &ConnectorConfigVersion{
+		ClusterName:   "local",
+		ConnectorName: "other",
+		Config:        map[string]string{"tasks.max": "9"},
+	}))
+
+	versions, err := store.ListVersions(ctx, "local", "sink/1")
+	require.NoError(t, err)
+	require.Len(t, versions, 3, "only the latest maxVersions versions must be retained")
+	assert.Equal(t, 2, versions[0].Version)
+	assert.Equal(t, 4, versions[2].Version)
+	assert.Equal(t, map[string]string{"tasks.max": "4"}, versions[2].Config)
+
+	versions, err = store.ListVersions(ctx, "local", "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
+func TestFileConfigHistoryStore(t *testing.T) {
+	store, err := NewFileConfigHistoryStore(t.TempDir(), 3)
+	require.NoError(t, err)
+	testConfigHistoryStore(t, store)
+}
+
+func TestKafkaConfigHistoryStore(t *testing.T) {
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1))
+	require.NoError(t, err)
+	t.Cleanup(fakeCluster.Close)
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...))
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+	adminCl := kadm.NewClient(cl)
+
+	store := NewKafkaConfigHistoryStore("connector-config-history", 3, func(context.Context) (*kgo.Client, *kadm.Client, error) {
+		return cl, adminCl, nil
+	})
+	testConfigHistoryStore(t, store)
+}
+
+func TestKafkaConfigHistoryStoreConcurrentVersions(t *testing.T) {
+	ctx := t.Context()
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1))
+	require.NoError(t, err)
+	t.Cleanup(fakeCluster.Close)
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...))
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+	adminCl := kadm.NewClient(cl)
+	getClients := func(context.Context) (*kgo.Client, *kadm.Client, error) {
+		return cl, adminCl, nil
+	}
+
+	// Two Console instances share the history topic
+	first := NewKafkaConfigHistoryStore("connector-config-history", 10, getClients)
+	second := NewKafkaConfigHistoryStore("connector-config-history", 10, getClients)
+	newVersion := func(tasks string) *ConnectorConfigVersion {
+		return &ConnectorConfigVersion{
+			ClusterName:   "local",
+			ConnectorName: "sink",
+			Operation:     ConnectorConfigOperationUpdate,
+			Config:        map[string]string{"tasks.max": tasks},
+		}
+	}
+
+	require.NoError(t, first.Append(ctx, newVersion("1")))
+	versions, err := second.ListVersions(ctx, "local", "sink")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+
+	// The second instance assigns version 2 based on its index, while the first
+	// instance already produced version 2
+	require.NoError(t, first.Append(ctx, newVersion("2")))
+	second.mu.Lock()
+	assigned, err := second.appendOnce(ctx, cl, adminCl, newVersion("3"))
+	second.mu.Unlock()
+	require.NoError(t, err)
+	assert.False(t, assigned, "the duplicate version must be detected")
+
+	// Appending again assigns the next version
+	v := newVersion("3")
+	require.NoError(t, second.Append(ctx, v))
+	assert.Equal(t, 3, v.Version)
+
+	// A new instance that reads the whole topic sees the versions of the first writer
+	third := NewKafkaConfigHistoryStore("connector-config-history", 10, getClients)
+	for _, store := range []*KafkaConfigHistoryStore{first, second, third} {
+		versions, err := store.ListVersions(ctx, "local", "sink")
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		for i, version := range versions {
+			assert.Equal(t, i+1, version.Version)
+			assert.Equal(t, map[string]string{"tasks.max": string(rune('1' + i))}, version.Config)
+		}
+	}
+
+	// The latest record of each version holds the config of the winning writer,
+	// so that compaction retains it
+	latest := make(map[string]string)
+	consumer, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...), kgo.ConsumeTopics("connector-config-history"))
+	require.NoError(t, err)
+	t.Cleanup(consumer.Close)
+	for len(latest) < 3 || latest[`{"clusterName":"local","connectorName":"sink","version":2}`] != "2" {
+		fetches := consumer.PollFetches(ctx)
+		require.NoError(t, fetches.Err())
+		fetches.EachRecord(func(r *kgo.Record) {
+			var version ConnectorConfigVersion
+			require.NoError(t, json.Unmarshal(r.Value, &version))
+			latest[string(r.Key)] = version.Config["tasks.max"]
+		})
+	}
+	assert.Equal(t, map[string]string{
+		`{"clusterName":"local","connectorName":"sink","version":1}`: "1",
+		`{"clusterName":"local","connectorName":"sink","version":2}`: "2",
+		`{"clusterName":"local","connectorName":"sink","version":3}`: "3",
+	}, latest)
+}
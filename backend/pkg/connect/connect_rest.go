@@ -0,0 +1,134 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	con "github.com/cloudhut/connect-client"
+)
+
+// maxConnectResponseSize is the maximum size of a Kafka connect REST API response
+// that is read by doRequest.
+const maxConnectResponseSize = 32 * 1024 * 1024
+
+// ConnectorOffsets are the offsets of all partitions of a connector, as returned and
+// accepted by the Kafka connect offsets API (KIP-875). The partition and offset
+// structure is defined by the connector plugin for source connectors. For sink
+// connectors, partitions contain kafka_topic and kafka_partition and offsets kafka_offset.
+type ConnectorOffsets struct {
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+// ConnectorOffset is the offset of a single source or sink partition. A nil Offset
+// resets the partition's offset when altering offsets.
+type ConnectorOffset struct {
+	Partition map[string]any `json:"partition"`
+	Offset    map[string]any `json:"offset"`
+}
+
+// connectorPath returns the escaped REST API path of a connector.
+func connectorPath(connector string) string {
+	return "/connectors/" + url.PathEscape(connector)
+}
+
+// getConnectorOffsets returns the committed offsets of a connector.
+func (c *ClientWithConfig) getConnectorOffsets(ctx context.Context, connector string) (ConnectorOffsets, error) {
+	var offsets ConnectorOffsets
+	err := c.doRequest(ctx, http.MethodGet, connectorPath(connector)+"/offsets", nil, &offsets)
+	return offsets, err
+}
+
+// alterConnectorOffsets overwrites the offsets of the given partitions. The connector
+// must be in the STOPPED state.
+func (c *ClientWithConfig) alterConnectorOffsets(ctx context.Context, connector string, offsets ConnectorOffsets) error {
+	return c.doRequest(ctx, http.MethodPatch, connectorPath(connector)+"/offsets", offsets, nil)
+}
+
+// resetConnectorOffsets resets the offsets of all partitions of a connector. The
+// connector must be in the STOPPED state.
+func (c *ClientWithConfig) resetConnectorOffsets(ctx context.Context, connector string) error {
+	return c.doRequest(ctx, http.MethodDelete, connectorPath(connector)+"/offsets", nil, nil)
+}
+
+// createConnectorWithInitialState creates a connector that starts in the given state,
+// e.g. STOPPED, so that its offsets can be altered before it processes any data.
+func (c *ClientWithConfig) createConnectorWithInitialState(ctx context.Context, req con.CreateConnectorRequest, initialState string) (con.ConnectorInfo, error) {
+	body := struct {
+		con.CreateConnectorRequest
+		InitialState string `json:"initial_state"`
+	}{req, initialState}
+
+	var info con.ConnectorInfo
+	err := c.doRequest(ctx, http.MethodPost, "/connectors", body, &info)
+	return info, err
+}
+
+// doRequest sends a request to an endpoint of the Kafka connect REST API that is not
+// supported by the connect client. Error responses are returned as con.ApiError, so
+// that GetStatusCodeFromAPIError works the same as for errors of the connect client.
+func (c *ClientWithConfig) doRequest(ctx context.Context, method, path string, reqBody, resBody any) error {
+	var body io.Reader
+	if reqBody != nil {
+		encoded, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.Cfg.URL, "/")+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Redpanda Console")
+	if c.Cfg.Username != "" {
+		req.SetBasicAuth(c.Cfg.Username, c.Cfg.Password)
+	}
+	if c.Cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Cfg.Token)
+	}
+
+	// The connect client's HTTP client carries the configured TLS settings and timeout
+	res, err := c.Client.GetClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(res.Body, maxConnectResponseSize))
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var apiErr con.ApiError
+		if err := json.Unmarshal(content, &apiErr); err != nil || apiErr.ErrorCode == 0 {
+			apiErr = con.ApiError{ErrorCode: res.StatusCode, Message: http.StatusText(res.StatusCode)}
+		}
+		return apiErr
+	}
+
+	if resBody != nil && len(content) > 0 {
+		if err := json.Unmarshal(content, resBody); err != nil {
+			return fmt.Errorf("failed to decode response body: %w", err)
+		}
+	}
+	return nil
+}
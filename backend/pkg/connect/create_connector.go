@@ -37,12 +37,16 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 		}
 	}
 	req.Config = s.Interceptor.ConsoleToKafkaConnect(className, req.Config)
+	req.Config, restErr = s.externalizeConnectorSecrets(ctx, c, req.Name, className, req.Config)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
 
 	cInfo, err := c.Client.CreateConnector(ctx, req)
 	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
 	cInfo = con.ConnectorInfo{
 		Name:   cInfo.Name,
-		Config: s.Interceptor.KafkaConnectToConsole(connectorClass, cInfo.Config),
+		Config: s.connectorConfigToConsole(ctx, clusterName, connectorClass, cInfo.Config),
 		Tasks:  cInfo.Tasks,
 		Type:   cInfo.Type,
 	}
@@ -56,6 +60,7 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 			IsSilent:     false,
 		}
 	}
+	s.recordConnectorConfig(ctx, clusterName, cInfo.Name, ConnectorConfigOperationCreate, 0, cInfo.Config)
 
 	return cInfo, nil
 }
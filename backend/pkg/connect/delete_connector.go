@@ -18,7 +18,8 @@ import (
 	"github.com/cloudhut/common/rest"
 )
 
-// DeleteConnector deletes a connector, halting all tasks and deleting its configuration.
+// DeleteConnector deletes a connector, halting all tasks and deleting its configuration
+// and externalized secrets.
 // Returns 409 (Conflict) if a rebalance is in process.
 func (s *Service) DeleteConnector(ctx context.Context, clusterName string, connector string) *rest.Error {
 	c, restErr := s.getConnectClusterByName(clusterName)
@@ -36,6 +37,7 @@ func (s *Service) DeleteConnector(ctx context.Context, clusterName string, conne
 			IsSilent:     false,
 		}
 	}
+	s.deleteConnectorSecrets(ctx, clusterName, connector, nil)
 
 	return nil
 }
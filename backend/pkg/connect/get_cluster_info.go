@@ -82,8 +82,9 @@ type ClusterInfoWithError struct {
 
 // GetAllClusterInfo requests cluster info from all configured connect clusters concurrently.
 func (s *Service) GetAllClusterInfo(ctx context.Context) []ClusterInfoWithError {
-	ch := make(chan ClusterInfoWithError, len(s.ClientsByCluster))
-	for clusterName := range s.ClientsByCluster {
+	clusters := s.Clusters()
+	ch := make(chan ClusterInfoWithError, len(clusters))
+	for clusterName := range clusters {
 		go func(name string) {
 			var requestError error
 			cInfo, restErr := s.GetClusterInfo(ctx, name)
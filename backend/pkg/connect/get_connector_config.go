@@ -18,13 +18,25 @@ import (
 	"github.com/cloudhut/common/rest"
 )
 
-// GetConnectorConfig gets the connector configuration.
+// GetConnectorConfig returns the connector's config. Values of sensitive config
+// properties are masked.
 func (s *Service) GetConnectorConfig(ctx context.Context, clusterName string, connector string) (map[string]string, *rest.Error) {
 	c, restErr := s.getConnectClusterByName(clusterName)
 	if restErr != nil {
 		return map[string]string{}, restErr
 	}
 
+	config, restErr := s.getConnectorConfig(ctx, c, connector)
+	if restErr != nil {
+		return map[string]string{}, restErr
+	}
+	connectorClass := getMapValueOrString(config, "connector.class", "unknown")
+	return s.maskConnectorConfig(ctx, clusterName, connectorClass, config), nil
+}
+
+// getConnectorConfig returns the connector's config in the Console representation
+// without masking sensitive values. It must not be returned to the frontend.
+func (s *Service) getConnectorConfig(ctx context.Context, c *ClientWithConfig, connector string) (map[string]string, *rest.Error) {
 	config, err := c.Client.GetConnectorConfig(ctx, connector)
 
 	connectorClass := getMapValueOrString(config, "connector.class", "unknown")
@@ -33,8 +45,8 @@ func (s *Service) GetConnectorConfig(ctx context.Context, clusterName string, co
 		return map[string]string{}, &rest.Error{
 			Err:          err,
 			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
-			Message:      fmt.Sprintf("Failed to pause connector: %v", err.Error()),
-			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector", connector)},
+			Message:      fmt.Sprintf("Failed to get connector config: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", c.Cfg.Name), slog.String("connector", connector)},
 			IsSilent:     false,
 		}
 	}
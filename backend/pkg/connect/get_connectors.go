@@ -152,8 +152,9 @@ func (s *Service) GetAllClusterConnectors(ctx context.Context) ([]*ClusterConnec
 		return nil, ErrKafkaConnectNotConfigured
 	}
 
-	ch := make(chan *ClusterConnectors, len(s.ClientsByCluster))
-	for _, cluster := range s.ClientsByCluster {
+	clusters := s.Clusters()
+	ch := make(chan *ClusterConnectors, len(clusters))
+	for _, cluster := range clusters {
 		go func(cfg config.KafkaConnectCluster, c *con.Client) {
 			connectors, err := c.ListConnectorsExpanded(ctx)
 			errMsg := ""
@@ -165,7 +166,7 @@ func (s *Service) GetAllClusterConnectors(ctx context.Context) ([]*ClusterConnec
 				ch <- &ClusterConnectors{
 					ClusterName:    cfg.Name,
 					ClusterAddress: cfg.URL,
-					Connectors:     s.listConnectorsExpandedToClusterConnectorInfo(connectors),
+					Connectors:     s.listConnectorsExpandedToClusterConnectorInfo(ctx, cfg.Name, connectors),
 					Error:          errMsg,
 				}
 				return
@@ -193,7 +194,7 @@ func (s *Service) GetAllClusterConnectors(ctx context.Context) ([]*ClusterConnec
 				ClusterInfo:       root,
 				TotalConnectors:   totalConnectors,
 				RunningConnectors: runningConnectors,
-				Connectors:        s.listConnectorsExpandedToClusterConnectorInfo(connectors),
+				Connectors:        s.listConnectorsExpandedToClusterConnectorInfo(ctx, cfg.Name, connectors),
 				Error:             errMsg,
 			}
 		}(cluster.Cfg, cluster.Client)
@@ -226,7 +227,7 @@ func (s *Service) GetClusterConnectors(ctx context.Context, clusterName string)
 	return ClusterConnectors{
 		ClusterName:    c.Cfg.Name,
 		ClusterAddress: c.Cfg.URL,
-		Connectors:     s.listConnectorsExpandedToClusterConnectorInfo(connectors),
+		Connectors:     s.listConnectorsExpandedToClusterConnectorInfo(ctx, c.Cfg.Name, connectors),
 		Error:          errMsg,
 	}, nil
 }
@@ -279,7 +280,7 @@ func (s *Service) GetConnector(ctx context.Context, clusterName string, connecto
 	return ClusterConnectorInfo{
 		Name:         cInfo.Name,
 		Class:        connectorClass,
-		Config:       s.Interceptor.KafkaConnectToConsole(connectorClass, cInfo.Config),
+		Config:       s.connectorConfigToConsole(ctx, clusterName, connectorClass, cInfo.Config),
 		Type:         cInfo.Type,
 		State:        stateInfo.Connector.State,
 		Topic:        getMapValueOrString(cInfo.Config, "kafka.topic", "unknown"),
@@ -290,7 +291,20 @@ func (s *Service) GetConnector(ctx context.Context, clusterName string, connecto
 }
 
 // GetConnectorInfo requests the connector info in the context of a single connect cluster.
+// Values of sensitive config properties are masked.
 func (s *Service) GetConnectorInfo(ctx context.Context, clusterName string, connector string) (con.ConnectorInfo, *rest.Error) {
+	cInfo, restErr := s.getConnectorInfo(ctx, clusterName, connector)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
+	cInfo.Config = s.maskConnectorConfig(ctx, clusterName, connectorClass, cInfo.Config)
+	return cInfo, nil
+}
+
+// getConnectorInfo requests the connector info in the Console representation without
+// masking sensitive values. It must not be returned to the frontend.
+func (s *Service) getConnectorInfo(ctx context.Context, clusterName string, connector string) (con.ConnectorInfo, *rest.Error) {
 	c, restErr := s.getConnectClusterByName(clusterName)
 	if restErr != nil {
 		return con.ConnectorInfo{}, restErr
@@ -347,14 +361,16 @@ func (s *Service) GetConnectorStatus(ctx context.Context, clusterName string, co
 	}, nil
 }
 
-func (s *Service) listConnectorsExpandedToClusterConnectorInfo(l map[string]con.ListConnectorsResponseExpanded) []ClusterConnectorInfo {
+func (s *Service) listConnectorsExpandedToClusterConnectorInfo(ctx context.Context, clusterName string, l map[string]con.ListConnectorsResponseExpanded) []ClusterConnectorInfo {
 	if l == nil {
 		return []ClusterConnectorInfo{}
 	}
 
 	connectorInfo := make([]ClusterConnectorInfo, 0, len(l))
 	for _, c := range l {
-		cInfo := connectorsResponseToClusterConnectorInfo(s.Interceptor.KafkaConnectToConsole, &c)
+		cInfo := connectorsResponseToClusterConnectorInfo(func(pluginClassName string, configs map[string]string) map[string]string {
+			return s.connectorConfigToConsole(ctx, clusterName, pluginClassName, configs)
+		}, &c)
 		connectorInfo = append(connectorInfo, *cInfo)
 	}
 
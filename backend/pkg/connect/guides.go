@@ -0,0 +1,90 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
+	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// newInterceptor creates the interceptor along with the declarative connector guides
+// that are configured.
+func newInterceptor(cfg config.KafkaConnectGuides, logger *slog.Logger) (*interceptor.Interceptor, error) {
+	if !cfg.Enabled {
+		return interceptor.NewInterceptor(), nil
+	}
+
+	defs, err := loadDeclarativeGuides(cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connector guides: %w", err)
+	}
+
+	return interceptor.NewInterceptor(interceptor.WithDeclarativeDefinitions(defs...)), nil
+}
+
+// loadDeclarativeGuides reads and parses all declarative connector guides from the
+// configured git repository and filesystem. Guides from the filesystem take precedence
+// over the guides from git for the same connector class.
+func loadDeclarativeGuides(cfg config.KafkaConnectGuides, logger *slog.Logger) ([]*declarative.Definition, error) {
+	var files []filesystem.File
+
+	if cfg.Git.Enabled {
+		gitSvc, err := git.NewService(cfg.Git, logger, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new git service: %w", err)
+		}
+		if err := gitSvc.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start git service: %w", err)
+		}
+		files = append(files, sortedFiles(gitSvc.GetFilesByFilename())...)
+	}
+
+	if cfg.FileSystem.Enabled {
+		fsSvc, err := filesystem.NewService(cfg.FileSystem, logger, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new filesystem service: %w", err)
+		}
+		if err := fsSvc.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start filesystem service: %w", err)
+		}
+		files = append(files, sortedFiles(fsSvc.GetFilesByFilename())...)
+	}
+
+	defs := make([]*declarative.Definition, 0, len(files))
+	for _, file := range files {
+		def, err := declarative.Parse(file.Path, file.Payload)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+
+	logger.Info("successfully loaded declarative connector guides", slog.Int("guides", len(defs)))
+
+	return defs, nil
+}
+
+// sortedFiles returns the files ordered by their name, so that the order in which
+// guides are registered is deterministic.
+func sortedFiles(filesByName map[string]filesystem.File) []filesystem.File {
+	files := make([]filesystem.File, 0, len(filesByName))
+	for _, name := range slices.Sorted(maps.Keys(filesByName)) {
+		files = append(files, filesByName[name])
+	}
+	return files
+}
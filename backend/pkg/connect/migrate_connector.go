@@ -0,0 +1,400 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// connectorMigrationRollbackTimeout is the maximum duration for rolling back a
+// failed migration. Rollbacks are not bound to the request context, so that they
+// are also executed if the client disconnects.
+const connectorMigrationRollbackTimeout = 30 * time.Second
+
+// ConnectorMigrationSourceAction is what happens to the source connector after the
+// connector has been successfully created in the target cluster.
+type ConnectorMigrationSourceAction string
+
+const (
+	// ConnectorMigrationSourceActionStop stops the source connector, but keeps its config.
+	ConnectorMigrationSourceActionStop ConnectorMigrationSourceAction = "STOP"
+	// ConnectorMigrationSourceActionDelete deletes the source connector.
+	ConnectorMigrationSourceActionDelete ConnectorMigrationSourceAction = "DELETE"
+	// ConnectorMigrationSourceActionKeep leaves the source connector as is.
+	ConnectorMigrationSourceActionKeep ConnectorMigrationSourceAction = "KEEP"
+)
+
+// ConnectorMigrationStepName identifies a single step of a connector migration.
+type ConnectorMigrationStepName string
+
+// Steps of a connector migration, in the order they are executed. The last two
+// steps are only executed to roll back a failed migration.
+const (
+	ConnectorMigrationStepFetchSourceConfig ConnectorMigrationStepName = "FETCH_SOURCE_CONFIG"
+	ConnectorMigrationStepValidateTarget    ConnectorMigrationStepName = "VALIDATE_TARGET_CONFIG"
+	ConnectorMigrationStepPauseSource       ConnectorMigrationStepName = "PAUSE_SOURCE"
+	ConnectorMigrationStepReadOffsets       ConnectorMigrationStepName = "READ_SOURCE_OFFSETS"
+	ConnectorMigrationStepCreateTarget      ConnectorMigrationStepName = "CREATE_TARGET"
+	ConnectorMigrationStepTransferOffsets   ConnectorMigrationStepName = "TRANSFER_OFFSETS"
+	ConnectorMigrationStepResumeTarget      ConnectorMigrationStepName = "RESUME_TARGET"
+	ConnectorMigrationStepFinalizeSource    ConnectorMigrationStepName = "FINALIZE_SOURCE"
+	ConnectorMigrationStepDeleteTarget      ConnectorMigrationStepName = "DELETE_TARGET"
+	ConnectorMigrationStepResumeSource      ConnectorMigrationStepName = "RESUME_SOURCE"
+)
+
+// ConnectorMigrationStepStatus is the outcome of a single migration step.
+type ConnectorMigrationStepStatus string
+
+const (
+	// ConnectorMigrationStepStatusSucceeded is a step that has been executed successfully.
+	ConnectorMigrationStepStatusSucceeded ConnectorMigrationStepStatus = "SUCCEEDED"
+	// ConnectorMigrationStepStatusFailed is a step that has failed.
+	ConnectorMigrationStepStatusFailed ConnectorMigrationStepStatus = "FAILED"
+	// ConnectorMigrationStepStatusSkipped is a step that is not required by the request.
+	ConnectorMigrationStepStatusSkipped ConnectorMigrationStepStatus = "SKIPPED"
+)
+
+// MigrateConnectorRequest describes which connector to move to which cluster.
+type MigrateConnectorRequest struct {
+	SourceClusterName string
+	ConnectorName     string
+	TargetClusterName string
+	// TargetConnectorName is the name of the connector in the target cluster.
+	// Defaults to ConnectorName.
+	TargetConnectorName string
+	// PauseSource pauses the source connector before its offsets are read, so
+	// that no data is processed twice by both connectors.
+	PauseSource bool
+	// TransferOffsets copies the source connector's offsets to the target connector
+	// before it is started. It requires Kafka connect 3.7 or later on both clusters.
+	TransferOffsets bool
+	SourceAction    ConnectorMigrationSourceAction
+}
+
+// ConnectorMigrationReport describes all executed steps of a connector migration.
+type ConnectorMigrationReport struct {
+	Success bool                     `json:"success"`
+	Steps   []ConnectorMigrationStep `json:"steps"`
+	// Rollback contains the steps that have been executed to restore the original
+	// state after a step has failed.
+	Rollback []ConnectorMigrationStep `json:"rollback"`
+}
+
+// ConnectorMigrationStep is a single executed step of a connector migration.
+type ConnectorMigrationStep struct {
+	Step      ConnectorMigrationStepName   `json:"step"`
+	Status    ConnectorMigrationStepStatus `json:"status"`
+	Message   string                       `json:"message,omitempty"`
+	Timestamp time.Time                    `json:"timestamp"`
+}
+
+// connectorMigration tracks the progress of a single migration along with the
+// actions that undo the steps executed so far.
+type connectorMigration struct {
+	report    *ConnectorMigrationReport
+	rollbacks []connectorMigrationRollback
+}
+
+type connectorMigrationRollback struct {
+	step ConnectorMigrationStepName
+	undo func(ctx context.Context) error
+}
+
+func (m *connectorMigration) record(step ConnectorMigrationStepName, status ConnectorMigrationStepStatus, message string) {
+	m.report.Steps = append(m.report.Steps, ConnectorMigrationStep{
+		Step:      step,
+		Status:    status,
+		Message:   message,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// run executes a step and records its outcome. The step's undo function is
+// registered only if the step succeeds.
+func (m *connectorMigration) run(ctx context.Context, step ConnectorMigrationStepName, fn func(ctx context.Context) (string, error), undo *connectorMigrationRollback) bool {
+	message, err := fn(ctx)
+	if err != nil {
+		m.record(step, ConnectorMigrationStepStatusFailed, err.Error())
+		return false
+	}
+	m.record(step, ConnectorMigrationStepStatusSucceeded, message)
+	if undo != nil {
+		m.rollbacks = append(m.rollbacks, *undo)
+	}
+	return true
+}
+
+// rollback undoes all successfully executed steps in reverse order.
+func (m *connectorMigration) rollback(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), connectorMigrationRollbackTimeout)
+	defer cancel()
+
+	for _, r := range slices.Backward(m.rollbacks) {
+		step := ConnectorMigrationStep{Step: r.step, Status: ConnectorMigrationStepStatusSucceeded}
+		if err := r.undo(ctx); err != nil {
+			step.Status = ConnectorMigrationStepStatusFailed
+			step.Message = err.Error()
+		}
+		step.Timestamp = time.Now().UTC()
+		m.report.Rollback = append(m.report.Rollback, step)
+	}
+}
+
+// MigrateConnector copies a connector from one Kafka connect cluster to another. The
+// config is validated against the target cluster, the source connector is optionally
+// paused and its offsets are transferred, before the connector is created in the
+// target cluster and the source connector is stopped or deleted. If a step fails,
+// all previous steps are rolled back. The returned report lists every executed step.
+func (s *Service) MigrateConnector(ctx context.Context, req MigrateConnectorRequest) (*ConnectorMigrationReport, *rest.Error) {
+	if req.TargetConnectorName == "" {
+		req.TargetConnectorName = req.ConnectorName
+	}
+	if req.SourceAction == "" {
+		req.SourceAction = ConnectorMigrationSourceActionStop
+	}
+	if req.SourceClusterName == req.TargetClusterName && req.ConnectorName == req.TargetConnectorName {
+		return nil, &rest.Error{
+			Err:      errors.New("source and target connector are the same"),
+			Status:   http.StatusBadRequest,
+			Message:  "The target cluster or the target connector name must differ from the source",
+			IsSilent: false,
+		}
+	}
+	source, restErr := s.getConnectClusterByName(req.SourceClusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	target, restErr := s.getConnectClusterByName(req.TargetClusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if _, err := target.Client.GetConnector(ctx, req.TargetConnectorName); err == nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("connector %q already exists in the target cluster", req.TargetConnectorName),
+			Status:   http.StatusConflict,
+			Message:  fmt.Sprintf("Connector %q already exists in cluster %q", req.TargetConnectorName, req.TargetClusterName),
+			IsSilent: false,
+		}
+	}
+
+	logger := s.Logger.With(
+		slog.String("source_cluster_name", req.SourceClusterName),
+		slog.String("target_cluster_name", req.TargetClusterName),
+		slog.String("connector", req.ConnectorName))
+	m := &connectorMigration{
+		report: &ConnectorMigrationReport{
+			Steps:    make([]ConnectorMigrationStep, 0),
+			Rollback: make([]ConnectorMigrationStep, 0),
+		},
+	}
+	succeeded := s.migrateConnector(ctx, m, source, target, req)
+	if !succeeded {
+		m.rollback(ctx)
+		logger.WarnContext(ctx, "failed to migrate connector, rolled back executed steps")
+	} else {
+		logger.InfoContext(ctx, "migrated connector to target cluster")
+	}
+	m.report.Success = succeeded
+
+	return m.report, nil
+}
+
+func (s *Service) migrateConnector(ctx context.Context, m *connectorMigration, source, target *ClientWithConfig, req MigrateConnectorRequest) bool {
+	// 1. Fetch the source config in the Console representation, so that the target
+	// config passes through the same interceptor chain as configs created via Console.
+	var (
+		pluginClassName string
+		config          map[string]any
+		sourceState     string
+	)
+	ok := m.run(ctx, ConnectorMigrationStepFetchSourceConfig, func(ctx context.Context) (string, error) {
+		// The unmasked config is required, so that secrets are copied to the target connector
+		info, restErr := s.getConnectorInfo(ctx, req.SourceClusterName, req.ConnectorName)
+		if restErr != nil {
+			return "", restErr.Err
+		}
+		status, err := source.Client.GetConnectorStatus(ctx, req.ConnectorName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get source connector status: %w", err)
+		}
+		sourceState = status.Connector.State
+
+		pluginClassName = info.Config["connector.class"]
+		config = make(map[string]any, len(info.Config))
+		for k, v := range info.Config {
+			config[k] = v
+		}
+		config["name"] = req.TargetConnectorName
+		return fmt.Sprintf("Fetched config of %v connector in state %v", info.Type, sourceState), nil
+	}, nil)
+	if !ok {
+		return false
+	}
+
+	// 2. Validate the config against the target cluster, which may run different plugin versions
+	ok = m.run(ctx, ConnectorMigrationStepValidateTarget, func(ctx context.Context) (string, error) {
+		validation, restErr := s.ValidateConnectorConfig(ctx, req.TargetClusterName, pluginClassName, config)
+		if restErr != nil {
+			return "", restErr.Err
+		}
+		if errs := connectorValidationErrors(validation); len(errs) > 0 {
+			return "", fmt.Errorf("config is invalid in the target cluster: %v", strings.Join(errs, "; "))
+		}
+		return "", nil
+	}, nil)
+	if !ok {
+		return false
+	}
+
+	// 3. Pause the source, so that its offsets do not change anymore
+	switch {
+	case !req.PauseSource:
+		m.record(ConnectorMigrationStepPauseSource, ConnectorMigrationStepStatusSkipped, "")
+	case sourceState != connectorStateRunning:
+		m.record(ConnectorMigrationStepPauseSource, ConnectorMigrationStepStatusSkipped, fmt.Sprintf("Source connector is in state %v", sourceState))
+	default:
+		ok = m.run(ctx, ConnectorMigrationStepPauseSource, func(ctx context.Context) (string, error) {
+			return "", source.Client.PauseConnector(ctx, req.ConnectorName)
+		}, &connectorMigrationRollback{
+			step: ConnectorMigrationStepResumeSource,
+			undo: func(ctx context.Context) error { return source.Client.ResumeConnector(ctx, req.ConnectorName) },
+		})
+		if !ok {
+			return false
+		}
+	}
+
+	// 4. Read the source offsets
+	var offsets ConnectorOffsets
+	if req.TransferOffsets {
+		ok = m.run(ctx, ConnectorMigrationStepReadOffsets, func(ctx context.Context) (string, error) {
+			var err error
+			offsets, err = source.getConnectorOffsets(ctx, req.ConnectorName)
+			if err != nil {
+				return "", fmt.Errorf("failed to read source connector offsets: %w", err)
+			}
+			return fmt.Sprintf("Read offsets of %d partitions", len(offsets.Offsets)), nil
+		}, nil)
+		if !ok {
+			return false
+		}
+	} else {
+		m.record(ConnectorMigrationStepReadOffsets, ConnectorMigrationStepStatusSkipped, "")
+	}
+
+	// 5. Create the target connector. If offsets are transferred, the connector is
+	// created in the STOPPED state, because offsets can only be altered while stopped.
+	deleteTarget := &connectorMigrationRollback{
+		step: ConnectorMigrationStepDeleteTarget,
+		undo: func(ctx context.Context) error {
+			if err := target.Client.DeleteConnector(ctx, req.TargetConnectorName); err != nil {
+				return err
+			}
+			s.deleteConnectorSecrets(ctx, req.TargetClusterName, req.TargetConnectorName, nil)
+			return nil
+		},
+	}
+	ok = m.run(ctx, ConnectorMigrationStepCreateTarget, func(ctx context.Context) (string, error) {
+		if !req.TransferOffsets {
+			_, restErr := s.CreateConnector(ctx, req.TargetClusterName, con.CreateConnectorRequest{Name: req.TargetConnectorName, Config: config})
+			if restErr != nil {
+				return "", restErr.Err
+			}
+			return "", nil
+		}
+		targetConfig, restErr := s.externalizeConnectorSecrets(ctx, target, req.TargetConnectorName, pluginClassName,
+			s.Interceptor.ConsoleToKafkaConnect(pluginClassName, config))
+		if restErr != nil {
+			return "", restErr.Err
+		}
+		createReq := con.CreateConnectorRequest{
+			Name:   req.TargetConnectorName,
+			Config: targetConfig,
+		}
+		info, err := target.createConnectorWithInitialState(ctx, createReq, connectorStateStopped)
+		if err != nil {
+			return "", fmt.Errorf("failed to create stopped connector: %w", err)
+		}
+		s.recordConnectorConfig(ctx, req.TargetClusterName, info.Name, ConnectorConfigOperationCreate, 0,
+			s.Interceptor.KafkaConnectToConsole(pluginClassName, info.Config))
+		return "Created connector in state STOPPED", nil
+	}, deleteTarget)
+	if !ok {
+		return false
+	}
+
+	// 6. Transfer offsets and start the target connector
+	if req.TransferOffsets {
+		if len(offsets.Offsets) == 0 {
+			m.record(ConnectorMigrationStepTransferOffsets, ConnectorMigrationStepStatusSkipped, "Source connector has no committed offsets")
+		} else {
+			ok = m.run(ctx, ConnectorMigrationStepTransferOffsets, func(ctx context.Context) (string, error) {
+				if err := target.alterConnectorOffsets(ctx, req.TargetConnectorName, offsets); err != nil {
+					return "", fmt.Errorf("failed to alter target connector offsets: %w", err)
+				}
+				return fmt.Sprintf("Transferred offsets of %d partitions", len(offsets.Offsets)), nil
+			}, nil)
+			if !ok {
+				return false
+			}
+		}
+		ok = m.run(ctx, ConnectorMigrationStepResumeTarget, func(ctx context.Context) (string, error) {
+			return "", target.Client.ResumeConnector(ctx, req.TargetConnectorName)
+		}, nil)
+		if !ok {
+			return false
+		}
+	} else {
+		m.record(ConnectorMigrationStepTransferOffsets, ConnectorMigrationStepStatusSkipped, "")
+		m.record(ConnectorMigrationStepResumeTarget, ConnectorMigrationStepStatusSkipped, "")
+	}
+
+	// 7. Stop or delete the source connector
+	switch req.SourceAction {
+	case ConnectorMigrationSourceActionDelete:
+		return m.run(ctx, ConnectorMigrationStepFinalizeSource, func(ctx context.Context) (string, error) {
+			if err := source.Client.DeleteConnector(ctx, req.ConnectorName); err != nil {
+				return "", err
+			}
+			s.deleteConnectorSecrets(ctx, req.SourceClusterName, req.ConnectorName, nil)
+			return "Deleted source connector", nil
+		}, nil)
+	case ConnectorMigrationSourceActionStop:
+		return m.run(ctx, ConnectorMigrationStepFinalizeSource, func(ctx context.Context) (string, error) {
+			return "Stopped source connector", source.Client.StopConnector(ctx, req.ConnectorName)
+		}, nil)
+	default:
+		m.record(ConnectorMigrationStepFinalizeSource, ConnectorMigrationStepStatusSkipped, "Source connector is kept")
+		return true
+	}
+}
+
+// connectorValidationErrors returns all config errors of a validation response.
+func connectorValidationErrors(validation model.ValidationResponse) []string {
+	errs := make([]string, 0)
+	for _, config := range validation.Configs {
+		for _, err := range config.Value.Errors {
+			errs = append(errs, fmt.Sprintf("%v: %v", config.Value.Name, err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
+)
+
+type fakeConnector struct {
+	config  map[string]string
+	state   string
+	offsets ConnectorOffsets
+}
+
+// fakeConnectCluster is a minimal in-memory Kafka connect REST API.
+type fakeConnectCluster struct {
+	mu         sync.Mutex
+	connectors map[string]*fakeConnector
+	// invalidConfigs are config keys that are reported as invalid by the validate endpoint
+	invalidConfigs []string
+	// passwordConfigs are config keys that are reported with the PASSWORD type by the validate endpoint
+	passwordConfigs []string
+	// failRequests are requests ("METHOD pattern") that respond with an error
+	failRequests []string
+}
+
+func newFakeConnectCluster(t *testing.T) (*fakeConnectCluster, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeConnectCluster{connectors: make(map[string]*fakeConnector)}
+	mux := http.NewServeMux()
+	handle := func(pattern string, fn func(w http.ResponseWriter, r *http.Request, c *fakeConnector)) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			for _, failing := range f.failRequests {
+				if failing == pattern {
+					writeFakeConnectError(w, http.StatusInternalServerError, "injected failure")
+					return
+				}
+			}
+			c, exists := f.connectors[r.PathValue("name")]
+			if r.PathValue("name") != "" && !exists {
+				writeFakeConnectError(w, http.StatusNotFound, "Connector "+r.PathValue("name")+" not found")
+				return
+			}
+			fn(w, r, c)
+		})
+	}
+
+	handle("GET /connectors/{name}", func(w http.ResponseWriter, r *http.Request, c *fakeConnector) {
+		writeFakeConnectJSON(w, con.ConnectorInfo{Name: r.PathValue("name"), Config: c.config, Type: "source"})
+	})
+	handle("GET /connectors/{name}/status", func(w http.ResponseWriter, r *http.Request, c *fakeConnector) {
+		writeFakeConnectJSON(w, con.ConnectorStateInfo{Name: r.PathValue("name"), Connector: con.ConnectorState{State: c.state}, Type: "source"})
+	})
+	handle("POST /connectors", func(w http.ResponseWriter, r *http.Request, _ *fakeConnector) {
+		var req struct {
+			Name         string            `json:"name"`
+			Config       map[string]string `json:"config"`
+			InitialState string            `json:"initial_state"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		state := req.InitialState
+		if state == "" {
+			state = connectorStateRunning
+		}
+		f.connectors[req.Name] = &fakeConnector{config: req.Config, state: state}
+		w.WriteHeader(http.StatusCreated)
+		writeFakeConnectJSON(w, con.ConnectorInfo{Name: req.Name, Config: req.Config, Type: "source"})
+	})
+	handle("GET /connectors/{name}/config", func(w http.ResponseWriter, _ *http.Request, c *fakeConnector) {
+		writeFakeConnectJSON(w, c.config)
+	})
+	handle("PUT /connectors/{name}/config", func(w http.ResponseWriter, r *http.Request, c *fakeConnector) {
+		_ = json.NewDecoder(r.Body).Decode(&c.config)
+		writeFakeConnectJSON(w, con.ConnectorInfo{Name: r.PathValue("name"), Config: c.config, Type: "source"})
+	})
+	handle("DELETE /connectors/{name}", func(w http.ResponseWriter, r *http.Request, _ *fakeConnector) {
+		delete(f.connectors, r.PathValue("name"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	for action, state := range map[string]string{"pause": connectorStatePaused, "resume": connectorStateRunning, "stop": connectorStateStopped} {
+		handle("PUT /connectors/{name}/"+action, func(w http.ResponseWriter, _ *http.Request, c *fakeConnector) {
+			c.state = state
+			w.WriteHeader(http.StatusAccepted)
+		})
+	}
+	handle("GET /connectors/{name}/offsets", func(w http.ResponseWriter, _ *http.Request, c *fakeConnector) {
+		writeFakeConnectJSON(w, c.offsets)
+	})
+	handle("PATCH /connectors/{name}/offsets", func(w http.ResponseWriter, r *http.Request, c *fakeConnector) {
+		if c.state != connectorStateStopped {
+			writeFakeConnectError(w, http.StatusBadRequest, "Connectors must be in the STOPPED state")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&c.offsets)
+		writeFakeConnectJSON(w, map[string]string{"message": "The offsets for this connector have been altered successfully"})
+	})
+	handle("DELETE /connectors/{name}/offsets", func(w http.ResponseWriter, _ *http.Request, c *fakeConnector) {
+		if c.state != connectorStateStopped {
+			writeFakeConnectError(w, http.StatusBadRequest, "Connectors must be in the STOPPED state")
+			return
+		}
+		c.offsets = ConnectorOffsets{}
+		writeFakeConnectJSON(w, map[string]string{"message": "The offsets for this connector have been reset successfully"})
+	})
+	handle("PUT /connector-plugins/{class}/config/validate", func(w http.ResponseWriter, r *http.Request, _ *fakeConnector) {
+		var cfg map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&cfg)
+		result := con.ConnectorValidationResult{Name: r.PathValue("class"), Groups: []string{}}
+		for key, value := range cfg {
+			errs := []any{}
+			for _, invalid := range f.invalidConfigs {
+				if invalid == key {
+					errs = append(errs, "Invalid value")
+					result.ErrorCount++
+				}
+			}
+			configType := "STRING"
+			if slices.Contains(f.passwordConfigs, key) {
+				configType = "PASSWORD"
+			}
+			result.Configs = append(result.Configs, con.ConnectorValidationResultConfig{
+				Definition: map[string]any{"name": key, "type": configType, "importance": "HIGH", "group": "Common", "order": 1},
+				Value:      map[string]any{"name": key, "value": value, "errors": errs, "visible": true, "recommended_values": []any{}},
+			})
+		}
+		// Definitions of all plugin configs are returned, even if they are not set
+		for _, key := range f.passwordConfigs {
+			if _, exists := cfg[key]; !exists {
+				result.Configs = append(result.Configs, con.ConnectorValidationResultConfig{
+					Definition: map[string]any{"name": key, "type": "PASSWORD", "importance": "HIGH", "group": "Common", "order": 1},
+					Value:      map[string]any{"name": key, "value": nil, "errors": []any{}, "visible": true, "recommended_values": []any{}},
+				})
+			}
+		}
+		writeFakeConnectJSON(w, result)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func writeFakeConnectJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeConnectError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(con.ApiError{ErrorCode: status, Message: message})
+}
+
+func newTestMigrationService(t *testing.T) (svc *Service, source, target *fakeConnectCluster) {
+	t.Helper()
+
+	source, sourceSrv := newFakeConnectCluster(t)
+	target, targetSrv := newFakeConnectCluster(t)
+	clients := make(map[string]*ClientWithConfig)
+	for name, srv := range map[string]*httptest.Server{"old": sourceSrv, "new": targetSrv} {
+		clients[name] = &ClientWithConfig{
+			Client: con.NewClient(con.WithHost(srv.URL), con.WithTimeout(5*time.Second)),
+			Cfg:    config.KafkaConnectCluster{Name: name, URL: srv.URL},
+		}
+	}
+
+	source.connectors["pg-source"] = &fakeConnector{
+		config: map[string]string{
+			"connector.class": "io.example.PostgresSourceConnector",
+			"name":            "pg-source",
+			"tasks.max":       "1",
+		},
+		state: connectorStateRunning,
+		offsets: ConnectorOffsets{Offsets: []ConnectorOffset{
+			{Partition: map[string]any{"server": "db1"}, Offset: map[string]any{"lsn": float64(42)}},
+		}},
+	}
+
+	return &Service{
+		Cfg:              config.KafkaConnect{RequestTimeout: 5 * time.Second},
+		Logger:           slog.New(slog.DiscardHandler),
+		ClientsByCluster: clients,
+		Interceptor:      interceptor.NewInterceptor(),
+	}, source, target
+}
+
+func migrationStepStatuses(steps []ConnectorMigrationStep) map[ConnectorMigrationStepName]ConnectorMigrationStepStatus {
+	statuses := make(map[ConnectorMigrationStepName]ConnectorMigrationStepStatus)
+	for _, step := range steps {
+		statuses[step.Step] = step.Status
+	}
+	return statuses
+}
+
+func TestMigrateConnector(t *testing.T) {
+	svc, source, target := newTestMigrationService(t)
+
+	report, restErr := svc.MigrateConnector(context.Background(), MigrateConnectorRequest{
+		SourceClusterName: "old",
+		ConnectorName:     "pg-source",
+		TargetClusterName: "new",
+		PauseSource:       true,
+		TransferOffsets:   true,
+		SourceAction:      ConnectorMigrationSourceActionDelete,
+	})
+	require.Nil(t, restErr)
+	require.True(t, report.Success, "%+v", report.Steps)
+	assert.Empty(t, report.Rollback)
+	assert.Equal(t, map[ConnectorMigrationStepName]ConnectorMigrationStepStatus{
+		ConnectorMigrationStepFetchSourceConfig: ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepValidateTarget:    ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepPauseSource:       ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepReadOffsets:       ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepCreateTarget:      ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepTransferOffsets:   ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepResumeTarget:      ConnectorMigrationStepStatusSucceeded,
+		ConnectorMigrationStepFinalizeSource:    ConnectorMigrationStepStatusSucceeded,
+	}, migrationStepStatuses(report.Steps))
+
+	assert.Empty(t, source.connectors)
+	migrated := target.connectors["pg-source"]
+	require.NotNil(t, migrated)
+	assert.Equal(t, connectorStateRunning, migrated.state)
+	assert.Equal(t, "io.example.PostgresSourceConnector", migrated.config["connector.class"])
+	require.Len(t, migrated.offsets.Offsets, 1)
+	assert.Equal(t, float64(42), migrated.offsets.Offsets[0].Offset["lsn"])
+}
+
+func TestMigrateConnectorRollback(t *testing.T) {
+	svc, source, target := newTestMigrationService(t)
+	target.failRequests = []string{"PATCH /connectors/{name}/offsets"}
+
+	report, restErr := svc.MigrateConnector(context.Background(), MigrateConnectorRequest{
+		SourceClusterName: "old",
+		ConnectorName:     "pg-source",
+		TargetClusterName: "new",
+		PauseSource:       true,
+		TransferOffsets:   true,
+		SourceAction:      ConnectorMigrationSourceActionDelete,
+	})
+	require.Nil(t, restErr)
+	assert.False(t, report.Success)
+
+	statuses := migrationStepStatuses(report.Steps)
+	assert.Equal(t, ConnectorMigrationStepStatusFailed, statuses[ConnectorMigrationStepTransferOffsets])
+	assert.NotContains(t, statuses, ConnectorMigrationStepFinalizeSource)
+
+	// Rollback happens in reverse order
+	require.Len(t, report.Rollback, 2)
+	assert.Equal(t, ConnectorMigrationStepDeleteTarget, report.Rollback[0].Step)
+	assert.Equal(t, ConnectorMigrationStepResumeSource, report.Rollback[1].Step)
+	assert.Equal(t, ConnectorMigrationStepStatusSucceeded, report.Rollback[1].Status)
+
+	assert.Empty(t, target.connectors)
+	require.Contains(t, source.connectors, "pg-source")
+	assert.Equal(t, connectorStateRunning, source.connectors["pg-source"].state)
+}
+
+func TestMigrateConnectorInvalidTargetConfig(t *testing.T) {
+	svc, source, target := newTestMigrationService(t)
+	target.invalidConfigs = []string{"tasks.max"}
+
+	report, restErr := svc.MigrateConnector(context.Background(), MigrateConnectorRequest{
+		SourceClusterName: "old",
+		ConnectorName:     "pg-source",
+		TargetClusterName: "new",
+		PauseSource:       true,
+	})
+	require.Nil(t, restErr)
+	assert.False(t, report.Success)
+	require.Len(t, report.Steps, 2)
+	assert.Equal(t, ConnectorMigrationStepStatusFailed, report.Steps[1].Status)
+	assert.Contains(t, report.Steps[1].Message, "tasks.max: Invalid value")
+	assert.Empty(t, report.Rollback)
+	assert.Empty(t, target.connectors)
+	assert.Equal(t, connectorStateRunning, source.connectors["pg-source"].state)
+}
+
+func TestMigrateConnectorTargetExists(t *testing.T) {
+	svc, _, target := newTestMigrationService(t)
+	target.connectors["pg-source"] = &fakeConnector{state: connectorStateRunning}
+
+	_, restErr := svc.MigrateConnector(context.Background(), MigrateConnectorRequest{
+		SourceClusterName: "old",
+		ConnectorName:     "pg-source",
+		TargetClusterName: "new",
+	})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusConflict, restErr.Status)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+)
+
+// GetConnectorOffsets returns the committed offsets of a source or sink connector.
+// This requires Kafka connect 3.6 or newer.
+func (s *Service) GetConnectorOffsets(ctx context.Context, clusterName string, connector string) (ConnectorOffsets, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return ConnectorOffsets{}, restErr
+	}
+
+	offsets, err := c.getConnectorOffsets(ctx, connector)
+	if err != nil {
+		return ConnectorOffsets{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to get connector offsets: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+	if offsets.Offsets == nil {
+		offsets.Offsets = []ConnectorOffset{}
+	}
+
+	return offsets, nil
+}
+
+// AlterConnectorOffsets overwrites the offsets of the given partitions. Partitions
+// with a nil offset are reset. The connector must be in the STOPPED state.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, clusterName string, connector string, offsets ConnectorOffsets) *rest.Error {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return restErr
+	}
+	if restErr := s.ensureConnectorStopped(ctx, c, connector); restErr != nil {
+		return restErr
+	}
+
+	err := c.alterConnectorOffsets(ctx, connector, offsets)
+	if err != nil {
+		return &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to alter connector offsets: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return nil
+}
+
+// ResetConnectorOffsets resets the offsets of all partitions of a connector, so that
+// it starts from scratch when it is resumed. The connector must be in the STOPPED
+// state.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, clusterName string, connector string) *rest.Error {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return restErr
+	}
+	if restErr := s.ensureConnectorStopped(ctx, c, connector); restErr != nil {
+		return restErr
+	}
+
+	err := c.resetConnectorOffsets(ctx, connector)
+	if err != nil {
+		return &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to reset connector offsets: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return nil
+}
+
+// ensureConnectorStopped returns an error if the connector is not in the STOPPED
+// state. Kafka connect rejects offset changes of running connectors as well, but
+// checking upfront avoids relying on the error message of the worker.
+func (*Service) ensureConnectorStopped(ctx context.Context, c *ClientWithConfig, connector string) *rest.Error {
+	status, err := c.Client.GetConnectorStatus(ctx, connector)
+	if err != nil {
+		return &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector state: %v", err.Error()),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", c.Cfg.Name), slog.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	if status.Connector.State != connectorStateStopped {
+		return &rest.Error{
+			Err:          errors.New("connector is not stopped"),
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Connector offsets can only be changed while the connector is STOPPED, but its state is %v", status.Connector.State),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", c.Cfg.Name), slog.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return nil
+}
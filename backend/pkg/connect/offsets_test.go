@@ -0,0 +1,59 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorOffsets(t *testing.T) {
+	ctx := context.Background()
+	svc, source, _ := newTestMigrationService(t)
+
+	offsets, restErr := svc.GetConnectorOffsets(ctx, "old", "pg-source")
+	require.Nil(t, restErr)
+	require.Len(t, offsets.Offsets, 1)
+	assert.Equal(t, float64(42), offsets.Offsets[0].Offset["lsn"])
+
+	_, restErr = svc.GetConnectorOffsets(ctx, "old", "unknown")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusNotFound, restErr.Status)
+
+	// Offsets can only be changed while the connector is stopped
+	altered := ConnectorOffsets{Offsets: []ConnectorOffset{
+		{Partition: map[string]any{"server": "db1"}, Offset: map[string]any{"lsn": float64(100)}},
+	}}
+	restErr = svc.AlterConnectorOffsets(ctx, "old", "pg-source", altered)
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+	restErr = svc.ResetConnectorOffsets(ctx, "old", "pg-source")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+	assert.Len(t, source.connectors["pg-source"].offsets.Offsets, 1)
+
+	require.Nil(t, svc.StopConnector(ctx, "old", "pg-source"))
+
+	require.Nil(t, svc.AlterConnectorOffsets(ctx, "old", "pg-source", altered))
+	offsets, restErr = svc.GetConnectorOffsets(ctx, "old", "pg-source")
+	require.Nil(t, restErr)
+	require.Len(t, offsets.Offsets, 1)
+	assert.Equal(t, float64(100), offsets.Offsets[0].Offset["lsn"])
+
+	require.Nil(t, svc.ResetConnectorOffsets(ctx, "old", "pg-source"))
+	offsets, restErr = svc.GetConnectorOffsets(ctx, "old", "pg-source")
+	require.Nil(t, restErr)
+	assert.Empty(t, offsets.Offsets)
+	assert.NotNil(t, offsets.Offsets)
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	con "github.com/cloudhut/connect-client"
+)
+
+// PluginGuide describes which guide is used to setup connectors of a plugin.
+type PluginGuide string
+
+const (
+	// PluginGuideCustom means that Console has a connector specific guide for the plugin.
+	PluginGuideCustom PluginGuide = "CUSTOM"
+	// PluginGuideDefault means that the plugin's configs are grouped by the default guide.
+	PluginGuideDefault PluginGuide = "DEFAULT"
+)
+
+// PluginCatalog is the inventory of all connector plugins that are installed
+// across all Kafka connect clusters.
+type PluginCatalog struct {
+	Plugins  []CatalogPlugin        `json:"plugins"`
+	Clusters []PluginCatalogCluster `json:"clusters"`
+}
+
+// PluginCatalogCluster reports whether the plugins of a cluster could be listed.
+type PluginCatalogCluster struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// CatalogPlugin is a connector plugin class along with the versions that are
+// installed in each cluster.
+type CatalogPlugin struct {
+	Class string      `json:"class"`
+	Type  string      `json:"type"`
+	Guide PluginGuide `json:"guide"`
+	// Versions are all distinct versions across all clusters.
+	Versions []string `json:"versions"`
+	// VersionSkew is true if the plugin is installed in different versions.
+	VersionSkew bool `json:"versionSkew"`
+	// Clusters are the clusters that have the plugin installed.
+	Clusters []CatalogPluginCluster `json:"clusters"`
+	// MissingInClusters are reachable clusters that don't have the plugin installed.
+	MissingInClusters []string `json:"missingInClusters"`
+}
+
+// CatalogPluginCluster are the versions of a plugin installed in a cluster, along with
+// the connectors that use the plugin.
+type CatalogPluginCluster struct {
+	ClusterName string   `json:"clusterName"`
+	Versions    []string `json:"versions"`
+	Connectors  []string `json:"connectors"`
+}
+
+// pluginInventory is the plugins and connectors of a single cluster.
+type pluginInventory struct {
+	clusterName string
+	plugins     []con.ConnectorPluginInfo
+	// connectorsByClass are the connector names by their connector class.
+	connectorsByClass map[string][]string
+	err               error
+}
+
+// GetPluginCatalog lists the connector plugins of all clusters concurrently and merges
+// them into a catalog. Clusters that can't be reached are reported in the catalog
+// rather than failing the whole request.
+func (s *Service) GetPluginCatalog(ctx context.Context) (PluginCatalog, error) {
+	if !s.Cfg.Enabled {
+		return PluginCatalog{}, ErrKafkaConnectNotConfigured
+	}
+
+	clusters := s.Clusters()
+	inventories := make([]pluginInventory, 0, len(clusters))
+	var mu sync.Mutex
+	wg := sync.WaitGroup{}
+	for _, c := range clusters {
+		wg.Go(func() {
+			inventory := s.getPluginInventory(ctx, c)
+			mu.Lock()
+			inventories = append(inventories, inventory)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return s.newPluginCatalog(inventories), nil
+}
+
+func (s *Service) getPluginInventory(ctx context.Context, c *ClientWithConfig) pluginInventory {
+	inventory := pluginInventory{clusterName: c.Cfg.Name, connectorsByClass: make(map[string][]string)}
+
+	childCtx, cancel := context.WithTimeout(ctx, s.Cfg.RequestTimeout)
+	defer cancel()
+	inventory.plugins, inventory.err = c.Client.GetConnectorPlugins(childCtx)
+	if inventory.err != nil {
+		s.Logger.WarnContext(ctx, "failed to list connector plugins of Kafka connect cluster",
+			slog.String("cluster_name", c.Cfg.Name), slog.Any("error", inventory.err))
+		return inventory
+	}
+
+	connectors, err := c.Client.ListConnectorsExpanded(childCtx)
+	if err != nil {
+		// The plugins are still useful without the connectors that use them
+		s.Logger.WarnContext(ctx, "failed to list connectors for plugin catalog",
+			slog.String("cluster_name", c.Cfg.Name), slog.Any("error", err))
+		return inventory
+	}
+	for name, connector := range connectors {
+		class := connector.Info.Config["connector.class"]
+		inventory.connectorsByClass[class] = append(inventory.connectorsByClass[class], name)
+	}
+
+	return inventory
+}
+
+// newPluginCatalog merges the inventories of all clusters. Plugins and clusters are
+// ordered by their name.
+func (s *Service) newPluginCatalog(inventories []pluginInventory) PluginCatalog {
+	slices.SortFunc(inventories, func(a, b pluginInventory) int {
+		return strings.Compare(a.clusterName, b.clusterName)
+	})
+
+	catalog := PluginCatalog{
+		Plugins:  make([]CatalogPlugin, 0),
+		Clusters: make([]PluginCatalogCluster, 0, len(inventories)),
+	}
+	pluginsByClass := make(map[string]*CatalogPlugin)
+	reachableClusters := make([]string, 0, len(inventories))
+	for _, inventory := range inventories {
+		catalogCluster := PluginCatalogCluster{Name: inventory.clusterName}
+		if inventory.err != nil {
+			catalogCluster.Error = inventory.err.Error()
+			catalog.Clusters = append(catalog.Clusters, catalogCluster)
+			continue
+		}
+		catalog.Clusters = append(catalog.Clusters, catalogCluster)
+		reachableClusters = append(reachableClusters, inventory.clusterName)
+
+		// Kafka connect lists each installed version of a plugin separately
+		versionsByClass := make(map[string][]string)
+		for _, plugin := range inventory.plugins {
+			if _, exists := pluginsByClass[plugin.Class]; !exists {
+				pluginsByClass[plugin.Class] = &CatalogPlugin{
+					Class: plugin.Class,
+					Type:  plugin.Type,
+					Guide: s.pluginGuide(plugin.Class),
+				}
+			}
+			versionsByClass[plugin.Class] = append(versionsByClass[plugin.Class], plugin.Version)
+		}
+		for class, versions := range versionsByClass {
+			slices.Sort(versions)
+			connectors := inventory.connectorsByClass[class]
+			if connectors == nil {
+				connectors = []string{}
+			}
+			slices.Sort(connectors)
+			pluginsByClass[class].Clusters = append(pluginsByClass[class].Clusters, CatalogPluginCluster{
+				ClusterName: inventory.clusterName,
+				Versions:    slices.Compact(versions),
+				Connectors:  connectors,
+			})
+		}
+	}
+
+	for _, class := range slices.Sorted(maps.Keys(pluginsByClass)) {
+		plugin := pluginsByClass[class]
+		installedClusters := make(map[string]struct{}, len(plugin.Clusters))
+		var versions []string
+		for _, cluster := range plugin.Clusters {
+			installedClusters[cluster.ClusterName] = struct{}{}
+			versions = append(versions, cluster.Versions...)
+		}
+		slices.Sort(versions)
+		plugin.Versions = slices.Compact(versions)
+		plugin.VersionSkew = len(plugin.Versions) > 1
+
+		plugin.MissingInClusters = make([]string, 0)
+		for _, clusterName := range reachableClusters {
+			if _, exists := installedClusters[clusterName]; !exists {
+				plugin.MissingInClusters = append(plugin.MissingInClusters, clusterName)
+			}
+		}
+		catalog.Plugins = append(catalog.Plugins, *plugin)
+	}
+
+	return catalog
+}
+
+func (s *Service) pluginGuide(class string) PluginGuide {
+	if s.Interceptor != nil && s.Interceptor.HasGuide(class) {
+		return PluginGuideCustom
+	}
+	return PluginGuideDefault
+}
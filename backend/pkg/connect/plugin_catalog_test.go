@@ -0,0 +1,103 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
+)
+
+const httpSourceClass = "com.github.castorm.kafka.connect.http.HttpSourceConnector"
+
+func newFakePluginCluster(t *testing.T, plugins []con.ConnectorPluginInfo, connectorClasses map[string]string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /connector-plugins", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeConnectJSON(w, plugins)
+	})
+	mux.HandleFunc("GET /connectors", func(w http.ResponseWriter, _ *http.Request) {
+		connectors := make(map[string]con.ListConnectorsResponseExpanded)
+		for name, class := range connectorClasses {
+			connectors[name] = con.ListConnectorsResponseExpanded{
+				Info: con.ConnectorInfo{Name: name, Config: map[string]string{"connector.class": class}},
+			}
+		}
+		writeFakeConnectJSON(w, connectors)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetPluginCatalog(t *testing.T) {
+	oldSrv := newFakePluginCluster(t, []con.ConnectorPluginInfo{
+		{Class: httpSourceClass, Type: "source", Version: "0.8.11"},
+		{Class: "com.example.WarehouseSink", Type: "sink", Version: "1.0.0"},
+	}, map[string]string{"http-b": httpSourceClass, "http-a": httpSourceClass, "warehouse": "com.example.WarehouseSink"})
+	newSrv := newFakePluginCluster(t, []con.ConnectorPluginInfo{
+		{Class: httpSourceClass, Type: "source", Version: "0.8.11"},
+		{Class: httpSourceClass, Type: "source", Version: "0.9.0"},
+	}, map[string]string{})
+	failingSrv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(failingSrv.Close)
+
+	clients := make(map[string]*ClientWithConfig)
+	for name, srv := range map[string]*httptest.Server{"old": oldSrv, "new": newSrv, "offline": failingSrv} {
+		clients[name] = &ClientWithConfig{
+			Client: con.NewClient(con.WithHost(srv.URL)),
+			Cfg:    config.KafkaConnectCluster{Name: name, URL: srv.URL},
+		}
+	}
+	svc := &Service{
+		Cfg:              config.KafkaConnect{Enabled: true, RequestTimeout: 5 * time.Second},
+		Logger:           slog.New(slog.DiscardHandler),
+		ClientsByCluster: clients,
+		Interceptor:      interceptor.NewInterceptor(),
+	}
+
+	catalog, err := svc.GetPluginCatalog(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, catalog.Clusters, 3)
+	assert.Equal(t, "new", catalog.Clusters[0].Name)
+	assert.Empty(t, catalog.Clusters[0].Error)
+	assert.Equal(t, "offline", catalog.Clusters[1].Name)
+	assert.NotEmpty(t, catalog.Clusters[1].Error)
+
+	require.Len(t, catalog.Plugins, 2)
+	httpSource := catalog.Plugins[1]
+	assert.Equal(t, httpSourceClass, httpSource.Class)
+	assert.Equal(t, PluginGuideCustom, httpSource.Guide)
+	assert.Equal(t, []string{"0.8.11", "0.9.0"}, httpSource.Versions)
+	assert.True(t, httpSource.VersionSkew)
+	assert.Empty(t, httpSource.MissingInClusters)
+	assert.Equal(t, []CatalogPluginCluster{
+		{ClusterName: "new", Versions: []string{"0.8.11", "0.9.0"}, Connectors: []string{}},
+		{ClusterName: "old", Versions: []string{"0.8.11"}, Connectors: []string{"http-a", "http-b"}},
+	}, httpSource.Clusters)
+
+	warehouse := catalog.Plugins[0]
+	assert.Equal(t, PluginGuideDefault, warehouse.Guide)
+	assert.False(t, warehouse.VersionSkew)
+	assert.Equal(t, []string{"new"}, warehouse.MissingInClusters)
+	assert.Equal(t, []string{"warehouse"}, warehouse.Clusters[0].Connectors)
+}
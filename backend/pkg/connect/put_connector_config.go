@@ -20,8 +20,13 @@ import (
 	con "github.com/cloudhut/connect-client"
 )
 
-// PutConnectorConfig overwrites an existent connector config.
+// PutConnectorConfig overwrites an existent connector config. Masked values of
+// sensitive config properties are kept from the connector's current config.
 func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, connectorName string, req con.PutConnectorConfigOptions) (con.ConnectorInfo, *rest.Error) {
+	return s.putConnectorConfig(ctx, clusterName, connectorName, req, ConnectorConfigOperationUpdate, 0)
+}
+
+func (s *Service) putConnectorConfig(ctx context.Context, clusterName string, connectorName string, req con.PutConnectorConfigOptions, op ConnectorConfigOperation, rolledBackFrom int) (con.ConnectorInfo, *rest.Error) {
 	c, restErr := s.getConnectClusterByName(clusterName)
 	if restErr != nil {
 		return con.ConnectorInfo{}, restErr
@@ -36,13 +41,21 @@ func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, co
 			IsSilent: false,
 		}
 	}
+	req.Config, restErr = s.restoreRedactedConfigValues(ctx, c, connectorName, req.Config)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
 	req.Config = s.Interceptor.ConsoleToKafkaConnect(className, req.Config)
+	req.Config, restErr = s.externalizeConnectorSecrets(ctx, c, connectorName, className, req.Config)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
 
 	cInfo, err := c.Client.PutConnectorConfig(ctx, connectorName, req)
 	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
 	cInfo = con.ConnectorInfo{
 		Name:   cInfo.Name,
-		Config: s.Interceptor.KafkaConnectToConsole(connectorClass, cInfo.Config),
+		Config: s.connectorConfigToConsole(ctx, clusterName, connectorClass, cInfo.Config),
 		Tasks:  cInfo.Tasks,
 		Type:   cInfo.Type,
 	}
@@ -56,6 +69,8 @@ func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, co
 			IsSilent:     false,
 		}
 	}
+	s.deleteConnectorSecrets(ctx, clusterName, connectorName, req.Config)
+	s.recordConnectorConfig(ctx, clusterName, connectorName, op, rolledBackFrom, cInfo.Config)
 
 	return cInfo, nil
 }
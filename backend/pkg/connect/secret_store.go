@@ -0,0 +1,48 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrSecretNotFound is returned by a SecretStore if a secret does not exist.
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrSecretAlreadyExists is returned by a SecretStore if a secret that shall
+	// be created exists already.
+	ErrSecretAlreadyExists = errors.New("secret already exists")
+)
+
+// Secret is a single secret in a SecretStore.
+type Secret struct {
+	ID     string            `json:"id"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Scopes are the names of the scopes the secret is available in.
+	Scopes []string `json:"scopes,omitempty"`
+	// Data is the secret value. It must never be returned to API clients.
+	Data []byte `json:"data"`
+}
+
+// SecretStore is a pluggable backend that stores secrets, such as the sensitive
+// connector config properties that have been externalized.
+type SecretStore interface {
+	// Get returns the secret with the given ID or ErrSecretNotFound.
+	Get(ctx context.Context, id string) (Secret, error)
+	// List returns all secrets ordered by ID.
+	List(ctx context.Context) ([]Secret, error)
+	// Create stores a new secret or returns ErrSecretAlreadyExists.
+	Create(ctx context.Context, secret Secret) error
+	// Put creates or replaces the secret.
+	Put(ctx context.Context, secret Secret) error
+	// Delete removes the secret with the given ID or returns ErrSecretNotFound.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var _ SecretStore = (*FileSecretStore)(nil)
+
+// FileSecretStore stores all secrets in a single local file that is encrypted
+// with AES-256-GCM. It is meant for single instance deployments.
+type FileSecretStore struct {
+	path string
+	aead cipher.AEAD
+
+	mu sync.Mutex
+}
+
+// NewFileSecretStore creates a secret store that persists secrets in the given
+// file. The key must be 32 bytes long.
+func NewFileSecretStore(path string, key []byte) (*FileSecretStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secrets cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secrets cipher: %w", err)
+	}
+	store := &FileSecretStore{path: path, aead: aead}
+
+	// Read the file once, so that a wrong key is reported on startup
+	if _, err := store.read(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// Get returns the secret with the given ID.
+func (f *FileSecretStore) Get(_ context.Context, id string) (Secret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return Secret{}, err
+	}
+	secret, exists := secrets[id]
+	if !exists {
+		return Secret{}, ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+// List returns all secrets ordered by ID.
+func (f *FileSecretStore) List(_ context.Context) ([]Secret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		list = append(list, secret)
+	}
+	slices.SortFunc(list, func(a, b Secret) int { return strings.Compare(a.ID, b.ID) })
+	return list, nil
+}
+
+// Create stores a new secret.
+func (f *FileSecretStore) Create(_ context.Context, secret Secret) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, exists := secrets[secret.ID]; exists {
+		return ErrSecretAlreadyExists
+	}
+	secrets[secret.ID] = secret
+	return f.write(secrets)
+}
+
+// Put creates or replaces the secret.
+func (f *FileSecretStore) Put(_ context.Context, secret Secret) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	secrets[secret.ID] = secret
+	return f.write(secrets)
+}
+
+// Delete removes the secret with the given ID.
+func (f *FileSecretStore) Delete(_ context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, exists := secrets[id]; !exists {
+		return ErrSecretNotFound
+	}
+	delete(secrets, id)
+	return f.write(secrets)
+}
+
+func (f *FileSecretStore) read() (map[string]Secret, error) {
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return make(map[string]Secret), nil
+		}
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	nonceSize := f.aead.NonceSize()
+	if len(content) < nonceSize {
+		return nil, errors.New("failed to decrypt secrets file: file is too short")
+	}
+	plaintext, err := f.aead.Open(nil, content[:nonceSize], content[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secrets file: %w", err)
+	}
+
+	secrets := make(map[string]Secret)
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to decode secrets file: %w", err)
+	}
+	return secrets, nil
+}
+
+// write encrypts the secrets with a new nonce and replaces the file atomically.
+func (f *FileSecretStore) write(secrets map[string]Secret) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	content := f.aead.Seal(nonce, nonce, plaintext, nil)
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create secrets directory: %w", err)
+	}
+	tmpPath := f.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+var _ SecretStore = (*WorkerExportSecretStore)(nil)
+
+// WorkerExportSecretStore wraps a secret store and writes all externalized connector
+// secrets to a Java properties file after each change. The Kafka connect workers can
+// resolve the secret references from that file with Kafka's FileConfigProvider, if
+// it is on a volume that is shared with the workers. The file contains the secrets
+// in plaintext.
+type WorkerExportSecretStore struct {
+	SecretStore
+	path string
+
+	mu sync.Mutex
+}
+
+// NewWorkerExportSecretStore wraps the given store and exports its connector secrets
+// to the given properties file once, so that the file is complete after startup.
+func NewWorkerExportSecretStore(ctx context.Context, store SecretStore, path string) (*WorkerExportSecretStore, error) {
+	w := &WorkerExportSecretStore{SecretStore: store, path: path}
+	if err := w.export(ctx); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Create stores a new secret and exports it to the workers.
+func (w *WorkerExportSecretStore) Create(ctx context.Context, secret Secret) error {
+	if err := w.SecretStore.Create(ctx, secret); err != nil {
+		return err
+	}
+	return w.export(ctx)
+}
+
+// Put creates or replaces the secret and exports it to the workers.
+func (w *WorkerExportSecretStore) Put(ctx context.Context, secret Secret) error {
+	if err := w.SecretStore.Put(ctx, secret); err != nil {
+		return err
+	}
+	return w.export(ctx)
+}
+
+// Delete removes the secret and its export.
+func (w *WorkerExportSecretStore) Delete(ctx context.Context, id string) error {
+	if err := w.SecretStore.Delete(ctx, id); err != nil {
+		return err
+	}
+	return w.export(ctx)
+}
+
+// export replaces the properties file atomically with all secrets that have been
+// externalized from connector configs.
+func (w *WorkerExportSecretStore) export(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	secrets, err := w.SecretStore.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export secrets to workers: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Generated by Redpanda Console, do not edit\n")
+	for _, secret := range secrets {
+		if secret.Labels[SecretLabelConnector] == "" {
+			continue
+		}
+		sb.WriteString(escapeProperty(secret.ID, true))
+		sb.WriteByte('=')
+		sb.WriteString(escapeProperty(string(secret.Data), false))
+		sb.WriteByte('\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(w.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create worker secrets directory: %w", err)
+	}
+	// The workers may run as another user of the same group
+	tmpPath := w.path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(sb.String()), 0o640); err != nil {
+		return fmt.Errorf("failed to write worker secrets file: %w", err)
+	}
+	if err := os.Rename(tmpPath, w.path); err != nil {
+		return fmt.Errorf("failed to write worker secrets file: %w", err)
+	}
+	return nil
+}
+
+// escapeProperty escapes a key or value for a Java properties file. Non ASCII
+// characters are written as unicode escapes, so that the file is valid in any
+// encoding that is used to read it.
+func escapeProperty(s string, isKey bool) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch {
+		case r == ' ' && (isKey || i == 0):
+			sb.WriteString(`\ `)
+		case r == '\\' || r == '=' || r == ':' || r == '#' || r == '!':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r == '\f':
+			sb.WriteString(`\f`)
+		case r < 0x20 || r > 0x7e:
+			for _, u := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&sb, `\u%04x`, u)
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
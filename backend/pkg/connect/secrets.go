@@ -0,0 +1,252 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+)
+
+const (
+	// SecretLabelCluster is the label of externalized secrets that holds the connect cluster name.
+	SecretLabelCluster = "kafka_connect_cluster"
+	// SecretLabelConnector is the label of externalized secrets that holds the connector name.
+	SecretLabelConnector = "kafka_connect_connector"
+	// SecretLabelConfig is the label of externalized secrets that holds the config property name.
+	SecretLabelConfig = "kafka_connect_config"
+)
+
+// secretIDInvalidCharsPattern matches all characters that are not allowed in secret IDs.
+var secretIDInvalidCharsPattern = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// isConfigProviderReference returns true if the config value references a config
+// provider, such as ${secretsManager:MY_SECRET}, rather than containing the value itself.
+func isConfigProviderReference(value string) bool {
+	return strings.HasPrefix(value, "${") && strings.HasSuffix(value, "}")
+}
+
+// connectorConfigToConsole converts a connector config that has been retrieved from
+// the connect cluster to the representation that is returned by Console. Sensitive
+// values are always masked.
+func (s *Service) connectorConfigToConsole(ctx context.Context, clusterName, pluginClassName string, config map[string]string) map[string]string {
+	return s.maskConnectorConfig(ctx, clusterName, pluginClassName, s.Interceptor.KafkaConnectToConsole(pluginClassName, config))
+}
+
+// maskConnectorConfig returns a copy of the config where the values of all sensitive
+// properties are masked. Config provider references are returned as they are.
+func (s *Service) maskConnectorConfig(ctx context.Context, clusterName, pluginClassName string, config map[string]string) map[string]string {
+	return redactConnectorConfig(config, s.sensitiveConfigKeys(ctx, clusterName, pluginClassName)...)
+}
+
+// sensitiveConfigKeys returns the PASSWORD config properties of the given plugin. If
+// the plugin has not been validated since startup, its config definitions are fetched
+// from the connect cluster first. If that fails, only the properties whose names look
+// sensitive are masked and the definitions are fetched again on the next call.
+func (s *Service) sensitiveConfigKeys(ctx context.Context, clusterName, pluginClassName string) []string {
+	keys, ok := s.sensitiveConfigKeysByPlugin.Load(clusterName + "/" + pluginClassName)
+	if ok {
+		return keys.([]string)
+	}
+
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil || pluginClassName == "" || pluginClassName == "unknown" {
+		return nil
+	}
+	validationResult, err := c.Client.PutValidateConnectorConfig(ctx, pluginClassName, con.ValidateConnectorConfigOptions{
+		Config: map[string]any{"connector.class": pluginClassName},
+	})
+	if err != nil {
+		s.Logger.WarnContext(ctx, "failed to fetch connector config definitions to detect sensitive config properties",
+			slog.String("cluster_name", clusterName), slog.String("plugin_class", pluginClassName), slog.Any("error", err))
+		return nil
+	}
+	return s.rememberSensitiveConfigKeys(clusterName, pluginClassName, validationResult)
+}
+
+// rememberSensitiveConfigKeys stores the PASSWORD config properties from a validate
+// response, so that they are masked when connector configs are read.
+func (s *Service) rememberSensitiveConfigKeys(clusterName, pluginClassName string, response con.ConnectorValidationResult) []string {
+	keys := s.Interceptor.SensitiveConfigKeys(pluginClassName, response)
+	s.sensitiveConfigKeysByPlugin.Store(clusterName+"/"+pluginClassName, keys)
+	return keys
+}
+
+// restoreRedactedConfigValues replaces masked values in the given config with the
+// values from the connector's current config. Masked values are sent back if the
+// user edits a connector config without changing its secrets.
+func (s *Service) restoreRedactedConfigValues(ctx context.Context, c *ClientWithConfig, connectorName string, config map[string]any) (map[string]any, *rest.Error) {
+	hasRedactedValues := false
+	for _, v := range config {
+		if v == redactedConfigValue {
+			hasRedactedValues = true
+			break
+		}
+	}
+	if !hasRedactedValues {
+		return config, nil
+	}
+
+	currentConfig, restErr := s.getConnectorConfig(ctx, c, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	restored := make(map[string]any, len(config))
+	var missingSecrets []string
+	for k, v := range config {
+		if v == redactedConfigValue {
+			currentValue, exists := currentConfig[k]
+			if !exists {
+				missingSecrets = append(missingSecrets, k)
+				continue
+			}
+			v = currentValue
+		}
+		restored[k] = v
+	}
+	if len(missingSecrets) > 0 {
+		slices.Sort(missingSecrets)
+		return nil, &rest.Error{
+			Err:      errors.New("redacted config properties are not set in the current connector config"),
+			Status:   http.StatusConflict,
+			Message:  fmt.Sprintf("Cannot restore the redacted config properties %v, because they are not set in the current connector config", strings.Join(missingSecrets, ", ")),
+			IsSilent: false,
+		}
+	}
+	return restored, nil
+}
+
+// externalizeConnectorSecrets moves the values of all PASSWORD config properties into
+// the secret store and replaces them with config provider references, which the
+// workers resolve from the file that the store exports the secrets to. The config is
+// expected in the representation that is sent to the connect cluster.
+func (s *Service) externalizeConnectorSecrets(ctx context.Context, c *ClientWithConfig, connectorName, pluginClassName string, config map[string]any) (map[string]any, *rest.Error) {
+	if s.Secrets == nil {
+		return config, nil
+	}
+	clusterName := c.Cfg.Name
+	logAttrs := []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector_name", connectorName)}
+
+	validationResult, err := c.Client.PutValidateConnectorConfig(ctx, pluginClassName, con.ValidateConnectorConfigOptions{Config: config})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to validate connector config for secret externalization: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to detect sensitive connector config properties: %v", err.Error()),
+			InternalLogs: logAttrs,
+			IsSilent:     false,
+		}
+	}
+	keys := s.rememberSensitiveConfigKeys(clusterName, pluginClassName, validationResult)
+
+	externalized := maps.Clone(config)
+	for _, key := range keys {
+		value, ok := externalized[key].(string)
+		if !ok || value == "" || isConfigProviderReference(value) {
+			continue
+		}
+
+		id, err := s.connectorSecretID(ctx, clusterName, connectorName, key)
+		if err == nil {
+			err = s.Secrets.Put(ctx, Secret{
+				ID: id,
+				Labels: map[string]string{
+					SecretLabelCluster:   clusterName,
+					SecretLabelConnector: connectorName,
+					SecretLabelConfig:    key,
+				},
+				Data: []byte(value),
+			})
+		}
+		if err != nil {
+			return nil, &rest.Error{
+				Err:          fmt.Errorf("failed to store connector secret: %w", err),
+				Status:       http.StatusInternalServerError,
+				Message:      fmt.Sprintf("Failed to store the value of %q in the secret store: %v", key, err.Error()),
+				InternalLogs: append(logAttrs, slog.String("config", key)),
+				IsSilent:     false,
+			}
+		}
+		externalized[key] = s.secretReference(id)
+	}
+
+	return externalized, nil
+}
+
+// deleteConnectorSecrets deletes the externalized secrets of a connector that are no
+// longer referenced by the given connector config, or all of its secrets if the config
+// is nil because the connector has been deleted. Failures are only logged, because the
+// connector change has already been applied.
+func (s *Service) deleteConnectorSecrets(ctx context.Context, clusterName, connectorName string, config map[string]any) {
+	if s.Secrets == nil {
+		return
+	}
+	logAttrs := []any{slog.String("cluster_name", clusterName), slog.String("connector_name", connectorName)}
+
+	secrets, err := s.Secrets.List(ctx)
+	if err != nil {
+		s.Logger.WarnContext(ctx, "failed to list connector secrets for deletion", append(logAttrs, slog.Any("error", err))...)
+		return
+	}
+	for _, secret := range secrets {
+		if secret.Labels[SecretLabelCluster] != clusterName || secret.Labels[SecretLabelConnector] != connectorName {
+			continue
+		}
+		if value, ok := config[secret.Labels[SecretLabelConfig]].(string); ok && value == s.secretReference(secret.ID) {
+			continue
+		}
+		if err := s.Secrets.Delete(ctx, secret.ID); err != nil && !errors.Is(err, ErrSecretNotFound) {
+			s.Logger.WarnContext(ctx, "failed to delete connector secret",
+				append(logAttrs, slog.String("secret_id", secret.ID), slog.Any("error", err))...)
+		}
+	}
+}
+
+// connectorSecretID returns the ID of the secret that holds the given connector config
+// property. IDs are derived from the names, so that a connector config update replaces
+// the previous secret. If the derived ID is already taken by another config property,
+// a hash of the names is appended.
+func (s *Service) connectorSecretID(ctx context.Context, clusterName, connectorName, key string) (string, error) {
+	name := strings.Join([]string{clusterName, connectorName, key}, "_")
+	id := "CONNECT_" + strings.Trim(secretIDInvalidCharsPattern.ReplaceAllString(strings.ToUpper(name), "_"), "_")
+
+	existing, err := s.Secrets.Get(ctx, id)
+	if errors.Is(err, ErrSecretNotFound) {
+		return id, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if existing.Labels[SecretLabelCluster] == clusterName &&
+		existing.Labels[SecretLabelConnector] == connectorName &&
+		existing.Labels[SecretLabelConfig] == key {
+		return id, nil
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(clusterName + "\x00" + connectorName + "\x00" + key))
+	return fmt.Sprintf("%s_%08X", id, h.Sum32()), nil
+}
+
+// secretReference returns the config provider reference for the given secret ID.
+func (s *Service) secretReference(id string) string {
+	return fmt.Sprintf("${%s:%s:%s}", s.Cfg.Secrets.ConfigProvider, s.Cfg.Secrets.WorkerConfigProviderPath(), id)
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSecretStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	key := bytes.Repeat([]byte{1}, 32)
+
+	store, err := NewFileSecretStore(path, key)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Create(ctx, Secret{ID: "DB_PASSWORD", Labels: map[string]string{"team": "data"}, Data: []byte("hunter2")}))
+	assert.ErrorIs(t, store.Create(ctx, Secret{ID: "DB_PASSWORD", Data: []byte("other")}), ErrSecretAlreadyExists)
+	require.NoError(t, store.Put(ctx, Secret{ID: "API_TOKEN", Scopes: []string{"SCOPE_REDPANDA_CLUSTER"}, Data: []byte("token")}))
+
+	secrets, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "API_TOKEN", secrets[0].ID)
+	assert.Equal(t, "DB_PASSWORD", secrets[1].ID)
+
+	// Secrets are encrypted at rest
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "hunter2")
+	assert.NotContains(t, string(content), "DB_PASSWORD")
+
+	reopened, err := NewFileSecretStore(path, key)
+	require.NoError(t, err)
+	secret, err := reopened.Get(ctx, "DB_PASSWORD")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hunter2"), secret.Data)
+	assert.Equal(t, "data", secret.Labels["team"])
+
+	_, err = NewFileSecretStore(path, bytes.Repeat([]byte{2}, 32))
+	assert.Error(t, err)
+
+	require.NoError(t, reopened.Delete(ctx, "DB_PASSWORD"))
+	assert.ErrorIs(t, reopened.Delete(ctx, "DB_PASSWORD"), ErrSecretNotFound)
+	_, err = reopened.Get(ctx, "DB_PASSWORD")
+	assert.ErrorIs(t, err, ErrSecretNotFound)
+}
+
+func TestExternalizeConnectorSecrets(t *testing.T) {
+	ctx := context.Background()
+	svc, cluster, _ := newTestMigrationService(t)
+	cluster.passwordConfigs = []string{"connection.password"}
+	fileStore, err := NewFileSecretStore(filepath.Join(t.TempDir(), "secrets.enc"), bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+	workerPath := filepath.Join(t.TempDir(), "secrets.properties")
+	store, err := NewWorkerExportSecretStore(ctx, fileStore, workerPath)
+	require.NoError(t, err)
+	svc.Secrets = store
+	svc.Cfg.Secrets.ConfigProvider = "file"
+	svc.Cfg.Secrets.ConfigProviderPath = "/etc/connect/secrets.properties"
+
+	_, restErr := svc.CreateConnector(ctx, "old", con.CreateConnectorRequest{
+		Name: "jdbc-orders",
+		Config: map[string]any{
+			"connector.class":     "io.example.JdbcSinkConnector",
+			"name":                "jdbc-orders",
+			"connection.password": "hunter2",
+		},
+	})
+	require.Nil(t, restErr)
+
+	reference := "${file:/etc/connect/secrets.properties:CONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD}"
+	assert.Equal(t, reference, cluster.connectors["jdbc-orders"].config["connection.password"])
+	// References are not masked on read
+	config, restErr := svc.GetConnectorConfig(ctx, "old", "jdbc-orders")
+	require.Nil(t, restErr)
+	assert.Equal(t, reference, config["connection.password"])
+	secret, err := store.Get(ctx, "CONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hunter2"), secret.Data)
+	assert.Equal(t, "jdbc-orders", secret.Labels[SecretLabelConnector])
+	// The workers resolve the reference from the exported file
+	exported, err := os.ReadFile(workerPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), "\nCONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD=hunter2\n")
+
+	// Updating the password replaces the secret, but keeps the reference
+	_, restErr = svc.PutConnectorConfig(ctx, "old", "jdbc-orders", con.PutConnectorConfigOptions{Config: map[string]any{
+		"connector.class":     "io.example.JdbcSinkConnector",
+		"name":                "jdbc-orders",
+		"connection.password": "s3cret",
+	}})
+	require.Nil(t, restErr)
+	assert.Equal(t, reference, cluster.connectors["jdbc-orders"].config["connection.password"])
+	secret, err = store.Get(ctx, "CONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("s3cret"), secret.Data)
+	exported, err = os.ReadFile(workerPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), "\nCONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD=s3cret\n")
+
+	// Secrets are deleted once they are no longer referenced
+	cluster.passwordConfigs = append(cluster.passwordConfigs, "ssh.password")
+	_, restErr = svc.PutConnectorConfig(ctx, "old", "jdbc-orders", con.PutConnectorConfigOptions{Config: map[string]any{
+		"connector.class": "io.example.JdbcSinkConnector",
+		"name":            "jdbc-orders",
+		"ssh.password":    "tunnel",
+	}})
+	require.Nil(t, restErr)
+	_, err = store.Get(ctx, "CONNECT_OLD_JDBC_ORDERS_CONNECTION_PASSWORD")
+	assert.ErrorIs(t, err, ErrSecretNotFound)
+	_, err = store.Get(ctx, "CONNECT_OLD_JDBC_ORDERS_SSH_PASSWORD")
+	require.NoError(t, err)
+
+	require.Nil(t, svc.DeleteConnector(ctx, "old", "jdbc-orders"))
+	secrets, err := store.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, secrets)
+	exported, err = os.ReadFile(workerPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(exported), "tunnel")
+}
+
+func TestEscapeProperty(t *testing.T) {
+	assert.Equal(t, `\ a\=b\:c\\d\n\u00e4 e`, escapeProperty(" a=b:c\\d\nä e", false))
+	assert.Equal(t, `MY\ KEY`, escapeProperty("MY KEY", true))
+}
+
+func TestMaskConnectorConfig(t *testing.T) {
+	ctx := context.Background()
+	svc, cluster, _ := newTestMigrationService(t)
+	cluster.passwordConfigs = []string{"db.pw"}
+	cluster.connectors["jdbc-orders"] = &fakeConnector{
+		config: map[string]string{
+			"connector.class":     "io.example.JdbcSinkConnector",
+			"name":                "jdbc-orders",
+			"connection.password": "hunter2",
+			"db.pw":               "s3cret",
+			"api.token":           "${file:/etc/connect/secrets.properties:TOKEN}",
+		},
+		state: connectorStateRunning,
+	}
+
+	// PASSWORD properties are detected even if the plugin has not been validated before
+	config, restErr := svc.GetConnectorConfig(ctx, "old", "jdbc-orders")
+	require.Nil(t, restErr)
+	assert.Equal(t, redactedConfigValue, config["connection.password"])
+	assert.Equal(t, redactedConfigValue, config["db.pw"])
+	assert.Equal(t, "${file:/etc/connect/secrets.properties:TOKEN}", config["api.token"])
+
+	info, restErr := svc.GetConnectorInfo(ctx, "old", "jdbc-orders")
+	require.Nil(t, restErr)
+	assert.Equal(t, redactedConfigValue, info.Config["db.pw"])
+	connectors, restErr := svc.GetClusterConnectors(ctx, "old")
+	require.Nil(t, restErr)
+	for _, connector := range connectors.Connectors {
+		if connector.Name == "jdbc-orders" {
+			assert.Equal(t, redactedConfigValue, connector.Config["db.pw"])
+		}
+	}
+
+	// If the definitions can't be fetched, only properties with sensitive names are masked
+	cluster.failRequests = []string{"PUT /connector-plugins/{class}/config/validate"}
+	svc.sensitiveConfigKeysByPlugin.Clear()
+	config, restErr = svc.GetConnectorConfig(ctx, "old", "jdbc-orders")
+	require.Nil(t, restErr)
+	assert.Equal(t, redactedConfigValue, config["connection.password"])
+	assert.Equal(t, "s3cret", config["db.pw"])
+	cluster.failRequests = nil
+	config, restErr = svc.GetConnectorConfig(ctx, "old", "jdbc-orders")
+	require.Nil(t, restErr)
+
+	// Sending back masked values keeps the current values
+	update := make(map[string]any, len(config))
+	for k, v := range config {
+		update[k] = v
+	}
+	update["tasks.max"] = "2"
+	_, restErr = svc.PutConnectorConfig(ctx, "old", "jdbc-orders", con.PutConnectorConfigOptions{Config: update})
+	require.Nil(t, restErr)
+	assert.Equal(t, "hunter2", cluster.connectors["jdbc-orders"].config["connection.password"])
+	assert.Equal(t, "s3cret", cluster.connectors["jdbc-orders"].config["db.pw"])
+	assert.Equal(t, "2", cluster.connectors["jdbc-orders"].config["tasks.max"])
+}
@@ -15,6 +15,7 @@ import (
 	"log/slog"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	con "github.com/cloudhut/connect-client"
 
@@ -29,6 +30,25 @@ type Service struct {
 	// ClientsByCluster holds the Client and config. The key is the clusters' name
 	ClientsByCluster map[string]*ClientWithConfig
 	Interceptor      *interceptor.Interceptor
+	// ConfigHistory stores all connector configs that are applied via Console.
+	// It is nil if the config history is disabled.
+	ConfigHistory ConfigHistoryStore
+	// Remediation restarts failed connectors and tasks in the background.
+	// It is nil if the auto remediation is disabled.
+	Remediation *RemediationSupervisor
+	// Registry adds and removes clusters at runtime and probes their health.
+	// It is nil if Kafka connect is disabled.
+	Registry *ClusterRegistry
+	// Secrets stores the externalized values of sensitive connector config properties.
+	// It is nil if the secrets externalization is disabled.
+	Secrets SecretStore
+
+	// sensitiveConfigKeysByPlugin caches the PASSWORD config properties per cluster
+	// and plugin class, so that they can be masked when connector configs are read.
+	sensitiveConfigKeysByPlugin sync.Map
+
+	// clustersMutex guards ClientsByCluster, as clusters may be added and removed at runtime.
+	clustersMutex sync.RWMutex
 }
 
 // ClientWithConfig carries the Kafka KafkaConnect client, along with the configuration
@@ -36,6 +56,46 @@ type Service struct {
 type ClientWithConfig struct {
 	Client *con.Client
 	Cfg    config.KafkaConnectCluster
+	// Source is where the cluster has been configured.
+	Source ClusterSource
+}
+
+// newClientWithConfig creates a Kafka connect HTTP client for the given cluster.
+func newClientWithConfig(clusterCfg config.KafkaConnectCluster, source ClusterSource, readTimeout time.Duration, logger *slog.Logger) *ClientWithConfig {
+	// Create dedicated KafkaConnect HTTP Client for each cluster
+	childLogger := logger.With(
+		slog.String("cluster_name", clusterCfg.Name),
+		slog.String("cluster_address", clusterCfg.URL))
+
+	opts := []con.ClientOption{
+		con.WithTimeout(readTimeout),
+		con.WithUserAgent("Redpanda Console"),
+	}
+
+	opts = append(opts, con.WithHost(clusterCfg.URL))
+	// TLS Config
+	tlsCfg, err := clusterCfg.TLS.TLSConfig()
+	if err != nil {
+		childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", slog.Any("error", err))
+		tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	opts = append(opts, con.WithTLSConfig(tlsCfg))
+
+	// Basic Auth
+	if clusterCfg.Username != "" {
+		opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
+	}
+
+	// Bearer Token
+	if clusterCfg.Token != "" {
+		opts = append(opts, con.WithAuthToken(clusterCfg.Token))
+	}
+
+	return &ClientWithConfig{
+		Client: con.NewClient(opts...),
+		Cfg:    clusterCfg,
+		Source: source,
+	}
 }
 
 // NewService creates a new connect.Service. It tests the connectivity for each configured
@@ -43,12 +103,17 @@ type ClientWithConfig struct {
 func NewService(cfg config.KafkaConnect, logger *slog.Logger) (*Service, error) {
 	clientsByCluster := make(map[string]*ClientWithConfig)
 
+	in, err := newInterceptor(cfg.Guides, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(cfg.Clusters) == 0 {
 		return &Service{
 			Cfg:              cfg,
 			Logger:           logger,
 			ClientsByCluster: clientsByCluster,
-			Interceptor:      interceptor.NewInterceptor(),
+			Interceptor:      in,
 		}, nil
 	}
 
@@ -56,48 +121,14 @@ func NewService(cfg config.KafkaConnect, logger *slog.Logger) (*Service, error)
 	logger.Info("creating Kafka connect HTTP clients and testing connectivity to all clusters")
 
 	for _, clusterCfg := range cfg.Clusters {
-		// Create dedicated KafkaConnect HTTP Client for each cluster
-		childLogger := logger.With(
-			slog.String("cluster_name", clusterCfg.Name),
-			slog.String("cluster_address", clusterCfg.URL))
-
-		opts := []con.ClientOption{
-			con.WithTimeout(cfg.ReadTimeout),
-			con.WithUserAgent("Redpanda Console"),
-		}
-
-		opts = append(opts, con.WithHost(clusterCfg.URL))
-		// TLS Config
-		tlsCfg, err := clusterCfg.TLS.TLSConfig()
-		if err != nil {
-			childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", slog.Any("error", err))
-			tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
-		}
-		opts = append(opts, con.WithTLSConfig(tlsCfg))
-
-		// Basic Auth
-		if clusterCfg.Username != "" {
-			opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
-		}
-
-		// Bearer Token
-		if clusterCfg.Token != "" {
-			opts = append(opts, con.WithAuthToken(clusterCfg.Token))
-		}
-
-		// Create client
-		client := con.NewClient(opts...)
-		clientsByCluster[clusterCfg.Name] = &ClientWithConfig{
-			Client: client,
-			Cfg:    clusterCfg,
-		}
+		clientsByCluster[clusterCfg.Name] = newClientWithConfig(clusterCfg, ClusterSourceStatic, cfg.ReadTimeout, logger)
 	}
 
 	svc := &Service{
 		Cfg:              cfg,
 		Logger:           logger,
 		ClientsByCluster: clientsByCluster,
-		Interceptor:      interceptor.NewInterceptor(),
+		Interceptor:      in,
 	}
 
 	// 2. Test connectivity against each cluster concurrently
@@ -115,7 +146,8 @@ func NewService(cfg config.KafkaConnect, logger *slog.Logger) (*Service, error)
 func (s *Service) TestConnectivity(ctx context.Context) {
 	var successfulChecks uint32
 	wg := sync.WaitGroup{}
-	for _, clientInfo := range s.ClientsByCluster {
+	clusters := s.Clusters()
+	for _, clientInfo := range clusters {
 		wg.Go(func() {
 			_, err := clientInfo.Client.GetRoot(ctx)
 			if err != nil {
@@ -131,5 +163,5 @@ func (s *Service) TestConnectivity(ctx context.Context) {
 	wg.Wait()
 	s.Logger.InfoContext(ctx, "tested Kafka connect cluster connectivity",
 		slog.Int("successful_clusters", int(successfulChecks)),
-		slog.Int("failed_clusters", len(s.ClientsByCluster)-int(successfulChecks)))
+		slog.Int("failed_clusters", len(clusters)-int(successfulChecks)))
 }
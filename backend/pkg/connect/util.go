@@ -30,7 +30,9 @@ func getMapValueOrString(m map[string]string, key string, fallback string) strin
 }
 
 func (s *Service) getConnectClusterByName(clusterName string) (*ClientWithConfig, *rest.Error) {
+	s.clustersMutex.RLock()
 	c, exists := s.ClientsByCluster[clusterName]
+	s.clustersMutex.RUnlock()
 	if !exists {
 		return nil, &rest.Error{
 			Err:          errors.New("a client for the given cluster name does not exist"),
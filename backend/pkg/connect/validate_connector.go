@@ -48,6 +48,7 @@ func (s *Service) ValidateConnectorConfig(ctx context.Context, clusterName strin
 		}
 	}
 
+	s.rememberSensitiveConfigKeys(clusterName, pluginClassName, cValidationResult)
 	consoleValidationResponse := s.Interceptor.KafkaConnectValidateToConsole(pluginClassName, cValidationResult, configs)
 
 	return consoleValidationResponse, nil
@@ -0,0 +1,201 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package declarative implements connector guides and config patches that are
+// defined in YAML rather than Go code. This allows to provide a setup guide for
+// connectors that are not known to Console, such as in-house connectors, without
+// forking Console.
+package declarative
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// Definition is a declarative connector guide. It describes how the configurations of
+// a single connector class shall be grouped and presented in the Console frontend.
+type Definition struct {
+	// Source is the name of the file the definition was loaded from. It is used
+	// for error messages only.
+	Source string `yaml:"-"`
+
+	// ClassName is the connector plugin class name that this guide is written for.
+	ClassName string `yaml:"className"`
+
+	// Steps define the setup wizard steps and what config keys go into each step.
+	// Config keys that are not listed in any of the steps will not be shown.
+	Steps []Step `yaml:"steps"`
+
+	// InjectedValues are added to the connector configuration when validating
+	// and submitting a connector.
+	InjectedValues []InjectedValue `yaml:"injectedValues"`
+
+	// Configs are overrides for the config definitions that are returned by the
+	// Kafka connect cluster.
+	Configs []ConfigOverride `yaml:"configs"`
+}
+
+// Step is a wizard step that groups one or more config groups.
+type Step struct {
+	Name        string  `yaml:"name"`
+	Description string  `yaml:"description"`
+	Groups      []Group `yaml:"groups"`
+}
+
+// Group is a group of configurations within a wizard step.
+type Group struct {
+	Name              string   `yaml:"name"`
+	Description       string   `yaml:"description"`
+	DocumentationLink string   `yaml:"documentationLink"`
+	ConfigKeys        []string `yaml:"configKeys"`
+}
+
+// InjectedValue is a config key/value pair that is injected into the connector config.
+// If Authoritative is true, the value overwrites user provided values for the same key.
+type InjectedValue struct {
+	Name          string `yaml:"name"`
+	Value         string `yaml:"value"`
+	Authoritative bool   `yaml:"authoritative"`
+}
+
+// ConfigOverride modifies the config definitions that match either the Name or the
+// NamePattern. Unset properties are not modified.
+type ConfigOverride struct {
+	Name        string `yaml:"name"`
+	NamePattern string `yaml:"namePattern"`
+
+	DisplayName       string             `yaml:"displayName"`
+	Documentation     string             `yaml:"documentation"`
+	Importance        string             `yaml:"importance"`
+	DefaultValue      *string            `yaml:"defaultValue"`
+	Required          *bool              `yaml:"required"`
+	Visible           *bool              `yaml:"visible"`
+	ComponentType     string             `yaml:"componentType"`
+	RecommendedValues []RecommendedValue `yaml:"recommendedValues"`
+
+	// VisibleWhen hides the config unless another config is set to one of the given values.
+	VisibleWhen *VisibleWhen `yaml:"visibleWhen"`
+	// Validation reports a validation error if the config value does not match the pattern.
+	Validation *Validation `yaml:"validation"`
+
+	nameRegex       *regexp.Regexp
+	validationRegex *regexp.Regexp
+}
+
+// RecommendedValue is a value that is offered to the user, e.g. as part of a radio group.
+type RecommendedValue struct {
+	Value       string `yaml:"value"`
+	DisplayName string `yaml:"displayName"`
+}
+
+// VisibleWhen describes the condition for showing a config.
+type VisibleWhen struct {
+	Config string   `yaml:"config"`
+	Values []string `yaml:"values"`
+}
+
+// Validation is a regex that the config value must match. Message is shown to the
+// user if the value does not match.
+type Validation struct {
+	Pattern string `yaml:"pattern"`
+	Message string `yaml:"message"`
+}
+
+// Parse parses and validates a declarative connector guide. The source is the name
+// of the file that is parsed and is used to provide context in error messages.
+func Parse(source string, content []byte) (*Definition, error) {
+	var def Definition
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+	if err := dec.Decode(&def); err != nil {
+		return nil, fmt.Errorf("failed to parse connector guide %q: %w", source, err)
+	}
+	def.Source = source
+
+	if err := def.validate(); err != nil {
+		return nil, fmt.Errorf("invalid connector guide %q: %w", source, err)
+	}
+
+	return &def, nil
+}
+
+// validate checks the definition for errors and compiles all regexes.
+func (d *Definition) validate() error {
+	if d.ClassName == "" {
+		return errors.New("className must be set")
+	}
+	if len(d.Steps) == 0 {
+		return errors.New("at least one step must be defined")
+	}
+	for i, step := range d.Steps {
+		if len(step.Groups) == 0 {
+			return fmt.Errorf("step %d (%q) must have at least one group", i, step.Name)
+		}
+	}
+	for i, val := range d.InjectedValues {
+		if val.Name == "" {
+			return fmt.Errorf("injected value %d must have a name", i)
+		}
+	}
+
+	for i := range d.Configs {
+		if err := d.Configs[i].compile(); err != nil {
+			return fmt.Errorf("config %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *ConfigOverride) compile() error {
+	if (c.Name == "") == (c.NamePattern == "") {
+		return errors.New("exactly one of name or namePattern must be set")
+	}
+	if c.NamePattern != "" {
+		regex, err := regexp.Compile("^(?:" + c.NamePattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid namePattern: %w", err)
+		}
+		c.nameRegex = regex
+	}
+
+	switch c.Importance {
+	case "", model.ConfigDefinitionImportanceHigh, model.ConfigDefinitionImportanceMedium, model.ConfigDefinitionImportanceLow:
+	default:
+		return fmt.Errorf("invalid importance %q, must be one of HIGH, MEDIUM or LOW", c.Importance)
+	}
+
+	if c.VisibleWhen != nil && c.VisibleWhen.Config == "" {
+		return errors.New("visibleWhen.config must be set")
+	}
+
+	if c.Validation != nil {
+		regex, err := regexp.Compile(c.Validation.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid validation pattern: %w", err)
+		}
+		c.validationRegex = regex
+	}
+
+	return nil
+}
+
+// isMatch returns true if the override applies to the given config key.
+func (c *ConfigOverride) isMatch(configKey string) bool {
+	if c.nameRegex != nil {
+		return c.nameRegex.MatchString(configKey)
+	}
+	return c.Name == configKey
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+const testDefinition = `
+className: com.example.WarehouseSinkConnector
+steps:
+  - name: Connection
+    groups:
+      - name: Warehouse
+        configKeys: [warehouse.url, warehouse.auth.mode, warehouse.token]
+injectedValues:
+  - name: key.converter
+    value: org.apache.kafka.connect.storage.StringConverter
+  - name: errors.tolerance
+    value: all
+    authoritative: true
+configs:
+  - name: warehouse.url
+    displayName: Warehouse URL
+    importance: HIGH
+    required: true
+    validation:
+      pattern: "^https://"
+      message: URL must use https
+  - name: warehouse.auth.mode
+    componentType: RADIO_GROUP
+    defaultValue: NONE
+    recommendedValues:
+      - value: NONE
+        displayName: None
+      - value: TOKEN
+        displayName: Token
+  - namePattern: warehouse\.token
+    visibleWhen:
+      config: warehouse.auth.mode
+      values: [TOKEN]
+`
+
+func TestParse(t *testing.T) {
+	def, err := Parse("warehouse.yaml", []byte(testDefinition))
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.WarehouseSinkConnector", def.ClassName)
+	assert.Len(t, def.Configs, 3)
+
+	tests := []struct {
+		name    string
+		content string
+		errMsg  string
+	}{
+		{
+			name:    "missing class name",
+			content: "steps: [{groups: [{configKeys: [a]}]}]",
+			errMsg:  "className must be set",
+		},
+		{
+			name:    "missing steps",
+			content: "className: a",
+			errMsg:  "at least one step must be defined",
+		},
+		{
+			name:    "unknown field",
+			content: "className: a\nunknown: true",
+			errMsg:  "field unknown not found",
+		},
+		{
+			name:    "invalid importance",
+			content: "className: a\nsteps: [{groups: [{configKeys: [a]}]}]\nconfigs: [{name: a, importance: CRITICAL}]",
+			errMsg:  "invalid importance",
+		},
+		{
+			name:    "name and pattern",
+			content: "className: a\nsteps: [{groups: [{configKeys: [a]}]}]\nconfigs: [{name: a, namePattern: a.*}]",
+			errMsg:  "exactly one of name or namePattern must be set",
+		},
+		{
+			name:    "invalid validation pattern",
+			content: "className: a\nsteps: [{groups: [{configKeys: [a]}]}]\nconfigs: [{name: a, validation: {pattern: '('}}]",
+			errMsg:  "invalid validation pattern",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("test.yaml", []byte(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestDefinitionPatch(t *testing.T) {
+	def, err := Parse("warehouse.yaml", []byte(testDefinition))
+	require.NoError(t, err)
+	p := def.Patch()
+
+	assert.True(t, p.IsMatch("warehouse.url", def.ClassName))
+	assert.True(t, p.IsMatch("warehouse.token", def.ClassName))
+	assert.False(t, p.IsMatch("warehouse.url", "com.example.OtherConnector"))
+	assert.False(t, p.IsMatch("topics", def.ClassName))
+
+	d := model.ConfigDefinition{Definition: model.ConfigDefinitionKey{Name: "warehouse.url", Importance: model.ConfigDefinitionImportanceLow}}
+	d = p.PatchDefinition(d, def.ClassName)
+	assert.Equal(t, "Warehouse URL", d.Definition.DisplayName)
+	assert.Equal(t, model.ConfigDefinitionImportanceHigh, d.Definition.Importance)
+	assert.True(t, d.Definition.Required)
+
+	d = model.ConfigDefinition{Definition: model.ConfigDefinitionKey{Name: "warehouse.auth.mode"}}
+	d = p.PatchDefinition(d, def.ClassName)
+	assert.Equal(t, model.ComponentRadioGroup, d.Metadata.ComponentType)
+	require.NotNil(t, d.Definition.CustomDefaultValue)
+	assert.Equal(t, "NONE", *d.Definition.CustomDefaultValue)
+	assert.Len(t, d.Metadata.RecommendedValues, 2)
+}
+
+func TestDefinitionGuide(t *testing.T) {
+	def, err := Parse("warehouse.yaml", []byte(testDefinition))
+	require.NoError(t, err)
+	g := def.Guide()
+	assert.Equal(t, def.ClassName, g.ClassName())
+
+	configs := g.ConsoleToKafkaConnect(map[string]any{
+		"key.converter":    "io.confluent.connect.avro.AvroConverter",
+		"errors.tolerance": "none",
+	})
+	assert.Equal(t, "io.confluent.connect.avro.AvroConverter", configs["key.converter"], "non-authoritative values must not overwrite user input")
+	assert.Equal(t, "all", configs["errors.tolerance"], "authoritative values must overwrite user input")
+
+	patchedConfigs := []model.ConfigDefinition{
+		{Definition: model.ConfigDefinitionKey{Name: "warehouse.url"}, Value: model.ConfigDefinitionValue{Name: "warehouse.url", Value: "http://warehouse", Visible: true}},
+		{Definition: model.ConfigDefinitionKey{Name: "warehouse.auth.mode"}, Value: model.ConfigDefinitionValue{Name: "warehouse.auth.mode", Value: "NONE", Visible: true}},
+		{Definition: model.ConfigDefinitionKey{Name: "warehouse.token"}, Value: model.ConfigDefinitionValue{Name: "warehouse.token", Visible: true}},
+		{Definition: model.ConfigDefinitionKey{Name: "not.in.steps"}, Value: model.ConfigDefinitionValue{Name: "not.in.steps", Visible: true}},
+	}
+	res := g.KafkaConnectValidateToConsole(def.ClassName, patchedConfigs, map[string]any{"warehouse.auth.mode": "NONE"})
+	require.Len(t, res.Configs, 3)
+	assert.Equal(t, []string{"URL must use https"}, res.Configs[0].Value.Errors)
+	assert.False(t, res.Configs[2].Value.Visible)
+
+	res = g.KafkaConnectValidateToConsole(def.ClassName, patchedConfigs, map[string]any{"warehouse.auth.mode": "TOKEN"})
+	assert.True(t, res.Configs[2].Value.Visible)
+}
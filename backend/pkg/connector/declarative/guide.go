@@ -0,0 +1,104 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/guide"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// Guide returns the connector guide for the definition. Additional options can be
+// passed to add hooks, e.g. the ones that are used by the community guides.
+func (d *Definition) Guide(opts ...guide.Option) guide.Guide {
+	steps := make([]model.ValidationResponseStep, len(d.Steps))
+	for i, step := range d.Steps {
+		groups := make([]model.ValidationResponseStepGroup, len(step.Groups))
+		for j, group := range step.Groups {
+			groups[j] = model.ValidationResponseStepGroup{
+				Name:              group.Name,
+				Description:       group.Description,
+				DocumentationLink: group.DocumentationLink,
+				ConfigKeys:        group.ConfigKeys,
+			}
+		}
+		steps[i] = model.ValidationResponseStep{
+			Name:        step.Name,
+			Description: step.Description,
+			Groups:      groups,
+		}
+	}
+
+	authoritative := make(map[string]string)
+	nonAuthoritative := make(map[string]string)
+	for _, val := range d.InjectedValues {
+		if val.Authoritative {
+			authoritative[val.Name] = val.Value
+		} else {
+			nonAuthoritative[val.Name] = val.Value
+		}
+	}
+
+	guideOpts := []guide.Option{
+		guide.WithInjectedValues(nonAuthoritative, false),
+		guide.WithInjectedValues(authoritative, true),
+		guide.WithKafkaConnectValidateToConsoleHookFn(d.kafkaConnectValidateToConsoleHook),
+	}
+	guideOpts = append(guideOpts, opts...)
+
+	return guide.NewWizardGuide(d.ClassName, steps, guideOpts...)
+}
+
+// kafkaConnectValidateToConsoleHook applies the rules that depend on the user's input,
+// that is conditional visibility and validation regexes.
+func (d *Definition) kafkaConnectValidateToConsoleHook(response model.ValidationResponse, config map[string]any) model.ValidationResponse {
+	for i := range response.Configs {
+		configDef := &response.Configs[i]
+		for j := range d.Configs {
+			override := &d.Configs[j]
+			if !override.isMatch(configDef.Definition.Name) {
+				continue
+			}
+
+			if override.VisibleWhen != nil {
+				value := configValue(config[override.VisibleWhen.Config])
+				configDef.SetVisible(slices.Contains(override.VisibleWhen.Values, value))
+			}
+
+			// Only validate user provided values, required configs are validated by Kafka connect
+			if override.validationRegex != nil {
+				value := configValue(configDef.Value.Value)
+				if value != "" && !override.validationRegex.MatchString(value) {
+					msg := override.Validation.Message
+					if msg == "" {
+						msg = fmt.Sprintf("Value must match the pattern %q", override.Validation.Pattern)
+					}
+					configDef.AddValueErrors(msg)
+				}
+			}
+		}
+	}
+
+	return response
+}
+
+// configValue returns the string representation of a config value.
+func configValue(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
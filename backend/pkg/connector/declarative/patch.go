@@ -0,0 +1,81 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+	"github.com/redpanda-data/console/backend/pkg/connector/patch"
+)
+
+// ConfigPatch applies the config overrides of a declarative definition.
+type ConfigPatch struct {
+	def *Definition
+}
+
+var _ patch.ConfigPatch = (*ConfigPatch)(nil)
+
+// Patch returns the config patch for the definition's config overrides.
+func (d *Definition) Patch() *ConfigPatch {
+	return &ConfigPatch{def: d}
+}
+
+// IsMatch implements the patch.ConfigPatch interface.
+func (c *ConfigPatch) IsMatch(configKey, connectorClass string) bool {
+	if connectorClass != c.def.ClassName {
+		return false
+	}
+	for i := range c.def.Configs {
+		if c.def.Configs[i].isMatch(configKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// PatchDefinition implements the patch.ConfigPatch interface. Overrides are applied
+// in the order they are defined, so that later overrides take precedence.
+func (c *ConfigPatch) PatchDefinition(d model.ConfigDefinition, _ string) model.ConfigDefinition {
+	for i := range c.def.Configs {
+		override := &c.def.Configs[i]
+		if !override.isMatch(d.Definition.Name) {
+			continue
+		}
+
+		if override.DisplayName != "" {
+			d.SetDisplayName(override.DisplayName)
+		}
+		if override.Documentation != "" {
+			d.SetDocumentation(override.Documentation)
+		}
+		if override.Importance != "" {
+			d.SetImportance(override.Importance)
+		}
+		if override.DefaultValue != nil {
+			d.SetDefaultValue(*override.DefaultValue)
+		}
+		if override.Required != nil {
+			d.SetRequired(*override.Required)
+		}
+		if override.Visible != nil {
+			d.SetVisible(*override.Visible)
+		}
+		if override.ComponentType != "" {
+			d.SetComponentType(override.ComponentType)
+		}
+		if len(override.RecommendedValues) > 0 {
+			d.ClearRecommendedValuesWithMetadata()
+			for _, val := range override.RecommendedValues {
+				d.AddRecommendedValueWithMetadata(val.Value, val.DisplayName)
+			}
+		}
+	}
+
+	return d
+}
@@ -189,3 +189,23 @@ func (in *Interceptor) applyConfigPatches(pluginClassName string, configDefiniti
 
 	return configDefinition
 }
+
+// HasGuide returns true if there is a connector specific guide for the given plugin
+// class, and false if the default guide is used.
+func (in *Interceptor) HasGuide(pluginClassName string) bool {
+	_, exists := in.guidesByClassName[pluginClassName]
+	return exists
+}
+
+// SensitiveConfigKeys returns the names of all config properties in the connector's
+// validate response whose type is PASSWORD after all config patches have been applied.
+func (in *Interceptor) SensitiveConfigKeys(pluginClassName string, response connect.ConnectorValidationResult) []string {
+	var keys []string
+	for _, config := range response.Configs {
+		configDef := in.applyConfigPatches(pluginClassName, model.NewConfigDefinitionFromValidationResult(config))
+		if configDef.Definition.Type == model.ConfigDefinitionTypePassword {
+			keys = append(keys, configDef.Definition.Name)
+		}
+	}
+	return keys
+}
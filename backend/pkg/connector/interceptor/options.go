@@ -10,6 +10,7 @@
 package interceptor
 
 import (
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
 	"github.com/redpanda-data/console/backend/pkg/connector/guide"
 	"github.com/redpanda-data/console/backend/pkg/connector/patch"
 )
@@ -32,3 +33,14 @@ func WithAdditionalGuides(guides ...guide.Guide) Option {
 		in.guides = append(in.guides, guides...)
 	}
 }
+
+// WithDeclarativeDefinitions adds the guides and config patches of declarative connector
+// guides. Declarative guides replace the community guides for the same connector class.
+func WithDeclarativeDefinitions(defs ...*declarative.Definition) Option {
+	return func(in *Interceptor) {
+		for _, def := range defs {
+			in.guides = append(in.guides, def.Guide())
+			in.configPatches = append(in.configPatches, def.Patch())
+		}
+	}
+}
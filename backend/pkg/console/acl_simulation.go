@@ -0,0 +1,593 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redpanda-data/common-go/rpsr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ACLSource is the system that enforces an ACL.
+type ACLSource string
+
+const (
+	// ACLSourceKafka denotes ACLs that are enforced by the Kafka API.
+	ACLSourceKafka ACLSource = "KAFKA"
+	// ACLSourceSchemaRegistry denotes ACLs that are enforced by the Schema Registry.
+	ACLSourceSchemaRegistry ACLSource = "SCHEMA_REGISTRY"
+)
+
+const (
+	aclWildcardResource  = "*"
+	aclWildcardPrincipal = "User:*"
+	aclWildcardHost      = "*"
+
+	aclPrincipalPrefixRole = "RedpandaRole:"
+
+	aclPatternLiteral  = "LITERAL"
+	aclPatternPrefixed = "PREFIXED"
+
+	aclOperationAll    = "ALL"
+	aclPermissionAllow = "ALLOW"
+	aclPermissionDeny  = "DENY"
+
+	// kafkaClusterResourceName is the only valid resource name for Kafka's cluster resource.
+	kafkaClusterResourceName = "kafka-cluster"
+)
+
+// ACLBinding is a single Kafka or Schema Registry ACL in a source independent
+// representation. All enum values use the names of Kafka's ACL enums, for example
+// TOPIC, PREFIXED, DESCRIBE_CONFIGS or DENY.
+type ACLBinding struct {
+	Source       ACLSource `json:"source" yaml:"source"`
+	ResourceType string    `json:"resourceType" yaml:"resourceType"`
+	ResourceName string    `json:"resourceName" yaml:"resourceName"`
+	PatternType  string    `json:"patternType" yaml:"patternType"`
+	Principal    string    `json:"principal" yaml:"principal"`
+	Host         string    `json:"host" yaml:"host"`
+	Operation    string    `json:"operation" yaml:"operation"`
+	Permission   string    `json:"permission" yaml:"permission"`
+}
+
+// operationsByResourceType are the operations that are evaluated for each resource type.
+var operationsByResourceType = map[ACLSource]map[string][]string{
+	ACLSourceKafka: {
+		kmsg.ACLResourceTypeTopic.String():           {"READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER_CONFIGS"},
+		kmsg.ACLResourceTypeGroup.String():           {"READ", "DELETE", "DESCRIBE"},
+		kmsg.ACLResourceTypeCluster.String():         {"CREATE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"},
+		kmsg.ACLResourceTypeTransactionalId.String(): {"WRITE", "DESCRIBE"},
+		kmsg.ACLResourceTypeDelegationToken.String(): {"DESCRIBE"},
+		kmsg.ACLResourceTypeUser.String():            {"CREATE_TOKENS", "DESCRIBE_TOKENS"},
+	},
+	ACLSourceSchemaRegistry: {
+		string(rpsr.ResourceTypeRegistry): {"READ", "WRITE", "DELETE", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER", "ALTER_CONFIGS"},
+		string(rpsr.ResourceTypeSubject):  {"READ", "WRITE", "DELETE", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER", "ALTER_CONFIGS"},
+	},
+}
+
+// impliedOperations lists the operations that are implicitly allowed if any of the
+// mapped operations is allowed. This mirrors Kafka's authorizer.
+var impliedOperations = map[string][]string{
+	"DESCRIBE":         {"READ", "WRITE", "DELETE", "ALTER"},
+	"DESCRIBE_CONFIGS": {"ALTER_CONFIGS"},
+}
+
+// ACLSimulationRequest describes the principal whose effective permissions shall be
+// evaluated and an optional ACL change that shall be simulated.
+type ACLSimulationRequest struct {
+	// Principal including its type, e.g. "User:alice".
+	Principal string `json:"principal"`
+	// Host the principal connects from. If empty, only ACLs for all hosts (*) are
+	// evaluated and host specific ACLs are listed as conditional ACLs.
+	Host string `json:"host"`
+	// Roles the principal is a member of. These are merged with the roles that are
+	// resolved via the Redpanda Admin API if ResolveRoles is set.
+	Roles []string `json:"roles"`
+	// ResolveRoles looks up the principal's role memberships in Redpanda.
+	ResolveRoles bool `json:"resolveRoles"`
+	// AddACLs are ACLs that shall be simulated as if they were created.
+	AddACLs []ACLBinding `json:"addAcls"`
+	// RemoveACLs are ACLs that shall be simulated as if they were deleted.
+	RemoveACLs []ACLBinding `json:"removeAcls"`
+}
+
+// ACLSimulationResponse contains the effective permissions of a principal and,
+// if an ACL change was proposed, the permission changes it would cause.
+type ACLSimulationResponse struct {
+	Principal           string                `json:"principal"`
+	Host                string                `json:"host"`
+	Roles               []string              `json:"roles"`
+	EffectivePrincipals []string              `json:"effectivePrincipals"`
+	Permissions         []ResourcePermissions `json:"permissions"`
+
+	// Changes is only set if ACL additions or removals have been proposed.
+	Changes []ResourcePermissionChange `json:"changes,omitempty"`
+	// PermissionsAfterChange is only set if ACL additions or removals have been proposed.
+	PermissionsAfterChange []ResourcePermissions `json:"permissionsAfterChange,omitempty"`
+
+	Warnings []string `json:"warnings"`
+}
+
+// ResourcePermissions are the effective permissions of a principal on a single resource.
+// If PatternType is PREFIXED, the permissions apply to all resources whose name starts
+// with ResourceName.
+type ResourcePermissions struct {
+	Source       ACLSource    `json:"source"`
+	ResourceType string       `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	PatternType  string       `json:"patternType"`
+	Allowed      []string     `json:"allowed"`
+	Denied       []string     `json:"denied"`
+	MatchedACLs  []ACLBinding `json:"matchedAcls"`
+	// ConditionalACLs are ACLs that only apply if the principal connects from
+	// a specific host. They are only set if no host was requested and are not
+	// considered for Allowed and Denied.
+	ConditionalACLs []ACLBinding `json:"conditionalAcls"`
+}
+
+// ResourcePermissionChange lists the operations a principal would gain or lose on
+// a resource by applying a proposed ACL change.
+type ResourcePermissionChange struct {
+	Source       ACLSource `json:"source"`
+	ResourceType string    `json:"resourceType"`
+	ResourceName string    `json:"resourceName"`
+	PatternType  string    `json:"patternType"`
+	Granted      []string  `json:"granted"`
+	Revoked      []string  `json:"revoked"`
+}
+
+// aclResource is a resource (or resource pattern) whose permissions are evaluated.
+type aclResource struct {
+	Source       ACLSource
+	ResourceType string
+	ResourceName string
+	PatternType  string
+}
+
+// SimulateACLPermissions evaluates every Kafka and Schema Registry resource the given
+// principal can access by applying Kafka's ACL semantics (wildcard principals,
+// literal and prefixed patterns, implied operations and DENY precedence). If ACL
+// additions or removals are passed, the permissions after applying them are
+// evaluated as well, without changing any ACL in the cluster.
+func (s *Service) SimulateACLPermissions(ctx context.Context, req ACLSimulationRequest) (*ACLSimulationResponse, error) {
+	if !strings.Contains(req.Principal, ":") {
+		return nil, fmt.Errorf("principal %q must be prefixed with its type, e.g. User:%v", req.Principal, req.Principal)
+	}
+
+	res := &ACLSimulationResponse{
+		Principal: req.Principal,
+		Host:      req.Host,
+		Warnings:  make([]string, 0),
+	}
+
+	// 1. Resolve roles
+	roles := slices.Clone(req.Roles)
+	if req.ResolveRoles {
+		resolved, err := s.resolvePrincipalRoles(ctx, req.Principal)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to resolve role memberships", slog.String("principal", req.Principal), slog.Any("error", err))
+			res.Warnings = append(res.Warnings, fmt.Sprintf("Failed to resolve role memberships: %v", err.Error()))
+		}
+		roles = append(roles, resolved...)
+	}
+	slices.Sort(roles)
+	roles = slices.Compact(roles)
+	res.Roles = roles
+	res.EffectivePrincipals = effectivePrincipals(req.Principal, roles)
+
+	// 2. Collect all current ACLs and resources
+	bindings, resources, warnings := s.collectACLBindingsAndResources(ctx)
+	res.Warnings = append(res.Warnings, warnings...)
+
+	// 3. Evaluate permissions
+	res.Permissions = evaluatePermissions(bindings, resources, res.EffectivePrincipals, req.Host)
+	if req.Host == "" && hasConditionalACLs(res.Permissions) {
+		res.Warnings = append(res.Warnings, "No host has been specified, host specific ACLs are listed as conditional ACLs but are not evaluated")
+	}
+
+	// 4. Simulate proposed changes
+	if len(req.AddACLs) > 0 || len(req.RemoveACLs) > 0 {
+		proposed := applyACLChanges(bindings, req.AddACLs, req.RemoveACLs)
+		resources = mergeACLResources(resources, aclPatternResources(req.AddACLs))
+		res.PermissionsAfterChange = evaluatePermissions(proposed, resources, res.EffectivePrincipals, req.Host)
+		res.Changes = diffPermissions(evaluatePermissions(bindings, resources, res.EffectivePrincipals, req.Host), res.PermissionsAfterChange)
+	}
+
+	return res, nil
+}
+
+// resolvePrincipalRoles returns the names of all Redpanda roles the principal is a member of.
+func (s *Service) resolvePrincipalRoles(ctx context.Context, principal string) ([]string, error) {
+	principalType, principalName, _ := strings.Cut(principal, ":")
+	adminCl, err := s.redpandaClientFactory.GetRedpandaAPIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rolesRes, err := adminCl.Roles(ctx, "", principalName, principalType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	roles := make([]string, len(rolesRes.Roles))
+	for i, role := range rolesRes.Roles {
+		roles[i] = role.Name
+	}
+	return roles, nil
+}
+
+// collectACLBindingsAndResources lists all Kafka and Schema Registry ACLs along with all
+// existing resources (topics, groups, subjects) they may apply to. Failures of individual
+// sources are returned as warnings, so that the remaining sources can still be evaluated.
+func (s *Service) collectACLBindingsAndResources(ctx context.Context) ([]ACLBinding, []aclResource, []string) {
+	var bindings []ACLBinding
+	var warnings []string
+
+	// Kafka ACLs
+	aclReq := kmsg.NewDescribeACLsRequest()
+	aclReq.ResourceType = kmsg.ACLResourceTypeAny
+	aclReq.ResourcePatternType = kmsg.ACLResourcePatternTypeAny
+	aclReq.Operation = kmsg.ACLOperationAny
+	aclReq.PermissionType = kmsg.ACLPermissionTypeAny
+	aclOverview, err := s.ListAllACLs(ctx, aclReq)
+	switch {
+	case err != nil:
+		warnings = append(warnings, fmt.Sprintf("Failed to list Kafka ACLs: %v", err.Error()))
+	case !aclOverview.IsAuthorizerEnabled:
+		warnings = append(warnings, "No authorizer is enabled for the Kafka API, hence all Kafka operations are allowed")
+	default:
+		bindings = append(bindings, KafkaACLBindings(aclOverview.ACLResources)...)
+	}
+
+	// Schema Registry ACLs
+	if s.cfg.SchemaRegistry.Enabled && s.CheckSchemaRegistryACLSupport(ctx) {
+		srACLs, err := s.ListSRACLs(ctx, []rpsr.ACL{{
+			ResourceType: rpsr.ResourceTypeAny,
+			PatternType:  rpsr.PatternTypeAny,
+			Operation:    rpsr.OperationAny,
+			Permission:   rpsr.PermissionAny,
+		}})
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Failed to list Schema Registry ACLs: %v", err.Error()))
+		} else {
+			bindings = append(bindings, SchemaRegistryACLBindings(srACLs)...)
+		}
+	}
+
+	// Existing resources
+	resources := []aclResource{{
+		Source:       ACLSourceKafka,
+		ResourceType: kmsg.ACLResourceTypeCluster.String(),
+		ResourceName: kafkaClusterResourceName,
+		PatternType:  aclPatternLiteral,
+	}}
+	topicNames, err := s.GetAllTopicNames(ctx)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Failed to list topics: %v", err.Error()))
+	}
+	for _, topic := range topicNames {
+		resources = append(resources, aclResource{ACLSourceKafka, kmsg.ACLResourceTypeTopic.String(), topic, aclPatternLiteral})
+	}
+	if _, adminCl, err := s.kafkaClientFactory.GetKafkaClient(ctx); err == nil {
+		groups, err := adminCl.ListGroups(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Failed to list consumer groups: %v", err.Error()))
+		}
+		for _, group := range groups.Groups() {
+			resources = append(resources, aclResource{ACLSourceKafka, kmsg.ACLResourceTypeGroup.String(), group, aclPatternLiteral})
+		}
+	}
+	if s.cfg.SchemaRegistry.Enabled {
+		resources = append(resources, aclResource{ACLSourceSchemaRegistry, string(rpsr.ResourceTypeRegistry), "", aclPatternLiteral})
+		subjects, err := s.GetSchemaRegistrySubjects(ctx, "")
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Failed to list Schema Registry subjects: %v", err.Error()))
+		}
+		for _, subject := range subjects {
+			if subject.IsSoftDeleted {
+				continue
+			}
+			resources = append(resources, aclResource{ACLSourceSchemaRegistry, string(rpsr.ResourceTypeSubject), subject.Name, aclPatternLiteral})
+		}
+	}
+
+	// Resource patterns from ACLs are evaluated too, so that permissions on resources
+	// that do not exist yet (e.g. prefixed topics or transactional IDs) are visible.
+	resources = mergeACLResources(resources, aclPatternResources(bindings))
+
+	return bindings, resources, warnings
+}
+
+// KafkaACLBindings flattens the described Kafka ACL resources into ACL bindings.
+func KafkaACLBindings(resources []*ACLResource) []ACLBinding {
+	bindings := make([]ACLBinding, 0, len(resources))
+	for _, resource := range resources {
+		for _, acl := range resource.ACLs {
+			bindings = append(bindings, ACLBinding{
+				Source:       ACLSourceKafka,
+				ResourceType: resource.ResourceType,
+				ResourceName: resource.ResourceName,
+				PatternType:  resource.ResourcePatternType,
+				Principal:    acl.Principal,
+				Host:         acl.Host,
+				Operation:    acl.Operation,
+				Permission:   acl.PermissionType,
+			})
+		}
+	}
+	return bindings
+}
+
+// SchemaRegistryACLBindings converts Schema Registry ACLs into ACL bindings.
+func SchemaRegistryACLBindings(acls []rpsr.ACL) []ACLBinding {
+	bindings := make([]ACLBinding, len(acls))
+	for i, acl := range acls {
+		bindings[i] = ACLBinding{
+			Source:       ACLSourceSchemaRegistry,
+			ResourceType: string(acl.ResourceType),
+			ResourceName: acl.Resource,
+			PatternType:  string(acl.PatternType),
+			Principal:    acl.Principal,
+			Host:         acl.Host,
+			Operation:    string(acl.Operation),
+			Permission:   string(acl.Permission),
+		}
+	}
+	return bindings
+}
+
+// effectivePrincipals returns the principal along with the role principals of all
+// roles it is a member of.
+func effectivePrincipals(principal string, roles []string) []string {
+	principals := []string{principal}
+	for _, role := range roles {
+		principals = append(principals, aclPrincipalPrefixRole+role)
+	}
+	return principals
+}
+
+// aclPatternResources returns a resource for each resource pattern of the given ACLs.
+func aclPatternResources(bindings []ACLBinding) []aclResource {
+	resources := make([]aclResource, 0)
+	for _, b := range bindings {
+		if b.PatternType == aclPatternLiteral && b.ResourceName == aclWildcardResource {
+			continue
+		}
+		resources = append(resources, aclResource{b.Source, b.ResourceType, b.ResourceName, b.PatternType})
+	}
+	return resources
+}
+
+// mergeACLResources appends all resources from b to a that do not exist in a yet.
+func mergeACLResources(a, b []aclResource) []aclResource {
+	seen := make(map[aclResource]struct{}, len(a))
+	merged := make([]aclResource, 0, len(a)+len(b))
+	for _, r := range append(slices.Clone(a), b...) {
+		if _, exists := seen[r]; exists {
+			continue
+		}
+		seen[r] = struct{}{}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// applyACLChanges returns a copy of bindings with all removals dropped and all additions appended.
+func applyACLChanges(bindings, add, remove []ACLBinding) []ACLBinding {
+	res := make([]ACLBinding, 0, len(bindings)+len(add))
+	for _, b := range bindings {
+		if !slices.Contains(remove, b) {
+			res = append(res, b)
+		}
+	}
+	for _, b := range add {
+		if !slices.Contains(res, b) {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+// evaluatePermissions returns the permissions of the principals on all resources on which
+// at least one operation is allowed or explicitly denied.
+func evaluatePermissions(bindings []ACLBinding, resources []aclResource, principals []string, host string) []ResourcePermissions {
+	permissions := make([]ResourcePermissions, 0)
+	for _, resource := range resources {
+		operations := operationsByResourceType[resource.Source][resource.ResourceType]
+
+		// Collect all ACLs that apply for the principal on this resource
+		matched := make([]ACLBinding, 0)
+		conditional := make([]ACLBinding, 0)
+		for _, b := range bindings {
+			if b.Source != resource.Source || b.ResourceType != resource.ResourceType ||
+				!aclPrincipalMatches(b.Principal, principals) || !aclResourceMatches(b, resource) {
+				continue
+			}
+			switch {
+			case aclHostMatches(b.Host, host):
+				matched = append(matched, b)
+			case host == "":
+				conditional = append(conditional, b)
+			}
+		}
+		if len(matched) == 0 && len(conditional) == 0 {
+			continue
+		}
+
+		perm := ResourcePermissions{
+			Source:       resource.Source,
+			ResourceType: resource.ResourceType,
+			ResourceName: resource.ResourceName,
+			PatternType:  resource.PatternType,
+			Allowed:      make([]string, 0),
+			Denied:       make([]string, 0),
+			MatchedACLs:  matched,
+
+			ConditionalACLs: conditional,
+		}
+		for _, op := range operations {
+			switch authorizeOperation(matched, op) {
+			case aclPermissionAllow:
+				perm.Allowed = append(perm.Allowed, op)
+			case aclPermissionDeny:
+				perm.Denied = append(perm.Denied, op)
+			}
+		}
+		if len(perm.Allowed) == 0 && len(perm.Denied) == 0 && len(conditional) == 0 {
+			continue
+		}
+		permissions = append(permissions, perm)
+	}
+
+	sort.Slice(permissions, func(i, j int) bool {
+		a, b := permissions[i], permissions[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.ResourceName != b.ResourceName {
+			return a.ResourceName < b.ResourceName
+		}
+		return a.PatternType < b.PatternType
+	})
+
+	return permissions
+}
+
+// authorizeOperation returns DENY if any matching ACL denies the operation, ALLOW if
+// at least one allows it, and an empty string if no ACL matches the operation at all.
+// Implied operations are only considered for ALLOW ACLs, as in Kafka.
+func authorizeOperation(matched []ACLBinding, op string) string {
+	allowed := false
+	for _, b := range matched {
+		switch b.Permission {
+		case aclPermissionDeny:
+			if b.Operation == op || b.Operation == aclOperationAll {
+				return aclPermissionDeny
+			}
+		case aclPermissionAllow:
+			if b.Operation == op || b.Operation == aclOperationAll || slices.Contains(impliedOperations[op], b.Operation) {
+				allowed = true
+			}
+		}
+	}
+	if allowed {
+		return aclPermissionAllow
+	}
+	return ""
+}
+
+func aclPrincipalMatches(aclPrincipal string, principals []string) bool {
+	for _, p := range principals {
+		if aclPrincipal == p {
+			return true
+		}
+		if aclPrincipal == aclWildcardPrincipal && strings.HasPrefix(p, "User:") {
+			return true
+		}
+	}
+	return false
+}
+
+// aclHostMatches returns whether the ACL host matches. If no host was requested,
+// only ACLs for all hosts match. Schema Registry ACLs may omit the host.
+func aclHostMatches(aclHost, host string) bool {
+	return aclHost == "" || aclHost == aclWildcardHost || (host != "" && aclHost == host)
+}
+
+func hasConditionalACLs(permissions []ResourcePermissions) bool {
+	for _, p := range permissions {
+		if len(p.ConditionalACLs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// aclResourceMatches returns whether the ACL applies to the resource. For prefixed
+// resource patterns, only ACLs that cover all resources with the prefix match.
+func aclResourceMatches(b ACLBinding, resource aclResource) bool {
+	if resource.Source == ACLSourceSchemaRegistry && resource.ResourceType == string(rpsr.ResourceTypeRegistry) {
+		return true
+	}
+
+	isLiteralWildcard := b.PatternType == aclPatternLiteral && b.ResourceName == aclWildcardResource
+	if resource.PatternType == aclPatternPrefixed {
+		return isLiteralWildcard || (b.PatternType == aclPatternPrefixed && strings.HasPrefix(resource.ResourceName, b.ResourceName))
+	}
+
+	switch b.PatternType {
+	case aclPatternLiteral:
+		return isLiteralWildcard || b.ResourceName == resource.ResourceName
+	case aclPatternPrefixed:
+		return strings.HasPrefix(resource.ResourceName, b.ResourceName)
+	default:
+		return false
+	}
+}
+
+// diffPermissions returns the operations that are granted or revoked per resource
+// when comparing the permissions before and after a change.
+func diffPermissions(before, after []ResourcePermissions) []ResourcePermissionChange {
+	type key struct {
+		Source       ACLSource
+		ResourceType string
+		ResourceName string
+		PatternType  string
+	}
+	allowedBefore := make(map[key][]string)
+	allowedAfter := make(map[key][]string)
+	keys := make([]key, 0)
+	for _, p := range before {
+		k := key{p.Source, p.ResourceType, p.ResourceName, p.PatternType}
+		allowedBefore[k] = p.Allowed
+		keys = append(keys, k)
+	}
+	for _, p := range after {
+		k := key{p.Source, p.ResourceType, p.ResourceName, p.PatternType}
+		allowedAfter[k] = p.Allowed
+		if _, exists := allowedBefore[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+
+	changes := make([]ResourcePermissionChange, 0)
+	for _, k := range keys {
+		change := ResourcePermissionChange{
+			Source:       k.Source,
+			ResourceType: k.ResourceType,
+			ResourceName: k.ResourceName,
+			PatternType:  k.PatternType,
+			Granted:      make([]string, 0),
+			Revoked:      make([]string, 0),
+		}
+		for _, op := range allowedAfter[k] {
+			if !slices.Contains(allowedBefore[k], op) {
+				change.Granted = append(change.Granted, op)
+			}
+		}
+		for _, op := range allowedBefore[k] {
+			if !slices.Contains(allowedAfter[k], op) {
+				change.Revoked = append(change.Revoked, op)
+			}
+		}
+		if len(change.Granted) > 0 || len(change.Revoked) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
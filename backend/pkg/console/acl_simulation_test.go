@@ -0,0 +1,176 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatePermissions(t *testing.T) {
+	topic := func(name string) aclResource {
+		return aclResource{ACLSourceKafka, "TOPIC", name, aclPatternLiteral}
+	}
+	acl := func(resourceName, patternType, principal, operation, permission string) ACLBinding {
+		return ACLBinding{
+			Source:       ACLSourceKafka,
+			ResourceType: "TOPIC",
+			ResourceName: resourceName,
+			PatternType:  patternType,
+			Principal:    principal,
+			Host:         "*",
+			Operation:    operation,
+			Permission:   permission,
+		}
+	}
+	principals := effectivePrincipals("User:alice", []string{"analysts"})
+
+	tests := []struct {
+		name        string
+		bindings    []ACLBinding
+		resource    aclResource
+		wantAllowed []string
+		wantDenied  []string
+	}{
+		{
+			name:        "literal read implies describe",
+			bindings:    []ACLBinding{acl("orders", aclPatternLiteral, "User:alice", "READ", aclPermissionAllow)},
+			resource:    topic("orders"),
+			wantAllowed: []string{"READ", "DESCRIBE"},
+			wantDenied:  []string{},
+		},
+		{
+			name:        "wildcard principal and prefixed resource",
+			bindings:    []ACLBinding{acl("ord", aclPatternPrefixed, aclWildcardPrincipal, "WRITE", aclPermissionAllow)},
+			resource:    topic("orders"),
+			wantAllowed: []string{"WRITE", "DESCRIBE"},
+			wantDenied:  []string{},
+		},
+		{
+			name:        "role principal",
+			bindings:    []ACLBinding{acl("*", aclPatternLiteral, "RedpandaRole:analysts", "DESCRIBE_CONFIGS", aclPermissionAllow)},
+			resource:    topic("orders"),
+			wantAllowed: []string{"DESCRIBE_CONFIGS"},
+			wantDenied:  []string{},
+		},
+		{
+			name: "deny takes precedence and implies nothing",
+			bindings: []ACLBinding{
+				acl("orders", aclPatternLiteral, "User:alice", aclOperationAll, aclPermissionAllow),
+				acl("ord", aclPatternPrefixed, "User:alice", "WRITE", aclPermissionDeny),
+			},
+			resource:    topic("orders"),
+			wantAllowed: []string{"READ", "CREATE", "DELETE", "ALTER", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER_CONFIGS"},
+			wantDenied:  []string{"WRITE"},
+		},
+		{
+			name:        "literal acl does not cover prefixed pattern",
+			bindings:    []ACLBinding{acl("orders", aclPatternLiteral, "User:alice", "READ", aclPermissionAllow)},
+			resource:    aclResource{ACLSourceKafka, "TOPIC", "ord", aclPatternPrefixed},
+			wantAllowed: nil,
+		},
+		{
+			name:        "acl for other principal",
+			bindings:    []ACLBinding{acl("orders", aclPatternLiteral, "User:bob", "READ", aclPermissionAllow)},
+			resource:    topic("orders"),
+			wantAllowed: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluatePermissions(tt.bindings, []aclResource{tt.resource}, principals, "")
+			if tt.wantAllowed == nil {
+				assert.Empty(t, got)
+				return
+			}
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.wantAllowed, got[0].Allowed)
+			assert.Equal(t, tt.wantDenied, got[0].Denied)
+		})
+	}
+}
+
+func TestEvaluatePermissionsHost(t *testing.T) {
+	read := ACLBinding{
+		Source:       ACLSourceKafka,
+		ResourceType: "TOPIC",
+		ResourceName: "orders",
+		PatternType:  aclPatternLiteral,
+		Principal:    "User:alice",
+		Host:         "*",
+		Operation:    "READ",
+		Permission:   aclPermissionAllow,
+	}
+	denyWrite := read
+	denyWrite.Host = "10.0.0.1"
+	denyWrite.Operation = "WRITE"
+	denyWrite.Permission = aclPermissionDeny
+	allowAlter := read
+	allowAlter.Host = "10.0.0.2"
+	allowAlter.Operation = "ALTER"
+
+	bindings := []ACLBinding{read, denyWrite, allowAlter}
+	resources := []aclResource{{ACLSourceKafka, "TOPIC", "orders", aclPatternLiteral}}
+	principals := effectivePrincipals("User:alice", nil)
+
+	t.Run("without host only wildcard host acls are evaluated", func(t *testing.T) {
+		got := evaluatePermissions(bindings, resources, principals, "")
+		require.Len(t, got, 1)
+		assert.Equal(t, []string{"READ", "DESCRIBE"}, got[0].Allowed)
+		assert.Empty(t, got[0].Denied)
+		assert.Equal(t, []ACLBinding{read}, got[0].MatchedACLs)
+		assert.Equal(t, []ACLBinding{denyWrite, allowAlter}, got[0].ConditionalACLs)
+		assert.True(t, hasConditionalACLs(got))
+	})
+
+	t.Run("with host only acls for that host are evaluated", func(t *testing.T) {
+		got := evaluatePermissions(bindings, resources, principals, "10.0.0.1")
+		require.Len(t, got, 1)
+		assert.Equal(t, []string{"READ", "DESCRIBE"}, got[0].Allowed)
+		assert.Equal(t, []string{"WRITE"}, got[0].Denied)
+		assert.Empty(t, got[0].ConditionalACLs)
+	})
+
+	t.Run("host specific acls only", func(t *testing.T) {
+		got := evaluatePermissions([]ACLBinding{allowAlter}, resources, principals, "")
+		require.Len(t, got, 1)
+		assert.Empty(t, got[0].Allowed)
+		assert.Equal(t, []ACLBinding{allowAlter}, got[0].ConditionalACLs)
+	})
+}
+
+func TestSimulateACLChanges(t *testing.T) {
+	read := ACLBinding{
+		Source:       ACLSourceKafka,
+		ResourceType: "TOPIC",
+		ResourceName: "orders",
+		PatternType:  aclPatternLiteral,
+		Principal:    "User:alice",
+		Host:         "*",
+		Operation:    "READ",
+		Permission:   aclPermissionAllow,
+	}
+	write := read
+	write.Operation = "WRITE"
+
+	resources := []aclResource{{ACLSourceKafka, "TOPIC", "orders", aclPatternLiteral}}
+	principals := effectivePrincipals("User:alice", nil)
+
+	before := evaluatePermissions([]ACLBinding{read}, resources, principals, "")
+	after := evaluatePermissions(applyACLChanges([]ACLBinding{read}, []ACLBinding{write}, []ACLBinding{read}), resources, principals, "")
+
+	changes := diffPermissions(before, after)
+	require.Len(t, changes, 1)
+	assert.Equal(t, []string{"WRITE"}, changes[0].Granted)
+	assert.Equal(t, []string{"READ"}, changes[0].Revoked)
+}
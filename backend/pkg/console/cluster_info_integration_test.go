@@ -45,7 +45,7 @@ func (s *ConsoleIntegrationTestSuite) TestGetClusterInfo() {
 	cfg.Kafka.Brokers = []string{testSeedBroker}
 
 	kafkaProvider := kafkafactory.NewCachedClientProvider(&cfg, log, prometheus.NewRegistry())
-	svc, err := NewService(&cfg, log, kafkaProvider, nil, nil, nil, nil)
+	svc, err := NewService(&cfg, log, kafkaProvider, nil, nil, nil, nil, nil)
 	require.NoError(err)
 	defer svc.Stop()
 
@@ -0,0 +1,419 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+const (
+	connectorDLQTopicConfig          = "errors.deadletterqueue.topic.name"
+	connectorDLQContextHeadersConfig = "errors.deadletterqueue.context.headers.enable"
+
+	// connectErrorsHeaderPrefix is the prefix of all headers that Kafka connect adds to
+	// records in the dead letter queue, if context headers are enabled.
+	connectErrorsHeaderPrefix         = "__connect.errors."
+	connectErrorsHeaderTopic          = connectErrorsHeaderPrefix + "topic"
+	connectErrorsHeaderPartition      = connectErrorsHeaderPrefix + "partition"
+	connectErrorsHeaderOffset         = connectErrorsHeaderPrefix + "offset"
+	connectErrorsHeaderConnectorName  = connectErrorsHeaderPrefix + "connector.name"
+	connectErrorsHeaderTaskID         = connectErrorsHeaderPrefix + "task.id"
+	connectErrorsHeaderStage          = connectErrorsHeaderPrefix + "stage"
+	connectErrorsHeaderClassName      = connectErrorsHeaderPrefix + "class.name"
+	connectErrorsHeaderExceptionClass = connectErrorsHeaderPrefix + "exception.class.name"
+	connectErrorsHeaderExceptionMsg   = connectErrorsHeaderPrefix + "exception.message"
+	connectErrorsHeaderStacktrace     = connectErrorsHeaderPrefix + "exception.stacktrace"
+
+	defaultDLQMessageCount = 100
+	maxDLQMessageCount     = 1000
+	maxDLQReplayRecords    = 100
+)
+
+// ConnectorDLQ is the content of a sink connector's dead letter queue.
+type ConnectorDLQ struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+	Topic         string `json:"topic"`
+	// ContextHeadersEnabled is false if the connector does not add the error context
+	// headers to the records. The records can't be replayed in this case.
+	ContextHeadersEnabled bool                 `json:"contextHeadersEnabled"`
+	Records               []ConnectorDLQRecord `json:"records"`
+	// ErrorGroups are the records grouped by the exception class, most frequent first.
+	ErrorGroups []ConnectorDLQErrorGroup `json:"errorGroups"`
+}
+
+// ConnectorDLQRecord is a record in the dead letter queue along with the error
+// that has been decoded from the record's headers.
+type ConnectorDLQRecord struct {
+	PartitionID int32                `json:"partitionId"`
+	Offset      int64                `json:"offset"`
+	Timestamp   int64                `json:"timestamp"`
+	Key         *serde.RecordPayload `json:"key"`
+	Value       *serde.RecordPayload `json:"value"`
+	Headers     []MessageHeader      `json:"headers"`
+	Error       ConnectorDLQError    `json:"error"`
+}
+
+// ConnectorDLQError is the error context that Kafka connect adds as headers to
+// records in the dead letter queue.
+type ConnectorDLQError struct {
+	OriginalTopic     string `json:"originalTopic,omitempty"`
+	OriginalPartition *int32 `json:"originalPartition,omitempty"`
+	OriginalOffset    *int64 `json:"originalOffset,omitempty"`
+	ConnectorName     string `json:"connectorName,omitempty"`
+	TaskID            *int32 `json:"taskId,omitempty"`
+	Stage             string `json:"stage,omitempty"`
+	ClassName         string `json:"className,omitempty"`
+	ExceptionClass    string `json:"exceptionClass,omitempty"`
+	ExceptionMessage  string `json:"exceptionMessage,omitempty"`
+	Stacktrace        string `json:"stacktrace,omitempty"`
+}
+
+// ConnectorDLQErrorGroup are all inspected records that failed with the same exception class.
+type ConnectorDLQErrorGroup struct {
+	ExceptionClass string `json:"exceptionClass"`
+	Count          int    `json:"count"`
+	// SampleMessage is the exception message of the most recent record in the group.
+	SampleMessage string                  `json:"sampleMessage"`
+	Records       []ConnectorDLQRecordRef `json:"records"`
+}
+
+// ConnectorDLQRecordRef references a record in the dead letter queue.
+type ConnectorDLQRecordRef struct {
+	PartitionID int32 `json:"partitionId"`
+	Offset      int64 `json:"offset"`
+}
+
+// ConnectorDLQReplayResult reports which dead letter queue records have been
+// produced to their original topic.
+type ConnectorDLQReplayResult struct {
+	Records []ConnectorDLQReplayRecord `json:"records"`
+}
+
+// ConnectorDLQReplayRecord is the result of replaying a single record.
+type ConnectorDLQReplayRecord struct {
+	ConnectorDLQRecordRef
+	TargetTopic     string `json:"targetTopic,omitempty"`
+	TargetPartition int32  `json:"targetPartition"`
+	TargetOffset    int64  `json:"targetOffset"`
+	Error           string `json:"error,omitempty"`
+}
+
+// GetConnectorDLQ reads the most recent records of a sink connector's dead letter
+// queue and decodes the error context headers.
+func (s *Service) GetConnectorDLQ(ctx context.Context, clusterName, connectorName string, messageCount int) (*ConnectorDLQ, *rest.Error) {
+	dlq, restErr := s.getConnectorDLQTopic(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if messageCount <= 0 {
+		messageCount = defaultDLQMessageCount
+	}
+	messageCount = min(messageCount, maxDLQMessageCount)
+	messages, err := s.collectMessages(ctx, ListMessageRequest{
+		TopicName:         dlq.Topic,
+		PartitionID:       partitionsAll,
+		StartOffset:       StartOffsetRecent,
+		MessageCount:      messageCount,
+		KeyDeserializer:   serde.PayloadEncodingUnspecified,
+		ValueDeserializer: serde.PayloadEncodingUnspecified,
+	})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to read dead letter queue topic %q: %v", dlq.Topic, err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	dlq.Records = make([]ConnectorDLQRecord, len(messages))
+	for i, msg := range messages {
+		dlq.Records[i] = ConnectorDLQRecord{
+			PartitionID: msg.PartitionID,
+			Offset:      msg.Offset,
+			Timestamp:   msg.Timestamp,
+			Key:         msg.Key,
+			Value:       msg.Value,
+			Headers:     msg.Headers,
+			Error:       decodeConnectorDLQError(msg.Headers),
+		}
+	}
+	// Most recent records first
+	slices.SortFunc(dlq.Records, func(a, b ConnectorDLQRecord) int {
+		return cmp.Or(cmp.Compare(b.Timestamp, a.Timestamp), cmp.Compare(a.PartitionID, b.PartitionID), cmp.Compare(b.Offset, a.Offset))
+	})
+	dlq.ErrorGroups = groupConnectorDLQErrors(dlq.Records)
+
+	return dlq, nil
+}
+
+// ReplayConnectorDLQRecords produces the given dead letter queue records to the topic
+// and partition they were originally consumed from. The error context headers are
+// removed from the replayed records.
+func (s *Service) ReplayConnectorDLQRecords(ctx context.Context, clusterName, connectorName string, refs []ConnectorDLQRecordRef) (*ConnectorDLQReplayResult, *rest.Error) {
+	if len(refs) == 0 || len(refs) > maxDLQReplayRecords {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("invalid number of records to replay: %d", len(refs)),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Between 1 and %d records can be replayed at once", maxDLQReplayRecords),
+			IsSilent: false,
+		}
+	}
+	dlq, restErr := s.getConnectorDLQTopic(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	result := &ConnectorDLQReplayResult{Records: make([]ConnectorDLQReplayRecord, len(refs))}
+	records := make([]*kgo.Record, 0, len(refs))
+	// producedIndexes maps the index of the produced record to the index in the result
+	producedIndexes := make([]int, 0, len(refs))
+	for i, ref := range refs {
+		result.Records[i].ConnectorDLQRecordRef = ref
+
+		messages, err := s.collectMessages(ctx, ListMessageRequest{
+			TopicName:          dlq.Topic,
+			PartitionID:        ref.PartitionID,
+			StartOffset:        ref.Offset,
+			MessageCount:       1,
+			IncludeRawPayload:  true,
+			IgnoreMaxSizeLimit: true,
+			KeyDeserializer:    serde.PayloadEncodingBinary,
+			ValueDeserializer:  serde.PayloadEncodingBinary,
+		})
+		if err != nil {
+			result.Records[i].Error = fmt.Sprintf("failed to read record: %v", err)
+			continue
+		}
+		if len(messages) == 0 || messages[0].Offset != ref.Offset {
+			result.Records[i].Error = "record does not exist in the dead letter queue"
+			continue
+		}
+
+		record, err := newConnectorDLQReplayRecord(messages[0])
+		if err != nil {
+			result.Records[i].Error = err.Error()
+			continue
+		}
+		result.Records[i].TargetTopic = record.Topic
+		records = append(records, record)
+		producedIndexes = append(producedIndexes, i)
+	}
+	if len(records) == 0 {
+		return result, nil
+	}
+
+	produceRes := s.ProducePlainRecords(ctx, records, false, nil)
+	for i, idx := range producedIndexes {
+		if produceRes.Error != "" {
+			result.Records[idx].Error = produceRes.Error
+			continue
+		}
+		produced := produceRes.Records[i]
+		result.Records[idx].TargetPartition = produced.PartitionID
+		result.Records[idx].TargetOffset = produced.Offset
+		result.Records[idx].Error = produced.Error
+	}
+	s.logger.InfoContext(ctx, "replayed records from dead letter queue",
+		slog.String("cluster_name", clusterName),
+		slog.String("connector", connectorName),
+		slog.String("topic", dlq.Topic),
+		slog.Int("records", len(records)))
+
+	return result, nil
+}
+
+// getConnectorDLQTopic returns the DLQ overview of the connector without any records.
+func (s *Service) getConnectorDLQTopic(ctx context.Context, clusterName, connectorName string) (*ConnectorDLQ, *rest.Error) {
+	if s.connectSvc == nil {
+		return nil, &rest.Error{
+			Err:      errors.New("kafka connect is not configured"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Kafka Connect is not configured in Redpanda Console",
+			IsSilent: false,
+		}
+	}
+
+	info, restErr := s.connectSvc.GetConnectorInfo(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	topic := info.Config[connectorDLQTopicConfig]
+	if info.Type != "sink" || topic == "" {
+		return nil, &rest.Error{
+			Err:          errors.New("connector has no dead letter queue"),
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("The connector has no dead letter queue. Only sink connectors with %q can have a dead letter queue", connectorDLQTopicConfig),
+			InternalLogs: []slog.Attr{slog.String("cluster_name", clusterName), slog.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	return &ConnectorDLQ{
+		ClusterName:           clusterName,
+		ConnectorName:         connectorName,
+		Topic:                 topic,
+		ContextHeadersEnabled: strings.EqualFold(info.Config[connectorDLQContextHeadersConfig], "true"),
+		Records:               []ConnectorDLQRecord{},
+		ErrorGroups:           []ConnectorDLQErrorGroup{},
+	}, nil
+}
+
+// collectMessages lists the messages and returns them once the search has completed.
+func (s *Service) collectMessages(ctx context.Context, listReq ListMessageRequest) ([]*TopicMessage, error) {
+	collector := &messageCollector{messages: make([]*TopicMessage, 0)}
+	if err := s.ListMessages(ctx, listReq, collector); err != nil {
+		return nil, err
+	}
+	if collector.err != "" {
+		return nil, errors.New(collector.err)
+	}
+	return collector.messages, nil
+}
+
+// messageCollector implements IListMessagesProgress and collects all listed messages.
+type messageCollector struct {
+	mu       sync.Mutex
+	messages []*TopicMessage
+	err      string
+}
+
+func (*messageCollector) OnPhase(string) {}
+
+func (c *messageCollector) OnMessage(message *TopicMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, message)
+}
+
+func (*messageCollector) OnMessageConsumed(int64) {}
+
+func (*messageCollector) OnComplete(int64, bool, string) {}
+
+func (c *messageCollector) OnError(msg string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = msg
+}
+
+// decodeConnectorDLQError decodes the error context headers of a DLQ record.
+func decodeConnectorDLQError(headers []MessageHeader) ConnectorDLQError {
+	var dlqErr ConnectorDLQError
+	for _, header := range headers {
+		value := string(header.Value)
+		switch header.Key {
+		case connectErrorsHeaderTopic:
+			dlqErr.OriginalTopic = value
+		case connectErrorsHeaderPartition:
+			if partition, err := strconv.ParseInt(value, 10, 32); err == nil {
+				partitionID := int32(partition)
+				dlqErr.OriginalPartition = &partitionID
+			}
+		case connectErrorsHeaderOffset:
+			if offset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				dlqErr.OriginalOffset = &offset
+			}
+		case connectErrorsHeaderConnectorName:
+			dlqErr.ConnectorName = value
+		case connectErrorsHeaderTaskID:
+			if parsed, err := strconv.ParseInt(value, 10, 32); err == nil {
+				taskID := int32(parsed)
+				dlqErr.TaskID = &taskID
+			}
+		case connectErrorsHeaderStage:
+			dlqErr.Stage = value
+		case connectErrorsHeaderClassName:
+			dlqErr.ClassName = value
+		case connectErrorsHeaderExceptionClass:
+			dlqErr.ExceptionClass = value
+		case connectErrorsHeaderExceptionMsg:
+			dlqErr.ExceptionMessage = value
+		case connectErrorsHeaderStacktrace:
+			dlqErr.Stacktrace = value
+		}
+	}
+	return dlqErr
+}
+
+// groupConnectorDLQErrors groups the records by their exception class. Groups are
+// ordered by the number of records, the records are expected to be ordered by recency.
+func groupConnectorDLQErrors(records []ConnectorDLQRecord) []ConnectorDLQErrorGroup {
+	groupsByClass := make(map[string]*ConnectorDLQErrorGroup)
+	groups := make([]*ConnectorDLQErrorGroup, 0)
+	for _, record := range records {
+		group, exists := groupsByClass[record.Error.ExceptionClass]
+		if !exists {
+			group = &ConnectorDLQErrorGroup{
+				ExceptionClass: record.Error.ExceptionClass,
+				SampleMessage:  record.Error.ExceptionMessage,
+				Records:        make([]ConnectorDLQRecordRef, 0),
+			}
+			groupsByClass[record.Error.ExceptionClass] = group
+			groups = append(groups, group)
+		}
+		group.Count++
+		group.Records = append(group.Records, ConnectorDLQRecordRef{PartitionID: record.PartitionID, Offset: record.Offset})
+	}
+
+	slices.SortStableFunc(groups, func(a, b *ConnectorDLQErrorGroup) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	result := make([]ConnectorDLQErrorGroup, len(groups))
+	for i, group := range groups {
+		result[i] = *group
+	}
+	return result
+}
+
+// newConnectorDLQReplayRecord creates the record that is produced to the original topic.
+func newConnectorDLQReplayRecord(msg *TopicMessage) (*kgo.Record, error) {
+	dlqErr := decodeConnectorDLQError(msg.Headers)
+	if dlqErr.OriginalTopic == "" {
+		return nil, fmt.Errorf("record has no %q header, enable %q to replay records", connectErrorsHeaderTopic, connectorDLQContextHeadersConfig)
+	}
+
+	record := &kgo.Record{
+		Topic:     dlqErr.OriginalTopic,
+		Partition: -1,
+	}
+	if dlqErr.OriginalPartition != nil {
+		record.Partition = *dlqErr.OriginalPartition
+	}
+	if msg.Key != nil {
+		record.Key = msg.Key.OriginalPayload
+	}
+	if msg.Value != nil {
+		record.Value = msg.Value.OriginalPayload
+	}
+	for _, header := range msg.Headers {
+		if strings.HasPrefix(header.Key, connectErrorsHeaderPrefix) {
+			continue
+		}
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: header.Key, Value: header.Value})
+	}
+
+	return record, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+func dlqHeaders(exceptionClass, message string) []MessageHeader {
+	return []MessageHeader{
+		{Key: "trace-id", Value: []byte("abc")},
+		{Key: connectErrorsHeaderTopic, Value: []byte("orders")},
+		{Key: connectErrorsHeaderPartition, Value: []byte("2")},
+		{Key: connectErrorsHeaderOffset, Value: []byte("1042")},
+		{Key: connectErrorsHeaderConnectorName, Value: []byte("warehouse-sink")},
+		{Key: connectErrorsHeaderTaskID, Value: []byte("0")},
+		{Key: connectErrorsHeaderStage, Value: []byte("VALUE_CONVERTER")},
+		{Key: connectErrorsHeaderClassName, Value: []byte("io.confluent.connect.avro.AvroConverter")},
+		{Key: connectErrorsHeaderExceptionClass, Value: []byte(exceptionClass)},
+		{Key: connectErrorsHeaderExceptionMsg, Value: []byte(message)},
+		{Key: connectErrorsHeaderStacktrace, Value: []byte("org.apache.kafka.connect.errors.DataException: ...")},
+	}
+}
+
+func TestDecodeConnectorDLQError(t *testing.T) {
+	dlqErr := decodeConnectorDLQError(dlqHeaders("org.apache.kafka.connect.errors.DataException", "Unknown magic byte!"))
+
+	assert.Equal(t, "orders", dlqErr.OriginalTopic)
+	require.NotNil(t, dlqErr.OriginalPartition)
+	assert.Equal(t, int32(2), *dlqErr.OriginalPartition)
+	require.NotNil(t, dlqErr.OriginalOffset)
+	assert.Equal(t, int64(1042), *dlqErr.OriginalOffset)
+	assert.Equal(t, "warehouse-sink", dlqErr.ConnectorName)
+	require.NotNil(t, dlqErr.TaskID)
+	assert.Equal(t, int32(0), *dlqErr.TaskID)
+	assert.Equal(t, "VALUE_CONVERTER", dlqErr.Stage)
+	assert.Equal(t, "org.apache.kafka.connect.errors.DataException", dlqErr.ExceptionClass)
+	assert.Equal(t, "Unknown magic byte!", dlqErr.ExceptionMessage)
+	assert.NotEmpty(t, dlqErr.Stacktrace)
+
+	// Records without context headers can still be inspected
+	assert.Equal(t, ConnectorDLQError{}, decodeConnectorDLQError(nil))
+}
+
+func TestGroupConnectorDLQErrors(t *testing.T) {
+	records := []ConnectorDLQRecord{
+		{PartitionID: 0, Offset: 3, Error: ConnectorDLQError{ExceptionClass: "SQLException", ExceptionMessage: "timeout"}},
+		{PartitionID: 0, Offset: 2, Error: ConnectorDLQError{ExceptionClass: "DataException", ExceptionMessage: "Unknown magic byte!"}},
+		{PartitionID: 1, Offset: 7, Error: ConnectorDLQError{ExceptionClass: "DataException", ExceptionMessage: "Unknown magic byte?"}},
+		{PartitionID: 0, Offset: 1, Error: ConnectorDLQError{}},
+	}
+
+	groups := groupConnectorDLQErrors(records)
+	require.Len(t, groups, 3)
+	assert.Equal(t, ConnectorDLQErrorGroup{
+		ExceptionClass: "DataException",
+		Count:          2,
+		SampleMessage:  "Unknown magic byte!",
+		Records:        []ConnectorDLQRecordRef{{PartitionID: 0, Offset: 2}, {PartitionID: 1, Offset: 7}},
+	}, groups[0])
+	assert.Equal(t, "SQLException", groups[1].ExceptionClass)
+	assert.Empty(t, groups[2].ExceptionClass)
+}
+
+func TestNewConnectorDLQReplayRecord(t *testing.T) {
+	msg := &TopicMessage{
+		PartitionID: 0,
+		Offset:      12,
+		Headers:     dlqHeaders("DataException", "Unknown magic byte!"),
+		Key:         &serde.RecordPayload{OriginalPayload: []byte("key")},
+		Value:       &serde.RecordPayload{OriginalPayload: []byte(`{"id":1}`)},
+	}
+
+	record, err := newConnectorDLQReplayRecord(msg)
+	require.NoError(t, err)
+	assert.Equal(t, "orders", record.Topic)
+	assert.Equal(t, int32(2), record.Partition)
+	assert.Equal(t, []byte("key"), record.Key)
+	assert.Equal(t, []byte(`{"id":1}`), record.Value)
+	assert.Equal(t, []kgo.RecordHeader{{Key: "trace-id", Value: []byte("abc")}}, record.Headers)
+
+	// The original topic is required to replay records
+	msg.Headers = []MessageHeader{{Key: "trace-id", Value: []byte("abc")}}
+	_, err = newConnectorDLQReplayRecord(msg)
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+// ConnectorOffsetsOverview contains the committed offsets of a Kafka connect connector.
+type ConnectorOffsetsOverview struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+	// Type is either source or sink
+	Type string `json:"type"`
+	// ConsumerGroup is the consumer group that is used by sink connectors
+	ConsumerGroup string                     `json:"consumerGroup,omitempty"`
+	Offsets       []ConnectorPartitionOffset `json:"offsets"`
+}
+
+// ConnectorPartitionOffset is the committed offset of a single source or sink partition.
+// For sink connectors it also contains the consumer group lag of the partition.
+type ConnectorPartitionOffset struct {
+	Partition     map[string]any `json:"partition"`
+	Offset        map[string]any `json:"offset"`
+	Lag           *int64         `json:"lag,omitempty"`
+	HighWaterMark *int64         `json:"highWaterMark,omitempty"`
+}
+
+// GetConnectorOffsets returns the committed offsets of a connector. The offsets of
+// sink connectors are shown along with the lag of the connector's consumer group.
+func (s *Service) GetConnectorOffsets(ctx context.Context, clusterName string, connectorName string) (*ConnectorOffsetsOverview, *rest.Error) {
+	if s.connectSvc == nil {
+		return nil, &rest.Error{
+			Err:      errors.New("kafka connect is not configured"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Kafka Connect is not configured in Redpanda Console",
+			IsSilent: false,
+		}
+	}
+
+	info, restErr := s.connectSvc.GetConnectorInfo(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	offsets, restErr := s.connectSvc.GetConnectorOffsets(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	overview := &ConnectorOffsetsOverview{
+		ClusterName:   clusterName,
+		ConnectorName: connectorName,
+		Type:          info.Type,
+		Offsets:       newConnectorPartitionOffsets(offsets),
+	}
+	if info.Type != "sink" {
+		return overview, nil
+	}
+
+	overview.ConsumerGroup = connectorConsumerGroup(connectorName, info.Config)
+	if err := s.addSinkConnectorLag(ctx, overview); err != nil {
+		// The offsets are still useful without the lag, so that we don't fail the request
+		s.logger.WarnContext(ctx, "failed to get consumer group lag of sink connector",
+			slog.String("cluster_name", clusterName),
+			slog.String("connector", connectorName),
+			slog.Any("error", err))
+	}
+
+	return overview, nil
+}
+
+// addSinkConnectorLag sets the lag and high water mark of each sink partition using
+// the offsets of the connector's consumer group.
+func (s *Service) addSinkConnectorLag(ctx context.Context, overview *ConnectorOffsetsOverview) error {
+	_, adminCl, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return err
+	}
+	offsetsByGroup, err := s.getConsumerGroupOffsets(ctx, adminCl, []string{overview.ConsumerGroup})
+	if err != nil {
+		return err
+	}
+
+	partitionOffsetsByTopic := make(map[string]map[int32]PartitionOffsets)
+	for _, topicOffsets := range offsetsByGroup[overview.ConsumerGroup] {
+		partitionOffsetsByTopic[topicOffsets.Topic] = make(map[int32]PartitionOffsets, len(topicOffsets.PartitionOffsets))
+		for _, partitionOffsets := range topicOffsets.PartitionOffsets {
+			partitionOffsetsByTopic[topicOffsets.Topic][partitionOffsets.PartitionID] = partitionOffsets
+		}
+	}
+
+	for i, offset := range overview.Offsets {
+		topic, partitionID, ok := sinkOffsetPartition(offset.Partition)
+		if !ok {
+			continue
+		}
+		partitionOffsets, exists := partitionOffsetsByTopic[topic][partitionID]
+		if !exists || partitionOffsets.Error != "" {
+			continue
+		}
+		overview.Offsets[i].Lag = &partitionOffsets.Lag
+		overview.Offsets[i].HighWaterMark = &partitionOffsets.HighWaterMark
+	}
+
+	return nil
+}
+
+// sinkOffsetPartition returns the topic and partition ID of a sink connector partition
+// as returned by the Kafka connect offsets API.
+func sinkOffsetPartition(partition map[string]any) (string, int32, bool) {
+	topic, ok := partition["kafka_topic"].(string)
+	if !ok {
+		return "", 0, false
+	}
+	partitionID, ok := partition["kafka_partition"].(float64)
+	if !ok {
+		return "", 0, false
+	}
+	return topic, int32(partitionID), true
+}
+
+// newConnectorPartitionOffsets converts the offsets returned by the connect service.
+func newConnectorPartitionOffsets(offsets connect.ConnectorOffsets) []ConnectorPartitionOffset {
+	converted := make([]ConnectorPartitionOffset, len(offsets.Offsets))
+	for i, offset := range offsets.Offsets {
+		converted[i] = ConnectorPartitionOffset{Partition: offset.Partition, Offset: offset.Offset}
+	}
+	return converted
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSinkOffsetPartition(t *testing.T) {
+	topic, partitionID, ok := sinkOffsetPartition(map[string]any{"kafka_topic": "orders", "kafka_partition": float64(3)})
+	assert.True(t, ok)
+	assert.Equal(t, "orders", topic)
+	assert.Equal(t, int32(3), partitionID)
+
+	// Source connector partitions are defined by the connector plugin
+	_, _, ok = sinkOffsetPartition(map[string]any{"server": "db1"})
+	assert.False(t, ok)
+	_, _, ok = sinkOffsetPartition(map[string]any{"kafka_topic": "orders"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,332 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/schemasample"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+const (
+	// maxGeneratedRecords is the maximum number of records a single request may generate.
+	maxGeneratedRecords = 1_000_000
+	// maxGeneratedRecordsDryRun is the maximum number of records returned by a dry run.
+	maxGeneratedRecordsDryRun = 100
+	// maxGenerateRecordsBatchSize is the maximum number of records produced at once.
+	maxGenerateRecordsBatchSize = 100
+	// maxGenerateRecordsErrors is the number of distinct errors that are reported,
+	// and the number of consecutive failed batches after which generating stops.
+	maxGenerateRecordsErrors = 10
+	// maxGenerateRecordsDuration is the maximum duration of a single request. It
+	// is further limited by the HTTP server's write timeout.
+	maxGenerateRecordsDuration = 30 * time.Minute
+)
+
+// GenerateRecordsRequest describes randomized records that shall be generated
+// from a schema and produced to a topic.
+type GenerateRecordsRequest struct {
+	Topic string `json:"-"`
+	// PartitionID is the partition to produce to. -1 lets the partitioner
+	// distribute the records.
+	PartitionID int32 `json:"partitionId"`
+	// SchemaID is the ID of the value schema in the schema registry.
+	SchemaID int `json:"schemaId"`
+	// IndexPath selects the Protobuf message inside the schema. Empty means
+	// the first top-level message.
+	IndexPath []int `json:"indexPath"`
+	// Count is the number of records to generate.
+	Count int `json:"count"`
+	// Seed and Now make the generated records reproducible, see
+	// schemasample.GeneratorOptions.
+	Seed int64     `json:"seed"`
+	Now  time.Time `json:"now"`
+	// RecordsPerSecond limits the produce rate. 0 means unlimited.
+	RecordsPerSecond float64 `json:"recordsPerSecond"`
+	// Fields overrides the generated values of individual fields by path.
+	Fields map[string]schemasample.FieldGenerator `json:"fields"`
+	// DryRun returns the generated records without producing them.
+	DryRun bool `json:"dryRun"`
+}
+
+// GenerateRecordsResponse is the result of generating records.
+type GenerateRecordsResponse struct {
+	Produced int `json:"produced"`
+	Failed   int `json:"failed"`
+	// Records are the generated records in their JSON encoding. They are only
+	// returned for dry runs.
+	Records []json.RawMessage `json:"records,omitempty"`
+	// Errors are the first distinct errors that occurred while producing.
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (r *GenerateRecordsResponse) addError(err error) {
+	msg := err.Error()
+	if len(r.Errors) < maxGenerateRecordsErrors && !slices.Contains(r.Errors, msg) {
+		r.Errors = append(r.Errors, msg)
+	}
+}
+
+// GenerateRecords generates randomized records from a registered schema and
+// produces them to a topic. The records are serialized like ProduceRecord
+// does, but produced in batches with a single producer client per request. Generating stops early if the context is cancelled, if several
+// batches in a row failed entirely or once the max duration has passed, so
+// that the response is sent before the HTTP server's write timeout.
+func (s *Service) GenerateRecords(ctx context.Context, req GenerateRecordsRequest) (*GenerateRecordsResponse, *rest.Error) {
+	maxDuration := s.generateRecordsMaxDuration()
+	if restErr := validateGenerateRecordsRequest(req, maxDuration); restErr != nil {
+		return nil, restErr
+	}
+
+	generator, schemaType, err := s.newSchemaGenerator(ctx, req)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to create record generator: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	res := &GenerateRecordsResponse{}
+	if req.DryRun {
+		for range req.Count {
+			record, err := generator.Next()
+			if err != nil {
+				return nil, errorToRestError(fmt.Errorf("failed to generate record: %w", err))
+			}
+			res.Records = append(res.Records, record)
+		}
+		return res, nil
+	}
+
+	valueInput := serde.RecordPayloadInput{
+		Encoding: generateRecordsPayloadEncoding(schemaType),
+		Options:  []serde.SerdeOpt{serde.WithSchemaID(uint32(req.SchemaID))},
+	}
+	if len(req.IndexPath) > 0 {
+		valueInput.Options = append(valueInput.Options, serde.WithIndex(req.IndexPath...))
+	}
+
+	batchSize := maxGenerateRecordsBatchSize
+	var interval time.Duration
+	if req.RecordsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / req.RecordsPerSecond)
+		// Produce about ten batches per second to spread the records evenly
+		batchSize = min(max(int(math.Ceil(req.RecordsPerSecond/10)), 1), maxGenerateRecordsBatchSize)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, maxDuration)
+	defer cancel()
+
+	client, err := s.newProducerClient(ctx, false, []kgo.CompressionCodec{kgo.NoCompression()})
+	if err != nil {
+		return nil, errorToRestError(err)
+	}
+	defer client.Close()
+
+	start := time.Now()
+	failedBatches := 0
+	for generated := 0; generated < req.Count; {
+		if interval > 0 {
+			if err := sleepUntil(ctx, start.Add(time.Duration(generated)*interval)); err != nil {
+				res.addError(fmt.Errorf("stopped after %d records: %w", generated, err))
+				return res, nil
+			}
+		}
+
+		records := make([]*kgo.Record, 0, min(batchSize, req.Count-generated))
+		for ; generated < req.Count && len(records) < cap(records); generated++ {
+			record, err := s.generateRecord(ctx, generator, req, valueInput)
+			if err != nil {
+				res.Failed++
+				res.addError(err)
+				continue
+			}
+			records = append(records, record)
+		}
+
+		produced := 0
+		if len(records) > 0 {
+			produceRes := produceRecordsWithClient(ctx, client, records, false)
+			if produceRes.Error != "" {
+				res.Failed += len(records)
+				res.addError(errors.New(produceRes.Error))
+			}
+			for _, record := range produceRes.Records {
+				if record.Error != "" {
+					res.Failed++
+					res.addError(errors.New(record.Error))
+					continue
+				}
+				produced++
+			}
+		}
+		res.Produced += produced
+
+		if produced == 0 {
+			failedBatches++
+		} else {
+			failedBatches = 0
+		}
+		if failedBatches >= maxGenerateRecordsErrors {
+			res.addError(fmt.Errorf("stopped after %d consecutive failed batches", failedBatches))
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			res.addError(fmt.Errorf("stopped after %d records: %w", generated, ctx.Err()))
+			return res, nil
+		}
+	}
+
+	return res, nil
+}
+
+// generateRecord generates and serializes a single record with a null key.
+func (s *Service) generateRecord(
+	ctx context.Context,
+	generator *schemasample.Generator,
+	req GenerateRecordsRequest,
+	valueInput serde.RecordPayloadInput,
+) (*kgo.Record, error) {
+	value, err := generator.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate record: %w", err)
+	}
+	valueInput.Payload = value
+
+	data, err := s.serdeSvc.SerializeRecord(ctx, serde.SerializeInput{
+		Topic: req.Topic,
+		Key:   serde.RecordPayloadInput{Encoding: serde.PayloadEncodingNull},
+		Value: valueInput,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize record: %w", err)
+	}
+
+	return &kgo.Record{
+		Topic:     req.Topic,
+		Key:       data.Key.Payload,
+		Value:     data.Value.Payload,
+		Partition: req.PartitionID,
+	}, nil
+}
+
+// newSchemaGenerator creates a record generator for the requested schema.
+func (s *Service) newSchemaGenerator(ctx context.Context, req GenerateRecordsRequest) (*schemasample.Generator, sr.SchemaType, error) {
+	if s.cachedSchemaClient == nil {
+		return nil, 0, errors.New("schema registry is not configured")
+	}
+
+	sch, err := s.cachedSchemaClient.SchemaByID(ctx, req.SchemaID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load schema %d: %w", req.SchemaID, err)
+	}
+
+	opts := schemasample.GeneratorOptions{
+		Seed:   req.Seed,
+		Now:    req.Now,
+		Fields: req.Fields,
+	}
+	var generator *schemasample.Generator
+	switch sch.Type {
+	case sr.TypeAvro:
+		generator, err = schemasample.NewAvroGenerator(sch.Schema, opts)
+	case sr.TypeJSON:
+		generator, err = schemasample.NewJSONSchemaGenerator(sch.Schema, opts)
+	case sr.TypeProtobuf:
+		files, rootFilename, filesErr := s.cachedSchemaClient.ProtoFilesByID(ctx, req.SchemaID)
+		if filesErr != nil {
+			return nil, 0, fmt.Errorf("failed to load proto files for schema %d: %w", req.SchemaID, filesErr)
+		}
+		generator, err = schemasample.NewProtobufGenerator(files, rootFilename, req.IndexPath, opts)
+	default:
+		return nil, 0, fmt.Errorf("unsupported schema type %q for record generation", sch.Type.String())
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return generator, sch.Type, nil
+}
+
+// generateRecordsMaxDuration returns how long a single request may produce records.
+func (s *Service) generateRecordsMaxDuration() time.Duration {
+	maxDuration := maxGenerateRecordsDuration
+	if writeTimeout := s.cfg.REST.HTTPServerWriteTimeout; writeTimeout > 0 {
+		// Leave some time to send the response
+		maxDuration = min(maxDuration, writeTimeout*9/10)
+	}
+	return maxDuration
+}
+
+func validateGenerateRecordsRequest(req GenerateRecordsRequest, maxDuration time.Duration) *rest.Error {
+	var err error
+	switch {
+	case req.SchemaID <= 0:
+		err = errors.New("a schema id must be set")
+	case req.Count <= 0:
+		err = errors.New("count must be positive")
+	case req.Count > maxGeneratedRecords:
+		err = fmt.Errorf("count must not exceed %d", maxGeneratedRecords)
+	case req.DryRun && req.Count > maxGeneratedRecordsDryRun:
+		err = fmt.Errorf("count must not exceed %d for dry runs", maxGeneratedRecordsDryRun)
+	case req.RecordsPerSecond < 0:
+		err = errors.New("recordsPerSecond must not be negative")
+	case !req.DryRun && req.RecordsPerSecond > 0 && float64(req.Count)/req.RecordsPerSecond > maxDuration.Seconds():
+		err = fmt.Errorf("producing %d records at %g records per second would take longer than %v, lower the count or raise the rate",
+			req.Count, req.RecordsPerSecond, maxDuration)
+	case req.PartitionID < -1:
+		err = errors.New("partitionId must be -1 or a valid partition")
+	}
+	if err == nil {
+		return nil
+	}
+	return &rest.Error{
+		Err:      err,
+		Status:   http.StatusBadRequest,
+		Message:  fmt.Sprintf("Invalid generate records request: %v", err.Error()),
+		IsSilent: false,
+	}
+}
+
+func generateRecordsPayloadEncoding(schemaType sr.SchemaType) serde.PayloadEncoding {
+	switch schemaType {
+	case sr.TypeProtobuf:
+		return serde.PayloadEncodingProtobufSchema
+	case sr.TypeJSON:
+		return serde.PayloadEncodingJSONSchema
+	default:
+		return serde.PayloadEncodingAvro
+	}
+}
+
+// sleepUntil waits until t or until the context is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,549 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+// LineageNodeType is the kind of resource that is represented by a lineage node.
+type LineageNodeType string
+
+const (
+	// LineageNodeTypeTopic is a Kafka topic.
+	LineageNodeTypeTopic LineageNodeType = "TOPIC"
+	// LineageNodeTypeSourceConnector is a Kafka connect source connector, which produces to topics.
+	LineageNodeTypeSourceConnector LineageNodeType = "SOURCE_CONNECTOR"
+	// LineageNodeTypeSinkConnector is a Kafka connect sink connector, which consumes from topics.
+	LineageNodeTypeSinkConnector LineageNodeType = "SINK_CONNECTOR"
+	// LineageNodeTypeTransform is a Redpanda data transform.
+	LineageNodeTypeTransform LineageNodeType = "TRANSFORM"
+	// LineageNodeTypeConsumerGroup is a consumer group that has committed offsets.
+	LineageNodeTypeConsumerGroup LineageNodeType = "CONSUMER_GROUP"
+	// LineageNodeTypePipeline is a Redpanda Connect pipeline.
+	LineageNodeTypePipeline LineageNodeType = "PIPELINE"
+)
+
+// LineageDirection describes in which direction the lineage graph is traversed
+// when it is queried for a single node.
+type LineageDirection string
+
+const (
+	// LineageDirectionUpstream returns all nodes the data of a node originates from.
+	LineageDirectionUpstream LineageDirection = "UPSTREAM"
+	// LineageDirectionDownstream returns all nodes that receive data from a node.
+	LineageDirectionDownstream LineageDirection = "DOWNSTREAM"
+	// LineageDirectionBoth returns both upstream and downstream nodes.
+	LineageDirectionBoth LineageDirection = "BOTH"
+)
+
+// lineageParallelRequests is the maximum number of concurrent requests for
+// fetching the topics of all connectors.
+const lineageParallelRequests = 10
+
+// LineageGraphRequest describes which part of the lineage graph shall be returned.
+type LineageGraphRequest struct {
+	// NodeID is the node whose upstream or downstream graph shall be returned.
+	// If empty, the whole graph is returned.
+	NodeID    string
+	Direction LineageDirection
+	// Depth is the maximum number of edges between NodeID and returned nodes.
+	// 0 means unlimited.
+	Depth int
+	// Pipelines are the Redpanda Connect pipelines that are added to the graph.
+	// They are not known to Console itself and thus need to be provided by the caller.
+	Pipelines []LineagePipeline
+}
+
+// LineagePipeline is a Redpanda Connect pipeline along with the topics it reads
+// from and writes to.
+type LineagePipeline struct {
+	ID           string
+	Name         string
+	State        string
+	InputTopics  []string
+	OutputTopics []string
+}
+
+// LineageGraph is a directed graph whose edges point in the direction of the data flow.
+type LineageGraph struct {
+	Nodes []LineageNode `json:"nodes"`
+	Edges []LineageEdge `json:"edges"`
+	// Warnings describe sources that could not be inspected, so that the graph
+	// may be incomplete.
+	Warnings []string `json:"warnings"`
+}
+
+// LineageNode is a single resource in the lineage graph.
+type LineageNode struct {
+	// ID uniquely identifies the node, e.g. "topic:orders" or "connector:cluster/name".
+	ID   string          `json:"id"`
+	Type LineageNodeType `json:"type"`
+	Name string          `json:"name"`
+	// ConnectClusterName is the Kafka connect cluster of connector nodes.
+	ConnectClusterName string `json:"connectClusterName,omitempty"`
+	State              string `json:"state,omitempty"`
+	// RecordCount is the number of records in topic nodes.
+	RecordCount *int64 `json:"recordCount,omitempty"`
+}
+
+// LineageEdge is a data flow from the source node to the target node.
+type LineageEdge struct {
+	Source string                `json:"source"`
+	Target string                `json:"target"`
+	Hint   LineageThroughputHint `json:"hint"`
+}
+
+// LineageThroughputHint gives an indication how much data flows along an edge.
+type LineageThroughputHint struct {
+	// TopicRecordCount is the number of records in the topic the edge starts or ends at.
+	TopicRecordCount int64 `json:"topicRecordCount"`
+	// Lag is the number of records in the source topic that have not been
+	// processed by the target yet. It is only set for consuming edges whose lag is known.
+	Lag *int64 `json:"lag,omitempty"`
+	// IsActive is false if the consumer or producer of the edge is not running.
+	IsActive bool `json:"isActive"`
+}
+
+// Node IDs are prefixed with their type, so that resources with the same name,
+// e.g. a topic and a consumer group called "orders", do not collide.
+func lineageTopicID(topic string) string {
+	return "topic:" + topic
+}
+
+func lineageConnectorID(clusterName, connector string) string {
+	return "connector:" + clusterName + "/" + connector
+}
+
+func lineageTransformID(transform string) string {
+	return "transform:" + transform
+}
+
+func lineageConsumerGroupID(group string) string {
+	return "consumer-group:" + group
+}
+
+func lineagePipelineID(pipelineID string) string {
+	return "pipeline:" + pipelineID
+}
+
+// lineageGraphBuilder collects nodes and edges from all sources, deduplicating them by ID.
+type lineageGraphBuilder struct {
+	nodes    map[string]*LineageNode
+	edges    map[[2]string]*LineageEdge
+	warnings []string
+}
+
+func newLineageGraphBuilder() *lineageGraphBuilder {
+	return &lineageGraphBuilder{
+		nodes:    make(map[string]*LineageNode),
+		edges:    make(map[[2]string]*LineageEdge),
+		warnings: make([]string, 0),
+	}
+}
+
+// addNode adds the node unless a node with the same ID already exists.
+func (b *lineageGraphBuilder) addNode(node LineageNode) {
+	if _, exists := b.nodes[node.ID]; !exists {
+		b.nodes[node.ID] = &node
+	}
+}
+
+// addTopic adds a topic node, which may not exist in the cluster anymore, and returns its ID.
+func (b *lineageGraphBuilder) addTopic(topic string) string {
+	id := lineageTopicID(topic)
+	b.addNode(LineageNode{ID: id, Type: LineageNodeTypeTopic, Name: topic})
+	return id
+}
+
+// addEdge adds or replaces the edge between two nodes.
+func (b *lineageGraphBuilder) addEdge(source, target string, lag *int64, isActive bool) {
+	b.edges[[2]string{source, target}] = &LineageEdge{
+		Source: source,
+		Target: target,
+		Hint:   LineageThroughputHint{Lag: lag, IsActive: isActive},
+	}
+}
+
+func (b *lineageGraphBuilder) addWarning(format string, args ...any) {
+	b.warnings = append(b.warnings, fmt.Sprintf(format, args...))
+}
+
+// graph returns the sorted lineage graph and fills in the topic record counts of all edges.
+func (b *lineageGraphBuilder) graph() *LineageGraph {
+	g := &LineageGraph{
+		Nodes:    make([]LineageNode, 0, len(b.nodes)),
+		Edges:    make([]LineageEdge, 0, len(b.edges)),
+		Warnings: b.warnings,
+	}
+	for _, node := range b.nodes {
+		g.Nodes = append(g.Nodes, *node)
+	}
+	for _, edge := range b.edges {
+		for _, id := range []string{edge.Source, edge.Target} {
+			if node := b.nodes[id]; node.Type == LineageNodeTypeTopic && node.RecordCount != nil {
+				edge.Hint.TopicRecordCount = *node.RecordCount
+			}
+		}
+		g.Edges = append(g.Edges, *edge)
+	}
+	slices.SortFunc(g.Nodes, func(a, b LineageNode) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(g.Edges, func(a, b LineageEdge) int {
+		return cmp.Or(strings.Compare(a.Source, b.Source), strings.Compare(a.Target, b.Target))
+	})
+	return g
+}
+
+// traverse returns the subgraph of all nodes that are reachable from the given node in
+// the given direction within depth edges. The second return value is false if the node
+// does not exist.
+func (g *LineageGraph) traverse(nodeID string, direction LineageDirection, depth int) (*LineageGraph, bool) {
+	if !slices.ContainsFunc(g.Nodes, func(n LineageNode) bool { return n.ID == nodeID }) {
+		return nil, false
+	}
+
+	downstream := make(map[string][]string)
+	upstream := make(map[string][]string)
+	for _, edge := range g.Edges {
+		downstream[edge.Source] = append(downstream[edge.Source], edge.Target)
+		upstream[edge.Target] = append(upstream[edge.Target], edge.Source)
+	}
+
+	included := map[string]bool{nodeID: true}
+	walk := func(adjacency map[string][]string) {
+		visited := map[string]bool{nodeID: true}
+		frontier := []string{nodeID}
+		for level := 0; len(frontier) > 0 && (depth <= 0 || level < depth); level++ {
+			var next []string
+			for _, id := range frontier {
+				for _, neighbor := range adjacency[id] {
+					if visited[neighbor] {
+						continue
+					}
+					visited[neighbor] = true
+					included[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+			frontier = next
+		}
+	}
+	if direction != LineageDirectionDownstream {
+		walk(upstream)
+	}
+	if direction != LineageDirectionUpstream {
+		walk(downstream)
+	}
+
+	sub := &LineageGraph{
+		Nodes:    make([]LineageNode, 0),
+		Edges:    make([]LineageEdge, 0),
+		Warnings: g.Warnings,
+	}
+	for _, node := range g.Nodes {
+		if included[node.ID] {
+			sub.Nodes = append(sub.Nodes, node)
+		}
+	}
+	for _, edge := range g.Edges {
+		if included[edge.Source] && included[edge.Target] {
+			sub.Edges = append(sub.Edges, edge)
+		}
+	}
+	return sub, true
+}
+
+// GetLineageGraph assembles a data lineage graph from the topics, consumer groups,
+// Kafka connect connectors, data transforms and the given Redpanda Connect pipelines.
+// Sources other than Kafka that cannot be inspected are reported as warnings, so that
+// a partial graph is still returned.
+func (s *Service) GetLineageGraph(ctx context.Context, req LineageGraphRequest) (*LineageGraph, *rest.Error) {
+	b := newLineageGraphBuilder()
+
+	// Connectors are collected first, so that consumer groups of sink connectors
+	// are attributed to the connector rather than being added as separate nodes.
+	sinkGroups := s.collectConnectorLineage(ctx, b)
+	s.collectTransformLineage(ctx, b)
+	for _, pipeline := range req.Pipelines {
+		addPipelineLineage(b, pipeline)
+	}
+	if err := s.collectTopicLineage(ctx, b, sinkGroups); err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to collect topics and consumer groups: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	graph := b.graph()
+	if req.NodeID == "" {
+		return graph, nil
+	}
+	sub, exists := graph.traverse(req.NodeID, req.Direction, req.Depth)
+	if !exists {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("lineage node %q not found", req.NodeID),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("Lineage node %q does not exist", req.NodeID),
+			IsSilent: false,
+		}
+	}
+	return sub, nil
+}
+
+// collectTopicLineage adds all topics along with their record counts and the consumer
+// groups that have committed offsets. Lags of groups that belong to sink connectors are
+// set on the respective connector edges. The keys of sinkGroups are group IDs and the
+// values the IDs of the sink connector nodes.
+func (s *Service) collectTopicLineage(ctx context.Context, b *lineageGraphBuilder, sinkGroups map[string]string) error {
+	_, adminCl, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := adminCl.Metadata(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+	topicNames := make([]string, 0, len(metadata.Topics))
+	for _, topic := range metadata.Topics {
+		if topic.Err != nil || topic.IsInternal {
+			continue
+		}
+		topicNames = append(topicNames, topic.Topic)
+		b.addTopic(topic.Topic)
+	}
+
+	if len(topicNames) > 0 {
+		startOffsets, err := adminCl.ListStartOffsets(ctx, topicNames...)
+		if err != nil {
+			return fmt.Errorf("failed to list topic start offsets: %w", err)
+		}
+		endOffsets, err := adminCl.ListEndOffsets(ctx, topicNames...)
+		if err != nil {
+			return fmt.Errorf("failed to list topic end offsets: %w", err)
+		}
+		endOffsets.Each(func(end kadm.ListedOffset) {
+			start, ok := startOffsets.Lookup(end.Topic, end.Partition)
+			if end.Err != nil || !ok || start.Err != nil {
+				return
+			}
+			node := b.nodes[lineageTopicID(end.Topic)]
+			if node.RecordCount == nil {
+				node.RecordCount = new(int64)
+			}
+			*node.RecordCount += max(end.Offset-start.Offset, 0)
+		})
+	}
+
+	groups, err := adminCl.ListGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list consumer groups: %w", err)
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+	offsetsByGroup, err := s.getConsumerGroupOffsets(ctx, adminCl, groups.Groups())
+	if err != nil {
+		return fmt.Errorf("failed to get consumer group offsets: %w", err)
+	}
+	for groupID, topicOffsets := range offsetsByGroup {
+		state := groups[groupID].State
+		isActive := isGroupStateActive(state)
+		for _, topicOffset := range topicOffsets {
+			if topicOffset.PartitionsWithOffset == 0 {
+				continue
+			}
+			topicID := b.addTopic(topicOffset.Topic)
+			lag := topicOffset.SummedLag
+
+			if connectorID, isSinkGroup := sinkGroups[groupID]; isSinkGroup {
+				b.addEdge(topicID, connectorID, &lag, b.nodes[connectorID].State == "RUNNING")
+				continue
+			}
+
+			groupNodeID := lineageConsumerGroupID(groupID)
+			b.addNode(LineageNode{ID: groupNodeID, Type: LineageNodeTypeConsumerGroup, Name: groupID, State: state})
+			b.addEdge(topicID, groupNodeID, &lag, isActive)
+		}
+	}
+
+	return nil
+}
+
+// collectConnectorLineage adds all connectors of all Kafka connect clusters along with
+// the topics they use. It returns the consumer group IDs of all sink connectors.
+func (s *Service) collectConnectorLineage(ctx context.Context, b *lineageGraphBuilder) map[string]string {
+	sinkGroups := make(map[string]string)
+	if s.connectSvc == nil || len(s.connectSvc.Clusters()) == 0 {
+		return sinkGroups
+	}
+
+	clusters, err := s.connectSvc.GetAllClusterConnectors(ctx)
+	if err != nil {
+		b.addWarning("Failed to list Kafka connect connectors: %v", err)
+		return sinkGroups
+	}
+
+	type connectorTopics struct {
+		clusterName string
+		connector   connect.ClusterConnectorInfo
+		topics      []string
+	}
+	var (
+		mu      sync.Mutex
+		results []connectorTopics
+	)
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(lineageParallelRequests)
+	for _, cluster := range clusters {
+		if cluster.Error != "" {
+			b.addWarning("Failed to list connectors of Kafka connect cluster %q: %v", cluster.ClusterName, cluster.Error)
+			continue
+		}
+		for _, connector := range cluster.Connectors {
+			eg.Go(func() error {
+				topics, restErr := s.connectSvc.ListConnectorTopics(egCtx, cluster.ClusterName, connector.Name)
+				result := connectorTopics{clusterName: cluster.ClusterName, connector: connector, topics: topics.Topics}
+				if restErr != nil {
+					// Topic tracking may be disabled in the connect cluster, in this
+					// case we fall back to the topics in the connector config.
+					s.logger.DebugContext(ctx, "failed to list connector topics for lineage, falling back to connector config",
+						slog.String("cluster_name", cluster.ClusterName),
+						slog.String("connector", connector.Name),
+						slog.Any("error", restErr.Err))
+					result.topics = connectorConfigTopics(connector.Config)
+				}
+				mu.Lock()
+				results = append(results, result)
+				mu.Unlock()
+				return nil
+			})
+		}
+	}
+	_ = eg.Wait()
+
+	for _, result := range results {
+		connector := result.connector
+		id := lineageConnectorID(result.clusterName, connector.Name)
+		isSink := strings.EqualFold(connector.Type, "sink")
+		nodeType := LineageNodeTypeSourceConnector
+		if isSink {
+			nodeType = LineageNodeTypeSinkConnector
+			sinkGroups[connectorConsumerGroup(connector.Name, connector.Config)] = id
+		}
+		b.addNode(LineageNode{
+			ID:                 id,
+			Type:               nodeType,
+			Name:               connector.Name,
+			ConnectClusterName: result.clusterName,
+			State:              connector.State,
+		})
+
+		isRunning := connector.State == "RUNNING"
+		for _, topic := range result.topics {
+			topicID := b.addTopic(topic)
+			if isSink {
+				b.addEdge(topicID, id, nil, isRunning)
+			} else {
+				b.addEdge(id, topicID, nil, isRunning)
+			}
+		}
+	}
+
+	return sinkGroups
+}
+
+// collectTransformLineage adds all data transforms, if the Redpanda admin API is configured.
+func (s *Service) collectTransformLineage(ctx context.Context, b *lineageGraphBuilder) {
+	if !s.cfg.Redpanda.AdminAPI.Enabled {
+		return
+	}
+
+	adminCl, err := s.redpandaClientFactory.GetRedpandaAPIClient(ctx)
+	if err != nil {
+		b.addWarning("Failed to retrieve Redpanda admin API client for listing data transforms: %v", err)
+		return
+	}
+	transforms, err := adminCl.ListWasmTransforms(ctx)
+	if err != nil {
+		b.addWarning("Failed to list data transforms: %v", err)
+		return
+	}
+
+	for _, transform := range transforms {
+		id := lineageTransformID(transform.Name)
+		var lag int64
+		state := "running"
+		for _, status := range transform.Status {
+			lag += int64(status.Lag)
+			if status.Status != "running" {
+				state = status.Status
+			}
+		}
+		isRunning := state == "running"
+		b.addNode(LineageNode{ID: id, Type: LineageNodeTypeTransform, Name: transform.Name, State: state})
+
+		b.addEdge(b.addTopic(transform.InputTopic), id, &lag, isRunning)
+		for _, topic := range transform.OutputTopics {
+			b.addEdge(id, b.addTopic(topic), nil, isRunning)
+		}
+	}
+}
+
+func addPipelineLineage(b *lineageGraphBuilder, pipeline LineagePipeline) {
+	id := lineagePipelineID(pipeline.ID)
+	name := cmp.Or(pipeline.Name, pipeline.ID)
+	b.addNode(LineageNode{ID: id, Type: LineageNodeTypePipeline, Name: name, State: pipeline.State})
+
+	isRunning := strings.EqualFold(pipeline.State, "running")
+	for _, topic := range pipeline.InputTopics {
+		b.addEdge(b.addTopic(topic), id, nil, isRunning)
+	}
+	for _, topic := range pipeline.OutputTopics {
+		b.addEdge(id, b.addTopic(topic), nil, isRunning)
+	}
+}
+
+// connectorConfigTopics returns the topics that are set in a connector config.
+// It does not resolve topic regexes of sink connectors.
+func connectorConfigTopics(config map[string]string) []string {
+	topics := make([]string, 0)
+	for _, key := range []string{"topics", "kafka.topic", "topic"} {
+		for topic := range strings.SplitSeq(config[key], ",") {
+			if topic = strings.TrimSpace(topic); topic != "" && !slices.Contains(topics, topic) {
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// connectorConsumerGroup returns the consumer group ID that a sink connector uses.
+func connectorConsumerGroup(connectorName string, config map[string]string) string {
+	if groupID := config["consumer.override.group.id"]; groupID != "" {
+		return groupID
+	}
+	return "connect-" + connectorName
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLineageGraph returns the graph:
+//
+//	connector:c/pg-source -> topic:orders -> transform:enrich -> topic:orders-enriched -> connector:c/s3-sink
+//	                         topic:orders -> consumer-group:billing
+//	                                                             topic:orders-enriched -> pipeline:p1 -> topic:audit
+func newTestLineageGraph() *LineageGraph {
+	b := newLineageGraphBuilder()
+	source := lineageConnectorID("c", "pg-source")
+	sink := lineageConnectorID("c", "s3-sink")
+	b.addNode(LineageNode{ID: source, Type: LineageNodeTypeSourceConnector, Name: "pg-source", ConnectClusterName: "c", State: "RUNNING"})
+	b.addNode(LineageNode{ID: sink, Type: LineageNodeTypeSinkConnector, Name: "s3-sink", ConnectClusterName: "c", State: "RUNNING"})
+	b.addNode(LineageNode{ID: lineageTransformID("enrich"), Type: LineageNodeTypeTransform, Name: "enrich"})
+	b.addNode(LineageNode{ID: lineageConsumerGroupID("billing"), Type: LineageNodeTypeConsumerGroup, Name: "billing"})
+
+	orders := b.addTopic("orders")
+	recordCount := int64(100)
+	b.nodes[orders].RecordCount = &recordCount
+	enriched := b.addTopic("orders-enriched")
+	lag := int64(5)
+
+	b.addEdge(source, orders, nil, true)
+	b.addEdge(orders, lineageTransformID("enrich"), &lag, true)
+	b.addEdge(lineageTransformID("enrich"), enriched, nil, true)
+	b.addEdge(enriched, sink, nil, true)
+	b.addEdge(orders, lineageConsumerGroupID("billing"), &lag, false)
+	addPipelineLineage(b, LineagePipeline{ID: "p1", State: "running", InputTopics: []string{"orders-enriched"}, OutputTopics: []string{"audit"}})
+
+	return b.graph()
+}
+
+func lineageNodeIDs(g *LineageGraph) []string {
+	ids := make([]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[i] = node.ID
+	}
+	return ids
+}
+
+func TestLineageGraphBuilder(t *testing.T) {
+	g := newTestLineageGraph()
+
+	assert.Equal(t, []string{
+		"connector:c/pg-source",
+		"connector:c/s3-sink",
+		"consumer-group:billing",
+		"pipeline:p1",
+		"topic:audit",
+		"topic:orders",
+		"topic:orders-enriched",
+		"transform:enrich",
+	}, lineageNodeIDs(g))
+	require.Len(t, g.Edges, 7)
+
+	// Edges touching a topic carry its record count
+	for _, edge := range g.Edges {
+		if edge.Source == "topic:orders" || edge.Target == "topic:orders" {
+			assert.Equal(t, int64(100), edge.Hint.TopicRecordCount, "%v -> %v", edge.Source, edge.Target)
+		}
+	}
+	// The pipeline name defaults to its ID
+	assert.Equal(t, "p1", g.Nodes[3].Name)
+}
+
+func TestLineageGraphTraverse(t *testing.T) {
+	g := newTestLineageGraph()
+
+	tests := []struct {
+		name      string
+		nodeID    string
+		direction LineageDirection
+		depth     int
+		expected  []string
+	}{
+		{
+			name:      "downstream of topic",
+			nodeID:    "topic:orders",
+			direction: LineageDirectionDownstream,
+			expected: []string{
+				"connector:c/s3-sink",
+				"consumer-group:billing",
+				"pipeline:p1",
+				"topic:audit",
+				"topic:orders",
+				"topic:orders-enriched",
+				"transform:enrich",
+			},
+		},
+		{
+			name:      "downstream of topic with depth",
+			nodeID:    "topic:orders",
+			direction: LineageDirectionDownstream,
+			depth:     1,
+			expected:  []string{"consumer-group:billing", "topic:orders", "transform:enrich"},
+		},
+		{
+			name:      "upstream of sink connector",
+			nodeID:    "connector:c/s3-sink",
+			direction: LineageDirectionUpstream,
+			expected: []string{
+				"connector:c/pg-source",
+				"connector:c/s3-sink",
+				"topic:orders",
+				"topic:orders-enriched",
+				"transform:enrich",
+			},
+		},
+		{
+			name:      "both directions do not include siblings",
+			nodeID:    "transform:enrich",
+			direction: LineageDirectionBoth,
+			depth:     1,
+			expected:  []string{"topic:orders", "topic:orders-enriched", "transform:enrich"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, exists := g.traverse(tt.nodeID, tt.direction, tt.depth)
+			require.True(t, exists)
+			assert.Equal(t, tt.expected, lineageNodeIDs(sub))
+			for _, edge := range sub.Edges {
+				assert.Contains(t, tt.expected, edge.Source)
+				assert.Contains(t, tt.expected, edge.Target)
+			}
+		})
+	}
+
+	_, exists := g.traverse("topic:unknown", LineageDirectionBoth, 0)
+	assert.False(t, exists)
+}
+
+func TestConnectorConfigTopics(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, connectorConfigTopics(map[string]string{"topics": "a, b,,a"}))
+	assert.Equal(t, []string{"c"}, connectorConfigTopics(map[string]string{"kafka.topic": "c"}))
+	assert.Empty(t, connectorConfigTopics(map[string]string{"topics.regex": "orders-.*"}))
+}
+
+func TestConnectorConsumerGroup(t *testing.T) {
+	assert.Equal(t, "connect-s3-sink", connectorConsumerGroup("s3-sink", map[string]string{}))
+	assert.Equal(t, "custom", connectorConsumerGroup("s3-sink", map[string]string{"consumer.override.group.id": "custom"}))
+}
@@ -16,7 +16,6 @@ import (
 	"log/slog"
 	"math"
 	"runtime/debug"
-	"sync"
 	"time"
 
 	"github.com/twmb/franz-go/pkg/kadm"
@@ -841,23 +840,15 @@ func (s *Service) fetchMessages(ctx context.Context, cl *kgo.Client, progress IL
 	}
 	defer client.Close()
 
-	// 2. Create consumer workers
-	// Reduced from 100 to 20 to limit memory usage in serverless environments
-	// With large records (up to 1MB), 100 records = 1GB+ after deserialization
-	jobs := make(chan *kgo.Record, 20)
-	resultsCh := make(chan *TopicMessage, 20)
-	workerCtx, cancel := context.WithCancelCause(ctx)
-	defer cancel(errors.New("worker cancel"))
-
-	wg := sync.WaitGroup{}
-
-	// If we use more than one worker the order of messages in each partition gets lost. Hence we only use it where
-	// multiple workers are actually beneficial - for potentially high throughput stream requests.
-	workerCount := 1
-	if consumeReq.FilterInterpreterCode != "" {
-		workerCount = 6
-	}
-	for i := 0; i < workerCount; i++ {
+	// 2. Create consumer workers. Records are deserialized and filtered by multiple workers in parallel,
+	// each with its own interpreter. Every fetched record gets a result slot that is queued in fetch order,
+	// so that the messages can be passed to the progress in the same order as they have been fetched,
+	// regardless of which worker finishes first. The queue is bounded, hence fetching pauses once
+	// deserialization or the progress can't keep up.
+	searchCfg := s.cfg.Console.MessageSearch
+	workerCount := searchCfg.WorkerCount()
+	interpreters := make([]isMessageOkFunc, workerCount)
+	for i := range interpreters {
 		// Setup JavaScript interpreter
 		isMessageOK, err := s.setupInterpreter(consumeReq.FilterInterpreterCode)
 		if err != nil {
@@ -865,20 +856,21 @@ func (s *Service) fetchMessages(ctx context.Context, cl *kgo.Client, progress IL
 			progress.OnError(fmt.Sprintf("failed to setup interpreter: %v", err.Error()))
 			return err
 		}
+		interpreters[i] = isMessageOK
+	}
 
-		wg.Add(1)
-		go s.startMessageWorker(workerCtx, &wg, isMessageOK, jobs, resultsCh,
-			consumeReq)
+	jobs := make(chan messageJob, workerCount)
+	pending := make(chan chan *TopicMessage, searchCfg.BufferSize(workerCount))
+	workerCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(errors.New("worker cancel"))
+
+	for _, isMessageOK := range interpreters {
+		go s.startMessageWorker(workerCtx, isMessageOK, jobs, consumeReq)
 	}
-	// Close the results channel once all workers have finished processing jobs and therefore no senders are left anymore
-	go func() {
-		wg.Wait()
-		close(resultsCh)
-	}()
 
 	// 3. Start go routine that consumes messages from Kafka and produces these records on the jobs channel so that these
 	// can be decoded by our workers.
-	go s.consumeKafkaMessages(workerCtx, client, consumeReq, jobs)
+	go s.consumeKafkaMessages(workerCtx, client, consumeReq, jobs, pending)
 
 	// 4. Receive decoded messages until our request is satisfied. Once that's the case we will cancel the context
 	// that propagate to all the launched go routines.
@@ -888,7 +880,15 @@ func (s *Service) fetchMessages(ctx context.Context, cl *kgo.Client, progress IL
 	// For descending order, we need to collect messages per partition and reverse them
 	messagesPerPartition := make(map[int32][]*TopicMessage)
 
-	for msg := range resultsCh {
+receiveLoop:
+	for result := range pending {
+		var msg *TopicMessage
+		select {
+		case <-workerCtx.Done():
+			break receiveLoop
+		case msg = <-result:
+		}
+
 		// Since a 'kafka message' is likely transmitted in compressed batches this size is not really accurate
 		progress.OnMessageConsumed(msg.MessageSize)
 		partitionReq := consumeReq.Partitions[msg.PartitionID]
@@ -968,108 +968,119 @@ func (*Service) requestOffsetsByTimestamp(ctx context.Context, cl *kgo.Client, t
 	return offsetByPartition, nil
 }
 
-func (s *Service) startMessageWorker(ctx context.Context, wg *sync.WaitGroup,
-	isMessageOK isMessageOkFunc, jobs <-chan *kgo.Record, resultsCh chan<- *TopicMessage,
-	consumeReq TopicConsumeRequest,
-) {
-	defer wg.Done()
+// messageJob is a fetched record along with the slot that receives the decoded message.
+type messageJob struct {
+	record *kgo.Record
+	result chan<- *TopicMessage
+}
+
+// startMessageWorker decodes records from the jobs channel until it is closed. The result slot
+// of each job is buffered, so that workers never wait for the receiver.
+func (s *Service) startMessageWorker(ctx context.Context, isMessageOK isMessageOkFunc, jobs <-chan messageJob, consumeReq TopicConsumeRequest) {
+	for job := range jobs {
+		job.result <- s.decodeMessage(ctx, isMessageOK, job.record, consumeReq)
+	}
+}
+
+// decodeMessage deserializes the record and checks whether it passes the filter code.
+func (s *Service) decodeMessage(ctx context.Context, isMessageOK isMessageOkFunc, record *kgo.Record, consumeReq TopicConsumeRequest) (topicMessage *TopicMessage) {
 	defer func() {
 		if r := recover(); r != nil {
 			s.logger.ErrorContext(ctx, "recovered from panic in message worker",
 				slog.Any("error", r),
 				slog.String("stack_trace", string(debug.Stack())),
 				slog.String("topic", consumeReq.TopicName))
+			// The message must still be returned, because the receiver waits for it
+			topicMessage = &TopicMessage{
+				PartitionID:  record.Partition,
+				Offset:       record.Offset,
+				Timestamp:    record.Timestamp.UnixNano() / int64(time.Millisecond),
+				IsMessageOk:  false,
+				ErrorMessage: fmt.Sprintf("Failed to decode message (partition: '%v', offset: '%v')", record.Partition, record.Offset),
+				MessageSize:  int64(len(record.Key) + len(record.Value)),
+			}
 		}
 	}()
 
-	for record := range jobs {
-		// We consume control records because the last message in a partition we expect might be a control record.
-		// We need to acknowledge that we received the message but it is ineligible to be sent to the frontend.
-		// Quit early if it is a control record!
-		isControlRecord := record.Attrs.IsControl()
-		if isControlRecord {
-			topicMessage := &TopicMessage{
-				PartitionID: record.Partition,
-				Offset:      record.Offset,
-				Timestamp:   record.Timestamp.UnixNano() / int64(time.Millisecond),
-				IsMessageOk: false,
-				MessageSize: int64(len(record.Key) + len(record.Value)),
-			}
-
-			select {
-			case <-ctx.Done():
-				return
-			case resultsCh <- topicMessage:
-				continue
-			}
+	// We consume control records because the last message in a partition we expect might be a control record.
+	// We need to acknowledge that we received the message but it is ineligible to be sent to the frontend.
+	// Quit early if it is a control record!
+	isControlRecord := record.Attrs.IsControl()
+	if isControlRecord {
+		return &TopicMessage{
+			PartitionID: record.Partition,
+			Offset:      record.Offset,
+			Timestamp:   record.Timestamp.UnixNano() / int64(time.Millisecond),
+			IsMessageOk: false,
+			MessageSize: int64(len(record.Key) + len(record.Value)),
 		}
+	}
 
-		// Run Interpreter filter and check if message passes the filter
-		deserializedRec := s.serdeSvc.DeserializeRecord(
-			ctx,
-			record,
-			serde.DeserializationOptions{
-				MaxPayloadSize:     s.cfg.Serde.MaxDeserializationPayloadSize,
-				Troubleshoot:       consumeReq.Troubleshoot,
-				IncludeRawData:     consumeReq.IncludeRawPayload,
-				IgnoreMaxSizeLimit: consumeReq.IgnoreMaxSizeLimit,
-				KeyEncoding:        consumeReq.KeyDeserializer,
-				ValueEncoding:      consumeReq.ValueDeserializer,
-			})
-
-		headersByKey := make(map[string][]byte, len(deserializedRec.Headers))
-		headers := make([]MessageHeader, 0)
-		for _, header := range deserializedRec.Headers {
-			headersByKey[header.Key] = header.Value
-			headers = append(headers, MessageHeader(header))
-		}
+	// Run Interpreter filter and check if message passes the filter
+	deserializedRec := s.serdeSvc.DeserializeRecord(
+		ctx,
+		record,
+		serde.DeserializationOptions{
+			MaxPayloadSize:     s.cfg.Serde.MaxDeserializationPayloadSize,
+			Troubleshoot:       consumeReq.Troubleshoot,
+			IncludeRawData:     consumeReq.IncludeRawPayload,
+			IgnoreMaxSizeLimit: consumeReq.IgnoreMaxSizeLimit,
+			KeyEncoding:        consumeReq.KeyDeserializer,
+			ValueEncoding:      consumeReq.ValueDeserializer,
+		})
 
-		// Check if message passes filter code
-		args := interpreterArguments{
-			PartitionID:   record.Partition,
-			Offset:        record.Offset,
-			Timestamp:     record.Timestamp,
-			Key:           deserializedRec.Key.DeserializedPayload,
-			Value:         deserializedRec.Value.DeserializedPayload,
-			HeadersByKey:  headersByKey,
-			KeySchemaID:   deserializedRec.Key.SchemaID,
-			ValueSchemaID: deserializedRec.Value.SchemaID,
-		}
+	headersByKey := make(map[string][]byte, len(deserializedRec.Headers))
+	headers := make([]MessageHeader, 0)
+	for _, header := range deserializedRec.Headers {
+		headersByKey[header.Key] = header.Value
+		headers = append(headers, MessageHeader(header))
+	}
 
-		isOK, err := isMessageOK(args)
-		var errMessage string
-		if err != nil {
-			s.logger.DebugContext(ctx, "failed to check if message is ok", slog.Any("error", err))
-			errMessage = fmt.Sprintf("Failed to check if message is ok (partition: '%v', offset: '%v'). Err: %v", record.Partition, record.Offset, err)
-		}
+	// Check if message passes filter code
+	args := interpreterArguments{
+		PartitionID:   record.Partition,
+		Offset:        record.Offset,
+		Timestamp:     record.Timestamp,
+		Key:           deserializedRec.Key.DeserializedPayload,
+		Value:         deserializedRec.Value.DeserializedPayload,
+		HeadersByKey:  headersByKey,
+		KeySchemaID:   deserializedRec.Key.SchemaID,
+		ValueSchemaID: deserializedRec.Value.SchemaID,
+
+		KeySchemaValidation:   deserializedRec.Key.SchemaValidation,
+		ValueSchemaValidation: deserializedRec.Value.SchemaValidation,
+	}
 
-		topicMessage := &TopicMessage{
-			PartitionID:     record.Partition,
-			Offset:          record.Offset,
-			Timestamp:       record.Timestamp.UnixNano() / int64(time.Millisecond),
-			Headers:         headers,
-			Compression:     compressionTypeDisplayname(record.Attrs.CompressionType()),
-			IsTransactional: record.Attrs.IsTransactional(),
-			Key:             deserializedRec.Key,
-			Value:           deserializedRec.Value,
-			IsMessageOk:     isOK,
-			ErrorMessage:    errMessage,
-			MessageSize:     int64(len(record.Key) + len(record.Value)),
-		}
+	isOK, err := isMessageOK(args)
+	var errMessage string
+	if err != nil {
+		s.logger.DebugContext(ctx, "failed to check if message is ok", slog.Any("error", err))
+		errMessage = fmt.Sprintf("Failed to check if message is ok (partition: '%v', offset: '%v'). Err: %v", record.Partition, record.Offset, err)
+	}
 
-		select {
-		case <-ctx.Done():
-			return
-		case resultsCh <- topicMessage:
-		}
+	return &TopicMessage{
+		PartitionID:     record.Partition,
+		Offset:          record.Offset,
+		Timestamp:       record.Timestamp.UnixNano() / int64(time.Millisecond),
+		Headers:         headers,
+		Compression:     compressionTypeDisplayname(record.Attrs.CompressionType()),
+		IsTransactional: record.Attrs.IsTransactional(),
+		Key:             deserializedRec.Key,
+		Value:           deserializedRec.Value,
+		IsMessageOk:     isOK,
+		ErrorMessage:    errMessage,
+		MessageSize:     int64(len(record.Key) + len(record.Value)),
 	}
 }
 
-// consumeKafkaMessages consumes messages for the consume request and sends responses to the jobs channel.
-// This function will close the channel.
+// consumeKafkaMessages consumes messages for the consume request and sends them to the jobs channel. The
+// result slot of each job is sent to the pending channel beforehand, so that the pending channel has the
+// same order as the fetched records. Sending blocks once the pending channel is full.
+// This function will close both channels.
 // The caller is responsible for closing the client if desired.
-func (s *Service) consumeKafkaMessages(ctx context.Context, client *kgo.Client, consumeReq TopicConsumeRequest, jobs chan<- *kgo.Record) {
+func (s *Service) consumeKafkaMessages(ctx context.Context, client *kgo.Client, consumeReq TopicConsumeRequest, jobs chan<- messageJob, pending chan<- chan *TopicMessage) {
 	defer close(jobs)
+	defer close(pending)
 
 	// Track which partitions have finished reading
 	finishedPartitions := make(map[int32]bool)
@@ -1103,11 +1114,17 @@ func (s *Service) consumeKafkaMessages(ctx context.Context, client *kgo.Client,
 					continue
 				}
 
-				// Avoid a deadlock in case the jobs channel is full
+				// Avoid a deadlock in case the pending or jobs channel is full
+				result := make(chan *TopicMessage, 1)
+				select {
+				case <-ctx.Done():
+					return
+				case pending <- result:
+				}
 				select {
 				case <-ctx.Done():
 					return
-				case jobs <- record:
+				case jobs <- messageJob{record: record, result: result}:
 				}
 
 				partitionReq := consumeReq.Partitions[record.Partition]
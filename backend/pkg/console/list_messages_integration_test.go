@@ -819,7 +819,7 @@ func createNewTestServiceWithKafkaFactory(t *testing.T, log *slog.Logger,
 	schemaFactory, _ := schema.NewSingleClientProvider(&cfg)
 	cacheFn := func(ctx context.Context) (string, error) { return "single/", nil }
 
-	svc, err := NewService(&cfg, log, kafkaFactory, schemaFactory, nil, cacheFn, nil)
+	svc, err := NewService(&cfg, log, kafkaFactory, schemaFactory, nil, cacheFn, nil, nil)
 	require.NoError(t, err)
 
 	err = svc.Start(t.Context())
@@ -10,12 +10,20 @@
 package console
 
 import (
+	"fmt"
+	"log/slog"
 	"math"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/serde"
 )
 
 func TestCalculateConsumeRequests_AllPartitions_FewNewestMessages(t *testing.T) {
@@ -231,3 +239,94 @@ func TestCalculateConsumeRequests_AllPartitions_WithFilter(t *testing.T) {
 		assert.Equal(t, table.expected, actual, "expected other result for all partitions with filter enable. Case: ", i)
 	}
 }
+
+// collectingProgress records all messages that are passed to it.
+type collectingProgress struct {
+	mu       sync.Mutex
+	messages []*TopicMessage
+}
+
+func (*collectingProgress) OnPhase(string)                 {}
+func (*collectingProgress) OnMessageConsumed(int64)        {}
+func (*collectingProgress) OnComplete(int64, bool, string) {}
+func (*collectingProgress) OnError(string)                 {}
+func (p *collectingProgress) OnMessage(message *TopicMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, message)
+}
+
+func TestFetchMessages_ParallelWorkersPreserveOrder(t *testing.T) {
+	const (
+		topicName           = "test"
+		recordsPerPartition = 200
+	)
+
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(3, topicName))
+	require.NoError(t, err)
+	t.Cleanup(fakeCluster.Close)
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...), kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+
+	for partition := range int32(3) {
+		records := make([]*kgo.Record, recordsPerPartition)
+		for i := range records {
+			records[i] = &kgo.Record{Topic: topicName, Partition: partition, Value: fmt.Appendf(nil, `{"id":%d}`, i)}
+		}
+		require.NoError(t, cl.ProduceSync(t.Context(), records...).FirstErr())
+	}
+
+	cfg := &config.Config{}
+	cfg.SetDefaults()
+	cfg.Console.MessageSearch.MaxWorkers = 4
+	cfg.Console.MessageSearch.MaxBufferedMessages = 8
+	svc := &Service{
+		cfg:      cfg,
+		logger:   slog.New(slog.DiscardHandler),
+		serdeSvc: &serde.Service{SerDes: []serde.Serde{serde.NullSerde{}, serde.JSONSerde{}}},
+	}
+
+	tt := []struct {
+		name             string
+		filterCode       string
+		maxMessageCount  int64
+		expectedMessages int
+	}{
+		{name: "all records", maxMessageCount: recordsPerPartition, expectedMessages: 3 * recordsPerPartition},
+		{name: "filtered records", filterCode: "return value.id % 3 === 0", maxMessageCount: 10, expectedMessages: 30},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			partitions := make(map[int32]*PartitionConsumeRequest)
+			for partition := range int32(3) {
+				partitions[partition] = &PartitionConsumeRequest{
+					PartitionID:     partition,
+					HighWaterMark:   recordsPerPartition,
+					StartOffset:     0,
+					EndOffset:       recordsPerPartition - 1,
+					MaxMessageCount: tc.maxMessageCount,
+				}
+			}
+
+			progress := &collectingProgress{}
+			err := svc.fetchMessages(t.Context(), cl, progress, TopicConsumeRequest{
+				TopicName:             topicName,
+				MaxMessageCount:       tc.expectedMessages,
+				Partitions:            partitions,
+				FilterInterpreterCode: tc.filterCode,
+				Direction:             directionAscending,
+			})
+			require.NoError(t, err)
+			require.Len(t, progress.messages, tc.expectedMessages)
+
+			lastOffsetByPartition := map[int32]int64{0: -1, 1: -1, 2: -1}
+			for _, msg := range progress.messages {
+				assert.Greater(t, msg.Offset, lastOffsetByPartition[msg.PartitionID], "messages of partition %d are out of order", msg.PartitionID)
+				lastOffsetByPartition[msg.PartitionID] = msg.Offset
+				assert.Equal(t, serde.PayloadEncodingJSON, msg.Value.Encoding)
+			}
+		})
+	}
+}
@@ -90,12 +90,24 @@ type ProduceRecordsResponse struct {
 // produced the user can opt in for using transactions so that either none or
 // all records will be produced successfully.
 func (s *Service) ProducePlainRecords(ctx context.Context, records []*kgo.Record, useTransactions bool, compressionOpts []kgo.CompressionCodec) ProduceRecordsResponse {
-	cl, _, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	client, err := s.newProducerClient(ctx, useTransactions, compressionOpts)
 	if err != nil {
 		return ProduceRecordsResponse{
 			Error: err.Error(),
 		}
 	}
+	defer client.Close()
+
+	return produceRecordsWithClient(ctx, client, records, useTransactions)
+}
+
+// newProducerClient creates a Kafka client for producing records that respects
+// the partition IDs of the records. The caller must close the client.
+func (s *Service) newProducerClient(ctx context.Context, useTransactions bool, compressionOpts []kgo.CompressionCodec) (*kgo.Client, error) {
+	cl, _, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	additionalKgoOpts := []kgo.Opt{
 		kgo.ProducerBatchCompression(compressionOpts...),
@@ -120,12 +132,15 @@ func (s *Service) ProducePlainRecords(ctx context.Context, records []*kgo.Record
 	opts := slices.Concat(cl.Opts(), additionalKgoOpts)
 	client, err := kgo.NewClient(opts...)
 	if err != nil {
-		return ProduceRecordsResponse{
-			Error: fmt.Errorf("failed to create new kafka client: %w", err).Error(),
-		}
+		return nil, fmt.Errorf("failed to create new kafka client: %w", err)
 	}
-	defer client.Close()
+	return client, nil
+}
 
+// produceRecordsWithClient produces the records with the given producer client
+// and waits until all of them have been acknowledged. If useTransactions is set,
+// the client must have been created with a transactional ID.
+func produceRecordsWithClient(ctx context.Context, client *kgo.Client, records []*kgo.Record, useTransactions bool) ProduceRecordsResponse {
 	if useTransactions {
 		// In case of transactions we do not want to risk a context cancellation, as this would not allow us
 		// to guarantee exactly once semantics!
@@ -152,7 +167,7 @@ func (s *Service) ProducePlainRecords(ctx context.Context, records []*kgo.Record
 	}
 
 	// client.Flush() will block until all produce() functions have returned
-	err = client.Flush(ctx)
+	err := client.Flush(ctx)
 	if err != nil {
 		return ProduceRecordsResponse{
 			Records: nil,
@@ -17,6 +17,7 @@ import (
 	"github.com/dop251/goja"
 
 	"github.com/redpanda-data/console/backend/pkg/interpreter"
+	"github.com/redpanda-data/console/backend/pkg/serde"
 )
 
 type interpreterArguments struct {
@@ -28,6 +29,9 @@ type interpreterArguments struct {
 	HeadersByKey  map[string][]byte
 	KeySchemaID   *uint32
 	ValueSchemaID *uint32
+
+	KeySchemaValidation   *serde.SchemaValidation
+	ValueSchemaValidation *serde.SchemaValidation
 }
 
 type isMessageOkFunc = func(args interpreterArguments) (bool, error)
@@ -97,6 +101,11 @@ func (*Service) setupInterpreter(interpreterCode string) (isMessageOkFunc, error
 			vm.Set("valueSchemaID", *args.ValueSchemaID)
 		}
 
+		// Validation results are reset for every message, so that filters like
+		// "return valueIsValid === false" only match validated payloads.
+		setSchemaValidation(vm, "key", args.KeySchemaValidation)
+		setSchemaValidation(vm, "value", args.ValueSchemaValidation)
+
 		isOkRes, err := vm.RunString("isMessageOk()")
 		if err != nil {
 			return false, fmt.Errorf("failed to evaluate javascript code: %w", err)
@@ -107,3 +116,16 @@ func (*Service) setupInterpreter(interpreterCode string) (isMessageOkFunc, error
 
 	return isMessageOk, nil
 }
+
+// setSchemaValidation exposes the schema validation result of the key or value
+// as <prefix>IsValid and <prefix>ValidationErrors. Both are null if the payload
+// has not been validated.
+func setSchemaValidation(vm *goja.Runtime, prefix string, validation *serde.SchemaValidation) {
+	if validation == nil {
+		vm.Set(prefix+"IsValid", goja.Null())
+		vm.Set(prefix+"ValidationErrors", goja.Null())
+		return
+	}
+	vm.Set(prefix+"IsValid", validation.Valid)
+	vm.Set(prefix+"ValidationErrors", validation.Errors)
+}
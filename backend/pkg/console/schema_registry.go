@@ -27,6 +27,8 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/redpanda-data/console/backend/pkg/proto"
+	schemacache "github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/schema/lint"
 )
 
 // SchemaRegistryMode returns the schema registry mode.
@@ -788,20 +790,35 @@ func (s *Service) GetSchemaRegistrySchemaTypes(ctx context.Context) (*SchemaRegi
 // CreateSchemaRequestParams contains optional parameters for schema creation.
 type CreateSchemaRequestParams struct {
 	Normalize bool `json:"normalize"`
+	// OverrideLintErrors registers the schema even if it violates lint rules
+	// with severity ERROR.
+	OverrideLintErrors bool `json:"overrideLintErrors"`
 }
 
 // CreateSchemaResponse is the response to creating a new schema.
 type CreateSchemaResponse struct {
 	ID int `json:"id"`
+	// LintViolations are the warnings and overridden errors of the schema lint.
+	LintViolations []lint.Violation `json:"lintViolations,omitempty"`
 }
 
 // CreateSchemaRegistrySchema registers a new schema for the given subject in the schema registry.
+// If schema linting is enabled, a *SchemaLintError is returned when the schema violates rules
+// with severity ERROR, unless these are overridden.
 func (s *Service) CreateSchemaRegistrySchema(ctx context.Context, subjectName string, schema sr.Schema, params CreateSchemaRequestParams) (*CreateSchemaResponse, error) {
 	srClient, err := s.schemaClientFactory.GetSchemaRegistryClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	violations, err := s.lintSchema(ctx, srClient, subjectName, schema)
+	if err != nil {
+		return nil, err
+	}
+	if lint.HasErrors(violations) && !params.OverrideLintErrors {
+		return nil, &SchemaLintError{Violations: violations}
+	}
+
 	// Add normalize query parameter if requested
 	if params.Normalize {
 		ctx = sr.WithParams(ctx, sr.Normalize)
@@ -822,19 +839,22 @@ func (s *Service) CreateSchemaRegistrySchema(ctx context.Context, subjectName st
 			if err != nil {
 				return nil, err
 			}
-			return &CreateSchemaResponse{ID: schemaID}, nil
+			return &CreateSchemaResponse{ID: schemaID, LintViolations: violations}, nil
 		}
 		return nil, err
 	}
 
-	return &CreateSchemaResponse{ID: schemaID}, nil
+	return &CreateSchemaResponse{ID: schemaID, LintViolations: violations}, nil
 }
 
 // SchemaRegistrySchemaValidation is the response to a schema validation.
 type SchemaRegistrySchemaValidation struct {
 	Compatibility SchemaRegistrySchemaValidationCompatibility `json:"compatibility"`
 	ParsingError  string                                      `json:"parsingError,omitempty"`
-	IsValid       bool                                        `json:"isValid"`
+	// ProtoImports lists the imports of a valid Protobuf schema along with
+	// the source that resolved each of them.
+	ProtoImports []schemacache.ProtoImport `json:"protoImports,omitempty"`
+	IsValid      bool                      `json:"isValid"`
 }
 
 // SchemaRegistrySchemaValidationCompatibility is the response to the compatibility check
@@ -922,6 +942,7 @@ func (s *Service) ValidateSchemaRegistrySchema(
 	}
 
 	var parsingErr string
+	var protoImports []schemacache.ProtoImport
 	switch sch.Type {
 	case sr.TypeAvro:
 		if _, err := s.cachedSchemaClient.ParseAvroSchemaWithReferences(ctx, sch); err != nil {
@@ -932,9 +953,11 @@ func (s *Service) ValidateSchemaRegistrySchema(
 			parsingErr = err.Error()
 		}
 	case sr.TypeProtobuf:
-		if _, err := s.cachedSchemaClient.CompileProtoSchemaWithReferences(ctx, sch, make(map[string]string)); err != nil {
+		_, imports, err := s.cachedSchemaClient.CompileProtoSchemaWithImports(ctx, sch, make(map[string]string))
+		if err != nil {
 			parsingErr = err.Error()
 		}
+		protoImports = imports
 	}
 
 	return &SchemaRegistrySchemaValidation{
@@ -943,6 +966,7 @@ func (s *Service) ValidateSchemaRegistrySchema(
 			Error:        compatErr,
 		},
 		ParsingError: parsingErr,
+		ProtoImports: protoImports,
 		IsValid:      parsingErr == "" && isCompatible,
 	}, nil
 }
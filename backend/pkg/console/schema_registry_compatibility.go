@@ -0,0 +1,103 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/schema/compatibility"
+)
+
+// CheckSchemaRegistrySchemaCompatibility checks the given schema against all registered
+// versions of the subject and returns every breaking change. Unlike
+// ValidateSchemaRegistrySchema the check is performed by Console, hence it only reads
+// from the schema registry and works in READONLY mode as well. If no compatibility
+// level is given, the subject's effective compatibility level is used.
+func (s *Service) CheckSchemaRegistrySchemaCompatibility(
+	ctx context.Context,
+	subjectName string,
+	sch sr.Schema,
+	level *sr.CompatibilityLevel,
+) (*compatibility.Result, error) {
+	srClient, err := s.schemaClientFactory.GetSchemaRegistryClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if level == nil {
+		res := srClient.Compatibility(sr.WithParams(ctx, sr.DefaultToGlobal), subjectName)[0]
+		if res.Err != nil {
+			return nil, fmt.Errorf("failed to get compatibility level of subject %q: %w", subjectName, res.Err)
+		}
+		level = &res.Level
+	}
+
+	proposed, err := s.parseSchemaForComparison(ctx, sch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	subjectSchemas, err := srClient.Schemas(ctx, subjectName)
+	if err != nil {
+		// New subjects don't have any versions the schema can be incompatible with
+		var schemaErr *sr.ResponseError
+		if !errors.As(err, &schemaErr) || schemaErr.ErrorCode != 40401 {
+			return nil, fmt.Errorf("failed to retrieve versions of subject %q: %w", subjectName, err)
+		}
+	}
+
+	existing := make([]compatibility.VersionedSchema, 0, len(subjectSchemas))
+	for _, subjectSchema := range subjectSchemas {
+		parsed, err := s.parseSchemaForComparison(ctx, subjectSchema.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse version %d of subject %q: %w", subjectSchema.Version, subjectName, err)
+		}
+		existing = append(existing, compatibility.VersionedSchema{Version: subjectSchema.Version, Schema: parsed})
+	}
+
+	return compatibility.Check(*level, proposed, existing)
+}
+
+// parseSchemaForComparison parses the schema along with its references into the
+// representation that is used by the compatibility checker and the schema diff.
+func (s *Service) parseSchemaForComparison(ctx context.Context, sch sr.Schema) (compatibility.Schema, error) {
+	switch sch.Type {
+	case sr.TypeAvro:
+		parsed, err := s.cachedSchemaClient.ParseAvroSchemaWithReferences(ctx, sch)
+		if err != nil {
+			return compatibility.Schema{}, err
+		}
+		return compatibility.Schema{Avro: parsed}, nil
+	case sr.TypeProtobuf:
+		files, err := s.cachedSchemaClient.CompileProtoSchemaWithReferences(ctx, sch, make(map[string]string))
+		if err != nil {
+			return compatibility.Schema{}, err
+		}
+		// The schema itself is the first file, the others are its imports
+		return compatibility.Schema{Protobuf: files[0]}, nil
+	case sr.TypeJSON:
+		// Compile the schema to validate it, but compare the documents
+		if _, err := s.cachedSchemaClient.ParseJSONSchema(ctx, sch); err != nil {
+			return compatibility.Schema{}, err
+		}
+		var document any
+		if err := json.Unmarshal([]byte(sch.Schema), &document); err != nil {
+			return compatibility.Schema{}, err
+		}
+		return compatibility.Schema{JSON: document}, nil
+	default:
+		return compatibility.Schema{}, fmt.Errorf("unsupported schema type %v", sch.Type)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/schema/diff"
+)
+
+// SchemaRegistrySchemaDiff is the difference between two schema versions, which
+// may belong to different subjects.
+type SchemaRegistrySchemaDiff struct {
+	From       SchemaVersion `json:"from"`
+	To         SchemaVersion `json:"to"`
+	SchemaType sr.SchemaType `json:"schemaType"`
+	Changes    []diff.Change `json:"changes"`
+	// UnifiedDiff is a unified text diff of both schemas. It's empty if the
+	// schemas are identical.
+	UnifiedDiff string `json:"unifiedDiff"`
+	// ParsingError is set if one of the schemas could not be parsed. The
+	// changes are empty in this case, but the unified diff is still returned.
+	ParsingError string `json:"parsingError,omitempty"`
+}
+
+// DiffSchemaRegistrySchemas compares two schema versions semantically and returns
+// the added, removed and changed fields, types and enum symbols along with a
+// unified text diff. You can use -1 as version to compare the latest version.
+// Soft-deleted versions can be compared as well.
+func (s *Service) DiffSchemaRegistrySchemas(
+	ctx context.Context,
+	fromSubject string,
+	fromVersion int,
+	toSubject string,
+	toVersion int,
+) (*SchemaRegistrySchemaDiff, error) {
+	srClient, err := s.schemaClientFactory.GetSchemaRegistryClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := srClient.SchemaByVersion(sr.WithParams(ctx, sr.ShowDeleted), fromSubject, fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve version %d of subject %q: %w", fromVersion, fromSubject, err)
+	}
+	to, err := srClient.SchemaByVersion(sr.WithParams(ctx, sr.ShowDeleted), toSubject, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve version %d of subject %q: %w", toVersion, toSubject, err)
+	}
+
+	unified, err := diff.Unified(
+		fmt.Sprintf("%s v%d", from.Subject, from.Version), from.Schema.Schema,
+		fmt.Sprintf("%s v%d", to.Subject, to.Version), to.Schema.Schema,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create unified diff: %w", err)
+	}
+	result := &SchemaRegistrySchemaDiff{
+		From:        SchemaVersion{Subject: from.Subject, Version: from.Version},
+		To:          SchemaVersion{Subject: to.Subject, Version: to.Version},
+		SchemaType:  to.Type,
+		Changes:     make([]diff.Change, 0),
+		UnifiedDiff: unified,
+	}
+
+	if from.Type != to.Type {
+		result.Changes = append(result.Changes, diff.Change{
+			Type: diff.ChangeTypeTypeChanged,
+			Old:  from.Type.String(),
+			New:  to.Type.String(),
+		})
+		return result, nil
+	}
+
+	fromSchema, err := s.parseSchemaForComparison(ctx, from.Schema)
+	if err != nil {
+		result.ParsingError = fmt.Sprintf("failed to parse version %d of subject %q: %v", from.Version, from.Subject, err)
+		return result, nil
+	}
+	toSchema, err := s.parseSchemaForComparison(ctx, to.Schema)
+	if err != nil {
+		result.ParsingError = fmt.Sprintf("failed to parse version %d of subject %q: %v", to.Version, to.Subject, err)
+		return result, nil
+	}
+
+	switch to.Type {
+	case sr.TypeAvro:
+		result.Changes = diff.Avro(fromSchema.Avro, toSchema.Avro)
+	case sr.TypeProtobuf:
+		result.Changes = diff.Protobuf(fromSchema.Protobuf, toSchema.Protobuf)
+	case sr.TypeJSON:
+		result.Changes = diff.JSONSchema(fromSchema.JSON, toSchema.JSON)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/redpanda-data/common-go/rpsr"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/schema/compatibility"
+	"github.com/redpanda-data/console/backend/pkg/schema/lint"
+)
+
+// SchemaLintError is returned when a schema violates lint rules with severity
+// ERROR and the violations have not been overridden.
+type SchemaLintError struct {
+	Violations []lint.Violation
+}
+
+func (e *SchemaLintError) Error() string {
+	errCount := 0
+	for _, v := range e.Violations {
+		if v.Severity == lint.SeverityError {
+			errCount++
+		}
+	}
+	return fmt.Sprintf("schema violates %d lint rule(s)", errCount)
+}
+
+// lintSchema checks the schema against the configured lint rules. It returns
+// no violations if linting is disabled or if the schema can't be parsed, in
+// which case the schema registry reports the parse error on registration.
+func (s *Service) lintSchema(ctx context.Context, srClient *rpsr.Client, subjectName string, schema sr.Schema) ([]lint.Violation, error) {
+	if s.schemaLinter == nil {
+		return nil, nil
+	}
+
+	parsed, err := s.parseSchemaForComparison(ctx, schema)
+	if err != nil {
+		s.logger.DebugContext(ctx, "skipping schema lint because the schema could not be parsed",
+			slog.String("subject", subjectName), slog.Any("error", err))
+		return nil, nil
+	}
+
+	var previous *compatibility.Schema
+	if parsed.Protobuf != nil && s.schemaLinter.RequiresPrevious(subjectName) {
+		latest, err := srClient.SchemaByVersion(ctx, subjectName, -1)
+		if err != nil {
+			var schemaErr *sr.ResponseError
+			if !errors.As(err, &schemaErr) || schemaErr.ErrorCode != 40401 {
+				return nil, fmt.Errorf("failed to retrieve latest version of subject %q: %w", subjectName, err)
+			}
+		} else if latest.Type == sr.TypeProtobuf {
+			parsedLatest, err := s.parseSchemaForComparison(ctx, latest.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse latest version of subject %q: %w", subjectName, err)
+			}
+			previous = &parsedLatest
+		}
+	}
+
+	return s.schemaLinter.Lint(subjectName, parsed, previous), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/redpanda-data/console/backend/pkg/bsr"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/proto"
+	schemacache "github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// newProtoImportSources creates the sources for imports of registered Protobuf
+// schemas in the configured order. Sources whose backing service is not
+// configured are skipped.
+func newProtoImportSources(cfg config.SchemaProtobufImports, protoSvc *proto.Service, bsrClient *bsr.Client) ([]schemacache.ProtoImportSource, error) {
+	sources := make([]schemacache.ProtoImportSource, 0, 3)
+	for _, name := range cfg.SourcesOrDefault() {
+		switch name {
+		case config.ProtobufImportSourceEmbedded:
+			source, err := schemacache.EmbeddedProtoImportSource(context.Background(), name)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, source)
+		case config.ProtobufImportSourceProtobuf:
+			if protoSvc == nil {
+				continue
+			}
+			sources = append(sources, schemacache.ProtoImportSource{
+				Name: name,
+				FindFileByPath: func(_ context.Context, path string) (protocompile.SearchResult, error) {
+					content, ok := protoSvc.ProtoFileSource(path)
+					if !ok {
+						return protocompile.SearchResult{}, protoregistry.NotFound
+					}
+					return protocompile.SearchResult{Source: strings.NewReader(content)}, nil
+				},
+			})
+		case config.ProtobufImportSourceBSR:
+			if bsrClient == nil || len(cfg.BSRModules) == 0 {
+				continue
+			}
+			sources = append(sources, schemacache.ProtoImportSource{
+				Name: name,
+				FindFileByPath: func(ctx context.Context, path string) (protocompile.SearchResult, error) {
+					var errs []error
+					for _, module := range cfg.BSRModules {
+						desc, err := bsrClient.GetModuleFile(ctx, module, path)
+						if err == nil {
+							return protocompile.SearchResult{Desc: desc}, nil
+						}
+						errs = append(errs, err)
+					}
+					return protocompile.SearchResult{}, errors.Join(errs...)
+				},
+			})
+		default:
+			return nil, fmt.Errorf("unknown protobuf import source %q", name)
+		}
+	}
+	return sources, nil
+}
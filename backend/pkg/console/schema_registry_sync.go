@@ -0,0 +1,659 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/redpanda-data/common-go/rpsr"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	schemafactory "github.com/redpanda-data/console/backend/pkg/factory/schema"
+)
+
+// Actions that a schema sync takes for a single subject version.
+const (
+	// SchemaSyncActionCreate is used for versions that do not exist in the
+	// target yet. They are registered with the same schema ID and version
+	// as in the source.
+	SchemaSyncActionCreate = "CREATE"
+	// SchemaSyncActionExists is used for versions that already exist in the
+	// target with the same schema ID. They are skipped, which allows resuming
+	// an interrupted sync by running it again.
+	SchemaSyncActionExists = "EXISTS"
+	// SchemaSyncActionConflict is used for versions that exist in the target
+	// with a different schema ID. They are never overwritten.
+	SchemaSyncActionConflict = "CONFLICT"
+)
+
+// SchemaRegistrySyncEndpoint identifies the source or target of a schema sync.
+type SchemaRegistrySyncEndpoint struct {
+	// Registry is the name of a registry in schemaRegistry.sync.registries.
+	// An empty name refers to the schema registry that is configured in
+	// schemaRegistry.
+	Registry string `json:"registry"`
+	// Context is the schema registry context. An empty context refers to
+	// the default context.
+	Context string `json:"context"`
+}
+
+// SchemaRegistrySyncRequest describes which subjects are copied from the
+// source to the target.
+type SchemaRegistrySyncRequest struct {
+	Source SchemaRegistrySyncEndpoint `json:"source"`
+	Target SchemaRegistrySyncEndpoint `json:"target"`
+	// SubjectFilter is a regex that subject names (without context) must
+	// match. All subjects are synced if it's empty. Subjects that are
+	// referenced by matching subjects are always synced.
+	SubjectFilter string `json:"subjectFilter"`
+	// DryRun only plans the sync without changing the target.
+	DryRun bool `json:"dryRun"`
+}
+
+// SchemaRegistrySyncResult is the (planned) outcome of a schema sync.
+type SchemaRegistrySyncResult struct {
+	DryRun bool `json:"dryRun"`
+	// Compatibility is the compatibility level of the source context that
+	// is set on the target context.
+	Compatibility string                      `json:"compatibility,omitempty"`
+	Subjects      []SchemaRegistrySyncSubject `json:"subjects"`
+	// Created is the number of versions that have been registered, or that
+	// would be registered in a dry-run.
+	Created int `json:"created"`
+	// Existing is the number of versions that already existed in the target.
+	Existing int `json:"existing"`
+	// Failed is the number of versions that are conflicting or could not be
+	// registered.
+	Failed int `json:"failed"`
+	// Errors that are not related to a single subject, such as failing to
+	// restore the mode of the target context.
+	Errors []string `json:"errors"`
+}
+
+// SchemaRegistrySyncSubject is the (planned) outcome of syncing a single subject.
+type SchemaRegistrySyncSubject struct {
+	SourceSubject string `json:"sourceSubject"`
+	TargetSubject string `json:"targetSubject"`
+	// IsDependency is true if the subject does not match the subject filter,
+	// but is referenced by a subject that does.
+	IsDependency bool `json:"isDependency"`
+	// Compatibility is the subject-level compatibility that is set on the
+	// target subject. It's empty if the source subject has none.
+	Compatibility string `json:"compatibility,omitempty"`
+	// Mode is the subject-level mode that is set on the target subject. It's
+	// empty if the source subject has none.
+	Mode     string                      `json:"mode,omitempty"`
+	Versions []SchemaRegistrySyncVersion `json:"versions"`
+	Error    string                      `json:"error,omitempty"`
+}
+
+// SchemaRegistrySyncVersion is the (planned) outcome of syncing a single
+// subject version.
+type SchemaRegistrySyncVersion struct {
+	Version  int    `json:"version"`
+	SchemaID int    `json:"schemaId"`
+	Action   string `json:"action"`
+	// TargetSchemaID is the schema ID of the conflicting target version.
+	TargetSchemaID int    `json:"targetSchemaId,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// schemaSyncSubject is a source subject along with its active versions.
+type schemaSyncSubject struct {
+	SchemaRegistrySyncSubject
+
+	// name is the subject name without context.
+	name string
+	// schemas are the active source versions in ascending order. They have
+	// the same order as the Versions of the SchemaRegistrySyncSubject.
+	schemas       []sr.SubjectSchema
+	compatibility *sr.CompatibilityLevel
+	mode          *sr.Mode
+}
+
+// schemaSyncVersion is a single source version that must be registered after
+// all its dependencies.
+type schemaSyncVersion struct {
+	subject *schemaSyncSubject
+	index   int
+	deps    []*schemaSyncVersion
+}
+
+// newSchemaSyncClients creates a schema registry client for each registry
+// that is configured for schema syncs.
+func newSchemaSyncClients(cfg config.SchemaSync) (map[string]*rpsr.Client, error) {
+	clients := make(map[string]*rpsr.Client, len(cfg.Registries))
+	for _, registry := range cfg.Registries {
+		client, err := schemafactory.NewClient(registry.URLs, registry.Authentication, registry.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for schema registry %q: %w", registry.Name, err)
+		}
+		clients[registry.Name] = client
+	}
+	return clients, nil
+}
+
+// SyncSchemaRegistry copies subjects with all active versions, references,
+// subject-level compatibility and modes from one schema registry or context to
+// another while preserving schema IDs and versions. The target context is put
+// into IMPORT mode while versions are registered, its previous mode is
+// restored afterwards. Versions that already exist in the target are skipped,
+// so that a sync can be resumed by running it again.
+func (s *Service) SyncSchemaRegistry(ctx context.Context, req SchemaRegistrySyncRequest) (*SchemaRegistrySyncResult, *rest.Error) {
+	if !s.cfg.SchemaRegistry.Sync.Enabled {
+		return nil, errSchemaSyncNotEnabled()
+	}
+
+	subjectFilter, err := regexp.Compile(req.SubjectFilter)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to compile subject filter: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	sourceContext := normalizeSchemaContext(req.Source.Context)
+	targetContext := normalizeSchemaContext(req.Target.Context)
+	if req.Source.Registry == req.Target.Registry && sourceContext == targetContext {
+		return nil, &rest.Error{
+			Err:      errors.New("source and target of the schema sync are the same"),
+			Status:   http.StatusBadRequest,
+			Message:  "Source and target must be a different registry or context",
+			IsSilent: false,
+		}
+	}
+
+	sourceClient, restErr := s.getSchemaSyncClient(ctx, req.Source.Registry)
+	if restErr != nil {
+		return nil, restErr
+	}
+	targetClient, restErr := s.getSchemaSyncClient(ctx, req.Target.Registry)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	subjects, err := s.loadSchemaSyncSubjects(ctx, sourceClient, sourceContext, subjectFilter)
+	if err != nil {
+		return nil, errorToRestError(fmt.Errorf("failed to load source subjects: %w", err))
+	}
+	for _, subject := range subjects {
+		subject.TargetSubject = qualifySubject(targetContext, subject.name)
+		targetVersions, err := getSchemaSyncTargetVersions(ctx, targetClient, subject.TargetSubject)
+		if err != nil {
+			return nil, errorToRestError(fmt.Errorf("failed to load versions of target subject %q: %w", subject.TargetSubject, err))
+		}
+		planSchemaSyncVersions(subject, targetVersions)
+	}
+
+	result := &SchemaRegistrySyncResult{
+		DryRun:   req.DryRun,
+		Subjects: make([]SchemaRegistrySyncSubject, 0, len(subjects)),
+		Errors:   make([]string, 0),
+	}
+	sourceCompatibility, err := getSchemaSyncCompatibility(ctx, sourceClient, contextConfigSubject(sourceContext))
+	if err != nil {
+		return nil, errorToRestError(fmt.Errorf("failed to get compatibility of source context: %w", err))
+	}
+	if sourceCompatibility != nil {
+		result.Compatibility = sourceCompatibility.String()
+	}
+
+	if !req.DryRun {
+		s.applySchemaSync(ctx, targetClient, targetContext, sourceContext, sourceCompatibility, subjects, result)
+	}
+
+	for _, subject := range subjects {
+		for _, version := range subject.Versions {
+			switch {
+			case version.Error != "" || version.Action == SchemaSyncActionConflict:
+				result.Failed++
+			case version.Action == SchemaSyncActionCreate:
+				result.Created++
+			case version.Action == SchemaSyncActionExists:
+				result.Existing++
+			}
+		}
+		result.Subjects = append(result.Subjects, subject.SchemaRegistrySyncSubject)
+	}
+	return result, nil
+}
+
+// applySchemaSync registers all planned versions in the target and copies the
+// compatibility and mode configs afterwards. Errors of single versions or
+// subjects are stored in the results.
+//
+// Registering versions with their source IDs requires IMPORT mode. It is only
+// set on the subjects that receive new versions, so that all other subjects of
+// the target context remain writable, and their previous mode is restored
+// before the modes of the source subjects are copied.
+func (s *Service) applySchemaSync(
+	ctx context.Context,
+	targetClient *rpsr.Client,
+	targetContext string,
+	sourceContext string,
+	compatibility *sr.CompatibilityLevel,
+	subjects []*schemaSyncSubject,
+	result *SchemaRegistrySyncResult,
+) {
+	importModeErrs := make(map[*schemaSyncSubject]error)
+	restoreModes := make(map[*schemaSyncSubject]func() error)
+	for _, subject := range subjects {
+		hasCreates := slices.ContainsFunc(subject.Versions, func(version SchemaRegistrySyncVersion) bool {
+			return version.Action == SchemaSyncActionCreate
+		})
+		if !hasCreates {
+			continue
+		}
+		restoreMode, err := putSchemaSyncImportMode(ctx, targetClient, subject.TargetSubject)
+		if err != nil {
+			importModeErrs[subject] = err
+			continue
+		}
+		restoreModes[subject] = restoreMode
+	}
+
+	failed := make(map[*schemaSyncVersion]bool)
+	for _, node := range orderSchemaSyncVersions(subjects, sourceContext) {
+		version := &node.subject.Versions[node.index]
+		if version.Action == SchemaSyncActionConflict {
+			failed[node] = true
+			continue
+		}
+		if version.Action != SchemaSyncActionCreate {
+			continue
+		}
+		if err, exists := importModeErrs[node.subject]; exists {
+			failed[node] = true
+			version.Error = fmt.Sprintf("failed to set IMPORT mode on target subject: %v", err)
+			continue
+		}
+		if i := slices.IndexFunc(node.deps, func(dep *schemaSyncVersion) bool { return failed[dep] }); i >= 0 {
+			dep := node.deps[i]
+			failed[node] = true
+			version.Error = fmt.Sprintf("skipped because version %d of subject %q could not be synced",
+				dep.subject.schemas[dep.index].Version, dep.subject.SourceSubject)
+			continue
+		}
+
+		source := node.subject.schemas[node.index]
+		schema := source.Schema
+		schema.References = rewriteSchemaSyncReferences(schema.References, sourceContext, targetContext)
+		id, err := targetClient.RegisterSchema(ctx, node.subject.TargetSubject, schema, source.ID, source.Version)
+		if err == nil && id != source.ID {
+			err = fmt.Errorf("target registry assigned schema ID %d instead of %d", id, source.ID)
+		}
+		if err != nil {
+			failed[node] = true
+			version.Error = err.Error()
+		}
+	}
+
+	for _, subject := range subjects {
+		restoreMode, exists := restoreModes[subject]
+		if !exists {
+			continue
+		}
+		if err := restoreMode(); err != nil {
+			s.logger.WarnContext(ctx, "failed to restore mode of schema sync target subject", slog.String("subject", subject.TargetSubject), slog.Any("error", err))
+			result.Errors = append(result.Errors, fmt.Sprintf("failed to restore the previous mode of subject %q: %v", subject.TargetSubject, err))
+		}
+	}
+
+	if compatibility != nil {
+		res := targetClient.SetCompatibility(ctx, sr.SetCompatibility{Level: *compatibility}, contextConfigSubject(targetContext))[0]
+		if res.Err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("failed to set compatibility of target context: %v", res.Err))
+		}
+	}
+	for _, subject := range subjects {
+		var errs []string
+		if subject.compatibility != nil {
+			res := targetClient.SetCompatibility(ctx, sr.SetCompatibility{Level: *subject.compatibility}, subject.TargetSubject)[0]
+			if res.Err != nil {
+				errs = append(errs, fmt.Sprintf("failed to set compatibility: %v", res.Err))
+			}
+		}
+		if subject.mode != nil {
+			res := targetClient.SetMode(ctx, *subject.mode, subject.TargetSubject)[0]
+			if res.Err != nil {
+				errs = append(errs, fmt.Sprintf("failed to set mode: %v", res.Err))
+			}
+		}
+		subject.Error = strings.Join(errs, "; ")
+	}
+}
+
+// getSchemaSyncClient returns the client for the given sync registry name. An
+// empty name returns the client of the configured schema registry.
+func (s *Service) getSchemaSyncClient(ctx context.Context, registry string) (*rpsr.Client, *rest.Error) {
+	if registry == "" {
+		srClient, err := s.schemaClientFactory.GetSchemaRegistryClient(ctx)
+		if err != nil {
+			return nil, errorToRestError(err)
+		}
+		return srClient, nil
+	}
+
+	srClient, exists := s.schemaSyncClients[registry]
+	if !exists {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("schema registry %q is not configured", registry),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Schema registry %q is not configured in schemaRegistry.sync.registries", registry),
+			IsSilent: false,
+		}
+	}
+	return srClient, nil
+}
+
+// loadSchemaSyncSubjects returns all source subjects of the given context that
+// match the filter, along with all subjects they reference.
+func (s *Service) loadSchemaSyncSubjects(ctx context.Context, srClient *rpsr.Client, schemaContext string, subjectFilter *regexp.Regexp) ([]*schemaSyncSubject, error) {
+	listCtx := ctx
+	if schemaContext != "." {
+		listCtx = sr.WithParams(ctx, sr.SubjectPrefix(contextConfigSubject(schemaContext)))
+	}
+	subjectNames, err := srClient.Subjects(listCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subjects: %w", err)
+	}
+
+	var queue []*schemaSyncSubject
+	for _, subjectName := range subjectNames {
+		subjectContext, name := splitQualifiedSubject(subjectName)
+		if cmp.Or(subjectContext, ".") != schemaContext || !subjectFilter.MatchString(name) {
+			continue
+		}
+		queue = append(queue, &schemaSyncSubject{name: name})
+	}
+
+	subjects := make([]*schemaSyncSubject, 0, len(queue))
+	loaded := make(map[string]bool, len(queue))
+	for _, subject := range queue {
+		loaded[subject.name] = true
+	}
+	for len(queue) > 0 {
+		subject := queue[0]
+		queue = queue[1:]
+
+		subject.SourceSubject = qualifySubject(schemaContext, subject.name)
+		schemas, err := srClient.Schemas(ctx, subject.SourceSubject)
+		if err != nil {
+			var schemaErr *sr.ResponseError
+			if errors.As(err, &schemaErr) && schemaErr.ErrorCode == 40401 {
+				// The subject has been deleted in the meantime
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve versions of subject %q: %w", subject.SourceSubject, err)
+		}
+		slices.SortFunc(schemas, func(a, b sr.SubjectSchema) int { return a.Version - b.Version })
+		subject.schemas = schemas
+
+		if subject.compatibility, err = getSchemaSyncCompatibility(ctx, srClient, subject.SourceSubject); err != nil {
+			return nil, fmt.Errorf("failed to get compatibility of subject %q: %w", subject.SourceSubject, err)
+		}
+		if subject.compatibility != nil {
+			subject.Compatibility = subject.compatibility.String()
+		}
+		if subject.mode, err = getSchemaSyncMode(ctx, srClient, subject.SourceSubject); err != nil {
+			return nil, fmt.Errorf("failed to get mode of subject %q: %w", subject.SourceSubject, err)
+		}
+		if subject.mode != nil {
+			subject.Mode = subject.mode.String()
+		}
+		subjects = append(subjects, subject)
+
+		// References to subjects of other contexts can't be mapped to the
+		// target context and must exist in the target already.
+		for _, schema := range schemas {
+			for _, ref := range schema.References {
+				refContext, refName := splitQualifiedSubject(ref.Subject)
+				if cmp.Or(refContext, schemaContext) != schemaContext || loaded[refName] {
+					continue
+				}
+				loaded[refName] = true
+				queue = append(queue, &schemaSyncSubject{
+					SchemaRegistrySyncSubject: SchemaRegistrySyncSubject{IsDependency: true},
+					name:                      refName,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(subjects, func(a, b *schemaSyncSubject) int { return strings.Compare(a.name, b.name) })
+	return subjects, nil
+}
+
+// getSchemaSyncTargetVersions returns the schema IDs of all versions, including
+// soft-deleted ones, of the given target subject.
+func getSchemaSyncTargetVersions(ctx context.Context, srClient *rpsr.Client, subject string) (map[int]int, error) {
+	schemas, err := srClient.Schemas(sr.WithParams(ctx, sr.ShowDeleted), subject)
+	if err != nil {
+		var schemaErr *sr.ResponseError
+		if errors.As(err, &schemaErr) && schemaErr.ErrorCode == 40401 {
+			return map[int]int{}, nil
+		}
+		return nil, err
+	}
+
+	schemaIDByVersion := make(map[int]int, len(schemas))
+	for _, schema := range schemas {
+		schemaIDByVersion[schema.Version] = schema.ID
+	}
+	return schemaIDByVersion, nil
+}
+
+// planSchemaSyncVersions sets the action of each source version by comparing it
+// with the versions that already exist in the target subject.
+func planSchemaSyncVersions(subject *schemaSyncSubject, targetSchemaIDByVersion map[int]int) {
+	subject.Versions = make([]SchemaRegistrySyncVersion, len(subject.schemas))
+	for i, schema := range subject.schemas {
+		version := SchemaRegistrySyncVersion{
+			Version:  schema.Version,
+			SchemaID: schema.ID,
+			Action:   SchemaSyncActionCreate,
+		}
+		if targetID, exists := targetSchemaIDByVersion[schema.Version]; exists {
+			version.Action = SchemaSyncActionExists
+			if targetID != schema.ID {
+				version.Action = SchemaSyncActionConflict
+				version.TargetSchemaID = targetID
+			}
+		}
+		subject.Versions[i] = version
+	}
+}
+
+// orderSchemaSyncVersions returns all source versions in the order they must be
+// registered: Each version comes after the previous version of the same subject
+// and after all versions it references. Apart from that, versions are ordered
+// by schema ID.
+func orderSchemaSyncVersions(subjects []*schemaSyncSubject, sourceContext string) []*schemaSyncVersion {
+	var versions []*schemaSyncVersion
+	byVersion := make(map[SchemaVersion]*schemaSyncVersion)
+	for _, subject := range subjects {
+		for i, schema := range subject.schemas {
+			version := &schemaSyncVersion{subject: subject, index: i}
+			versions = append(versions, version)
+			byVersion[SchemaVersion{Subject: subject.name, Version: schema.Version}] = version
+		}
+	}
+
+	for i, version := range versions {
+		if version.index > 0 {
+			version.deps = append(version.deps, versions[i-1])
+		}
+		for _, ref := range version.subject.schemas[version.index].References {
+			refContext, refName := splitQualifiedSubject(ref.Subject)
+			if cmp.Or(refContext, sourceContext) != sourceContext {
+				continue
+			}
+			if dep, exists := byVersion[SchemaVersion{Subject: refName, Version: ref.Version}]; exists {
+				version.deps = append(version.deps, dep)
+			}
+		}
+	}
+
+	slices.SortStableFunc(versions, func(a, b *schemaSyncVersion) int {
+		schemaA, schemaB := a.subject.schemas[a.index], b.subject.schemas[b.index]
+		return cmp.Or(
+			cmp.Compare(schemaA.ID, schemaB.ID),
+			strings.Compare(a.subject.name, b.subject.name),
+			cmp.Compare(schemaA.Version, schemaB.Version),
+		)
+	})
+
+	ordered := make([]*schemaSyncVersion, 0, len(versions))
+	visited := make(map[*schemaSyncVersion]bool, len(versions))
+	var visit func(version *schemaSyncVersion)
+	visit = func(version *schemaSyncVersion) {
+		if visited[version] {
+			return
+		}
+		visited[version] = true
+		for _, dep := range version.deps {
+			visit(dep)
+		}
+		ordered = append(ordered, version)
+	}
+	for _, version := range versions {
+		visit(version)
+	}
+	return ordered
+}
+
+// rewriteSchemaSyncReferences points references that are qualified with the
+// source context to the target context.
+func rewriteSchemaSyncReferences(refs []sr.SchemaReference, sourceContext, targetContext string) []sr.SchemaReference {
+	if len(refs) == 0 {
+		return refs
+	}
+	rewritten := make([]sr.SchemaReference, len(refs))
+	for i, ref := range refs {
+		if refContext, refName := splitQualifiedSubject(ref.Subject); refContext == sourceContext {
+			ref.Subject = qualifySubject(targetContext, refName)
+		}
+		rewritten[i] = ref
+	}
+	return rewritten
+}
+
+// putSchemaSyncImportMode puts the given subject into IMPORT mode and returns
+// a function that restores the previous subject-level mode.
+func putSchemaSyncImportMode(ctx context.Context, srClient *rpsr.Client, subject string) (func() error, error) {
+	previous, err := getSchemaSyncMode(ctx, srClient, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mode: %w", err)
+	}
+	if previous != nil && *previous == sr.ModeImport {
+		return func() error { return nil }, nil
+	}
+
+	// Setting IMPORT mode on a non-empty subject must be forced
+	if err := srClient.SetMode(sr.WithParams(ctx, sr.Force), sr.ModeImport, subject)[0].Err; err != nil {
+		return nil, err
+	}
+	return func() error {
+		// The previous mode must be restored even if the request has been canceled
+		restoreCtx := context.WithoutCancel(ctx)
+		if previous == nil {
+			return srClient.ResetMode(restoreCtx, subject)[0].Err
+		}
+		return srClient.SetMode(restoreCtx, *previous, subject)[0].Err
+	}, nil
+}
+
+// getSchemaSyncCompatibility returns the compatibility level of the given
+// subject or nil if the subject has no subject-level compatibility.
+func getSchemaSyncCompatibility(ctx context.Context, srClient *rpsr.Client, subject string) (*sr.CompatibilityLevel, error) {
+	res := srClient.Compatibility(ctx, subject)[0]
+	if res.Err != nil {
+		var schemaErr *sr.ResponseError
+		if errors.As(res.Err, &schemaErr) && errors.Is(schemaErr.SchemaError(), sr.ErrSubjectLevelCompatibilityNotConfigured) {
+			return nil, nil
+		}
+		return nil, res.Err
+	}
+	return &res.Level, nil
+}
+
+// getSchemaSyncMode returns the mode of the given subject or nil if the subject
+// has no subject-level mode.
+func getSchemaSyncMode(ctx context.Context, srClient *rpsr.Client, subject string) (*sr.Mode, error) {
+	res := srClient.Mode(ctx, subject)[0]
+	if res.Err != nil {
+		var schemaErr *sr.ResponseError
+		if errors.As(res.Err, &schemaErr) && errors.Is(schemaErr.SchemaError(), sr.ErrSubjectLevelModeNotConfigured) {
+			return nil, nil
+		}
+		return nil, res.Err
+	}
+	return &res.Mode, nil
+}
+
+// normalizeSchemaContext returns the context name as reported by the schema
+// registry, e.g. "." for the default context and ".dr" for "dr".
+func normalizeSchemaContext(schemaContext string) string {
+	if !strings.HasPrefix(schemaContext, ".") {
+		return "." + schemaContext
+	}
+	return schemaContext
+}
+
+// qualifySubject returns the subject name qualified with the given context.
+// Subjects of the default context are not qualified.
+func qualifySubject(schemaContext, subject string) string {
+	if schemaContext == "." {
+		return subject
+	}
+	return contextConfigSubject(schemaContext) + subject
+}
+
+// contextConfigSubject returns the subject that is used to get or set the
+// config and mode of a context. The default context uses the global config.
+func contextConfigSubject(schemaContext string) string {
+	if schemaContext == "." {
+		return ""
+	}
+	return ":" + schemaContext + ":"
+}
+
+// splitQualifiedSubject splits a subject such as ":.dr:orders-value" into its
+// context and name. The context is empty for unqualified subjects.
+func splitQualifiedSubject(subject string) (schemaContext, name string) {
+	if !strings.HasPrefix(subject, ":.") {
+		return "", subject
+	}
+	schemaContext, name, found := strings.Cut(subject[1:], ":")
+	if !found {
+		return "", subject
+	}
+	return schemaContext, name
+}
+
+func errSchemaSyncNotEnabled() *rest.Error {
+	return &rest.Error{
+		Err:      errors.New("schema sync is not enabled"),
+		Status:   http.StatusNotImplemented,
+		Message:  "Schema sync is not enabled. Set schemaRegistry.sync.enabled to copy subjects between registries or contexts",
+		IsSilent: true,
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/sr"
+	"github.com/twmb/franz-go/pkg/sr/srfake"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	schemafactory "github.com/redpanda-data/console/backend/pkg/factory/schema"
+)
+
+// importRegistry extends the fake schema registry with modes, subject-level
+// compatibility and registering schemas with a fixed ID and version, which
+// is only allowed in IMPORT mode.
+type importRegistry struct {
+	*srfake.Registry
+
+	mu            sync.Mutex
+	modes         map[string]sr.Mode
+	compatibility map[string]sr.CompatibilityLevel
+}
+
+func newImportRegistry(t *testing.T) *importRegistry {
+	r := &importRegistry{
+		Registry:      srfake.New(),
+		modes:         map[string]sr.Mode{"": sr.ModeReadWrite},
+		compatibility: make(map[string]sr.CompatibilityLevel),
+	}
+	t.Cleanup(r.Close)
+	r.Intercept(r.intercept)
+	return r
+}
+
+func (r *importRegistry) intercept(w http.ResponseWriter, req *http.Request) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	respond := func(status int, body any) bool {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+		return true
+	}
+	respondErr := func(err *sr.Error) bool {
+		return respond(err.Code/100, map[string]any{"error_code": err.Code, "message": err.Description})
+	}
+
+	switch path := req.URL.Path; {
+	case path == "/mode" || strings.HasPrefix(path, "/mode/"):
+		subject := strings.TrimPrefix(strings.TrimPrefix(path, "/mode"), "/")
+		switch req.Method {
+		case http.MethodGet:
+			mode, exists := r.modes[subject]
+			if !exists {
+				return respondErr(sr.ErrSubjectLevelModeNotConfigured)
+			}
+			return respond(http.StatusOK, map[string]sr.Mode{"mode": mode})
+		case http.MethodPut:
+			var body struct {
+				Mode sr.Mode `json:"mode"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			r.modes[subject] = body.Mode
+			return respond(http.StatusOK, body)
+		case http.MethodDelete:
+			delete(r.modes, subject)
+			return respond(http.StatusOK, map[string]sr.Mode{})
+		}
+	case strings.HasPrefix(path, "/config/"):
+		subject := strings.TrimPrefix(path, "/config/")
+		switch req.Method {
+		case http.MethodGet:
+			level, exists := r.compatibility[subject]
+			if !exists {
+				return respondErr(sr.ErrSubjectLevelCompatibilityNotConfigured)
+			}
+			return respond(http.StatusOK, map[string]sr.CompatibilityLevel{"compatibilityLevel": level})
+		case http.MethodPut:
+			var body sr.SetCompatibility
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			r.compatibility[subject] = body.Level
+			return respond(http.StatusOK, body)
+		}
+	case req.Method == http.MethodPost && strings.HasPrefix(path, "/subjects/") && strings.HasSuffix(path, "/versions"):
+		var body sr.SubjectSchema
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		if body.ID <= 0 {
+			return respondErr(sr.ErrInvalidSchema)
+		}
+		subject := strings.TrimSuffix(strings.TrimPrefix(path, "/subjects/"), "/versions")
+		if r.effectiveMode(subject) != sr.ModeImport {
+			return respondErr(sr.ErrOperationNotPermitted)
+		}
+		return r.seed(subject, body, respond)
+	}
+	return false
+}
+
+// effectiveMode returns the mode of the subject, which falls back to the mode of
+// its context and the global mode.
+func (r *importRegistry) effectiveMode(subject string) sr.Mode {
+	if mode, exists := r.modes[subject]; exists {
+		return mode
+	}
+	if schemaContext, _ := splitQualifiedSubject(subject); schemaContext != "" {
+		if mode, exists := r.modes[contextConfigSubject(schemaContext)]; exists {
+			return mode
+		}
+	}
+	return r.modes[""]
+}
+
+func (r *importRegistry) seed(subject string, schema sr.SubjectSchema, respond func(int, any) bool) (handled bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			handled = respond(http.StatusUnprocessableEntity, map[string]any{"error_code": 42201, "message": fmt.Sprint(err)})
+		}
+	}()
+	r.SeedSchema(subject, schema.Version, schema.ID, schema.Schema)
+	return respond(http.StatusOK, map[string]int{"id": schema.ID})
+}
+
+func TestSyncSchemaRegistry(t *testing.T) {
+	source := newImportRegistry(t)
+	target := newImportRegistry(t)
+
+	avroSchema := func(name string, refs ...sr.SchemaReference) sr.Schema {
+		return sr.Schema{
+			Schema:     fmt.Sprintf(`{"type":"record","name":%q,"fields":[]}`, name),
+			Type:       sr.TypeAvro,
+			References: refs,
+		}
+	}
+	source.SeedSchema("common", 1, 1, avroSchema("Common"))
+	source.SeedSchema("orders-value", 1, 2, avroSchema("OrderV1"))
+	source.SeedSchema("orders-value", 2, 5, avroSchema("OrderV2", sr.SchemaReference{Name: "Common", Subject: "common", Version: 1}))
+	source.SeedSchema("payments-value", 1, 3, avroSchema("Payment"))
+	source.SeedSchema("other-value", 1, 4, avroSchema("Other"))
+	source.compatibility["orders-value"] = sr.CompatFull
+	source.modes["payments-value"] = sr.ModeReadOnly
+	// orders-value v1 has already been synced by a previous run
+	target.modes[""] = sr.ModeReadOnly
+	target.SeedSchema("orders-value", 1, 2, avroSchema("OrderV1"))
+	target.SeedSchema("payments-value", 1, 7, avroSchema("PaymentConflict"))
+
+	cfg := &config.Config{
+		SchemaRegistry: config.Schema{
+			Enabled: true,
+			URLs:    []string{source.URL()},
+			Sync: config.SchemaSync{
+				Enabled:    true,
+				Registries: []config.SchemaSyncRegistry{{Name: "dr", URLs: []string{target.URL()}}},
+			},
+		},
+	}
+	schemaClientFactory, err := schemafactory.NewSingleClientProvider(cfg)
+	require.NoError(t, err)
+	schemaSyncClients, err := newSchemaSyncClients(cfg.SchemaRegistry.Sync)
+	require.NoError(t, err)
+	svc := &Service{
+		cfg:                 cfg,
+		logger:              slog.New(slog.DiscardHandler),
+		schemaClientFactory: schemaClientFactory,
+		schemaSyncClients:   schemaSyncClients,
+	}
+
+	req := SchemaRegistrySyncRequest{
+		Target:        SchemaRegistrySyncEndpoint{Registry: "dr"},
+		SubjectFilter: "^(orders|payments)-value$",
+		DryRun:        true,
+	}
+	expected := &SchemaRegistrySyncResult{
+		DryRun:        true,
+		Compatibility: "BACKWARD",
+		Subjects: []SchemaRegistrySyncSubject{
+			{
+				SourceSubject: "common",
+				TargetSubject: "common",
+				IsDependency:  true,
+				Versions:      []SchemaRegistrySyncVersion{{Version: 1, SchemaID: 1, Action: SchemaSyncActionCreate}},
+			},
+			{
+				SourceSubject: "orders-value",
+				TargetSubject: "orders-value",
+				Compatibility: "FULL",
+				Versions: []SchemaRegistrySyncVersion{
+					{Version: 1, SchemaID: 2, Action: SchemaSyncActionExists},
+					{Version: 2, SchemaID: 5, Action: SchemaSyncActionCreate},
+				},
+			},
+			{
+				SourceSubject: "payments-value",
+				TargetSubject: "payments-value",
+				Mode:          "READONLY",
+				Versions:      []SchemaRegistrySyncVersion{{Version: 1, SchemaID: 3, Action: SchemaSyncActionConflict, TargetSchemaID: 7}},
+			},
+		},
+		Created:  2,
+		Existing: 1,
+		Failed:   1,
+		Errors:   []string{},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		result, restErr := svc.SyncSchemaRegistry(t.Context(), req)
+		require.Nil(t, restErr)
+		assert.Equal(t, expected, result)
+		_, exists := target.GetSchema("common", 1)
+		assert.False(t, exists)
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		req.DryRun = false
+		expected.DryRun = false
+		result, restErr := svc.SyncSchemaRegistry(t.Context(), req)
+		require.Nil(t, restErr)
+		assert.Equal(t, expected, result)
+
+		synced, exists := target.GetSchema("orders-value", 2)
+		require.True(t, exists)
+		assert.Equal(t, 5, synced.ID)
+		// IMPORT mode has only been set on the synced subjects and removed again
+		assert.Equal(t, map[string]sr.Mode{"": sr.ModeReadOnly, "payments-value": sr.ModeReadOnly}, target.modes)
+		assert.Equal(t, sr.CompatFull, target.compatibility["orders-value"])
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		result, restErr := svc.SyncSchemaRegistry(t.Context(), req)
+		require.Nil(t, restErr)
+		assert.Equal(t, 0, result.Created)
+		assert.Equal(t, 3, result.Existing)
+		assert.Equal(t, 1, result.Failed)
+	})
+
+	t.Run("same source and target", func(t *testing.T) {
+		_, restErr := svc.SyncSchemaRegistry(t.Context(), SchemaRegistrySyncRequest{Source: SchemaRegistrySyncEndpoint{Context: "."}})
+		require.NotNil(t, restErr)
+		assert.Equal(t, http.StatusBadRequest, restErr.Status)
+	})
+}
+
+func TestSchemaSyncSubjectNames(t *testing.T) {
+	assert.Equal(t, ".", normalizeSchemaContext(""))
+	assert.Equal(t, ".", normalizeSchemaContext("."))
+	assert.Equal(t, ".dr", normalizeSchemaContext("dr"))
+	assert.Equal(t, ".dr", normalizeSchemaContext(".dr"))
+
+	assert.Equal(t, "orders-value", qualifySubject(".", "orders-value"))
+	assert.Equal(t, ":.dr:orders-value", qualifySubject(".dr", "orders-value"))
+	assert.Equal(t, "", contextConfigSubject("."))
+	assert.Equal(t, ":.dr:", contextConfigSubject(".dr"))
+
+	for _, tt := range []struct {
+		subject string
+		context string
+		name    string
+	}{
+		{"orders-value", "", "orders-value"},
+		{":.dr:orders-value", ".dr", "orders-value"},
+		{":.:orders-value", ".", "orders-value"},
+		{":.dr:a:b", ".dr", "a:b"},
+		{":.incomplete", "", ":.incomplete"},
+	} {
+		schemaContext, name := splitQualifiedSubject(tt.subject)
+		assert.Equal(t, tt.context, schemaContext, tt.subject)
+		assert.Equal(t, tt.name, name, tt.subject)
+	}
+}
+
+func TestPlanSchemaSyncVersions(t *testing.T) {
+	subject := &schemaSyncSubject{
+		schemas: []sr.SubjectSchema{
+			{Version: 1, ID: 10},
+			{Version: 2, ID: 11},
+			{Version: 3, ID: 12},
+		},
+	}
+	planSchemaSyncVersions(subject, map[int]int{1: 10, 2: 20})
+
+	assert.Equal(t, []SchemaRegistrySyncVersion{
+		{Version: 1, SchemaID: 10, Action: SchemaSyncActionExists},
+		{Version: 2, SchemaID: 11, Action: SchemaSyncActionConflict, TargetSchemaID: 20},
+		{Version: 3, SchemaID: 12, Action: SchemaSyncActionCreate},
+	}, subject.Versions)
+}
+
+func TestOrderSchemaSyncVersions(t *testing.T) {
+	ref := func(subject string, version int) sr.SchemaReference {
+		return sr.SchemaReference{Name: subject + ".proto", Subject: subject, Version: version}
+	}
+	// orders references a version of common that has been registered with
+	// a higher schema ID. The reference to the other context is ignored.
+	common := &schemaSyncSubject{
+		name: "common",
+		schemas: []sr.SubjectSchema{
+			{Version: 1, ID: 1},
+			{Version: 2, ID: 5},
+		},
+	}
+	orders := &schemaSyncSubject{
+		name: "orders",
+		schemas: []sr.SubjectSchema{
+			{Version: 1, ID: 2},
+			{Version: 2, ID: 3, Schema: sr.Schema{References: []sr.SchemaReference{ref(":.other:common", 1), ref("common", 2)}}},
+			{Version: 3, ID: 4},
+		},
+	}
+
+	var order []string
+	for _, version := range orderSchemaSyncVersions([]*schemaSyncSubject{common, orders}, ".") {
+		order = append(order, fmt.Sprintf("%s-v%d", version.subject.name, version.subject.schemas[version.index].Version))
+	}
+	assert.Equal(t, []string{"common-v1", "orders-v1", "common-v2", "orders-v2", "orders-v3"}, order)
+}
+
+func TestRewriteSchemaSyncReferences(t *testing.T) {
+	refs := []sr.SchemaReference{
+		{Name: "a.proto", Subject: "a", Version: 1},
+		{Name: "b.proto", Subject: ":.src:b", Version: 2},
+		{Name: "c.proto", Subject: ":.other:c", Version: 3},
+	}
+
+	assert.Equal(t, []sr.SchemaReference{
+		{Name: "a.proto", Subject: "a", Version: 1},
+		{Name: "b.proto", Subject: "b", Version: 2},
+		{Name: "c.proto", Subject: ":.other:c", Version: 3},
+	}, rewriteSchemaSyncReferences(refs, ".src", "."))
+	assert.Equal(t, ":.dr:b", rewriteSchemaSyncReferences(refs, ".src", ".dr")[1].Subject)
+	// The source references must not be modified
+	assert.Equal(t, ":.src:b", refs[1].Subject)
+}
@@ -0,0 +1,620 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	kafkafactory "github.com/redpanda-data/console/backend/pkg/factory/kafka"
+)
+
+// SchemaUsageReport shows which topics are serialized with which schema IDs and
+// maps them to the registered subject versions.
+type SchemaUsageReport struct {
+	Scan     SchemaUsageScanStatus `json:"scan"`
+	Subjects []SubjectSchemaUsage  `json:"subjects"`
+	// UnusedSubjects are the subjects none of whose versions has been found in
+	// any of the sampled records.
+	UnusedSubjects []string           `json:"unusedSubjects"`
+	Topics         []TopicSchemaUsage `json:"topics"`
+}
+
+// SchemaUsageScanStatus describes the most recent scan of all topics.
+type SchemaUsageScanStatus struct {
+	// LastScanTimestamp is the unix timestamp in ms at which the last successful
+	// scan has finished. It is nil until the first scan has succeeded, in which
+	// case no subject is reported as unused yet.
+	LastScanTimestamp  *int64 `json:"lastScanTimestamp"`
+	LastScanDurationMs int64  `json:"lastScanDurationMs"`
+	LastScanError      string `json:"lastScanError,omitempty"`
+	ScannedTopics      int    `json:"scannedTopics"`
+	ScanIntervalMs     int64  `json:"scanIntervalMs"`
+}
+
+// TopicSchemaUsage lists the schema IDs that have been found in the records of a topic.
+type TopicSchemaUsage struct {
+	TopicName string          `json:"topicName"`
+	SchemaIDs []SchemaIDUsage `json:"schemaIds"`
+}
+
+// SchemaIDUsage describes where and when a schema ID has been found in a topic.
+type SchemaIDUsage struct {
+	SchemaID int  `json:"schemaId"`
+	IsKey    bool `json:"isKey"`
+	IsValue  bool `json:"isValue"`
+	// FirstSeenTimestamp and LastSeenTimestamp are the unix timestamps in ms of
+	// the oldest and newest sampled record with this schema ID.
+	FirstSeenTimestamp int64 `json:"firstSeenTimestamp"`
+	LastSeenTimestamp  int64 `json:"lastSeenTimestamp"`
+	// InLatestSample is true if the schema ID has been found in the latest
+	// sample of the topic, which means it's still being produced.
+	InLatestSample bool `json:"inLatestSample"`
+}
+
+// SubjectSchemaUsage shows which topics use the versions of a subject.
+type SubjectSchemaUsage struct {
+	Subject  string               `json:"subject"`
+	IsUnused bool                 `json:"isUnused"`
+	Versions []SchemaVersionUsage `json:"versions"`
+}
+
+// SchemaVersionUsage lists the topics whose records are serialized with the schema
+// ID of a subject version. Subjects that share the same schema share the schema
+// ID, hence the topics can't be distinguished by subject.
+type SchemaVersionUsage struct {
+	Version       int  `json:"version"`
+	SchemaID      int  `json:"schemaId"`
+	IsSoftDeleted bool `json:"isSoftDeleted"`
+	// IsProduced is true if the schema ID has been found in the latest sample
+	// of at least one topic.
+	IsProduced        bool               `json:"isProduced"`
+	LastSeenTimestamp *int64             `json:"lastSeenTimestamp"`
+	Topics            []SchemaTopicUsage `json:"topics"`
+}
+
+// SchemaTopicUsage is a topic whose records are serialized with a schema version.
+type SchemaTopicUsage struct {
+	TopicName         string `json:"topicName"`
+	IsKey             bool   `json:"isKey"`
+	IsValue           bool   `json:"isValue"`
+	LastSeenTimestamp int64  `json:"lastSeenTimestamp"`
+	InLatestSample    bool   `json:"inLatestSample"`
+}
+
+// schemaIDSighting aggregates the sampled records of a topic that use the same schema ID.
+type schemaIDSighting struct {
+	isKey     bool
+	isValue   bool
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+func (s *schemaIDSighting) observe(ts time.Time, isKey bool) {
+	if isKey {
+		s.isKey = true
+	} else {
+		s.isValue = true
+	}
+	if s.firstSeen.IsZero() || ts.Before(s.firstSeen) {
+		s.firstSeen = ts
+	}
+	if ts.After(s.lastSeen) {
+		s.lastSeen = ts
+	}
+}
+
+// schemaIDEntry is a schema ID in the index of a topic.
+type schemaIDEntry struct {
+	schemaIDSighting
+	inLatestSample bool
+}
+
+// SchemaUsageTracker periodically samples the most recent records of all topics
+// and indexes the schema IDs of the Confluent wire format header in record keys
+// and values. The index is kept in memory and rebuilt after a restart.
+type SchemaUsageTracker struct {
+	kafkaClientFactory kafkafactory.ClientFactory
+	logger             *slog.Logger
+	cfg                config.ConsoleSchemaUsage
+
+	mu     sync.RWMutex
+	topics map[string]map[int]*schemaIDEntry
+	status SchemaUsageScanStatus
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSchemaUsageTracker creates a tracker that scans all topics of the cluster
+// once Start is called.
+func NewSchemaUsageTracker(kafkaClientFactory kafkafactory.ClientFactory, logger *slog.Logger, cfg config.ConsoleSchemaUsage) *SchemaUsageTracker {
+	return &SchemaUsageTracker{
+		kafkaClientFactory: kafkaClientFactory,
+		logger:             logger.With(slog.String("component", "schema_usage_tracker")),
+		cfg:                cfg,
+		topics:             make(map[string]map[int]*schemaIDEntry),
+		status:             SchemaUsageScanStatus{ScanIntervalMs: cfg.ScanInterval.Milliseconds()},
+	}
+}
+
+// Start scans all topics immediately and then in the configured interval until
+// Stop is called.
+func (t *SchemaUsageTracker) Start(ctx context.Context) {
+	// The start context expires once the startup has finished
+	ctx, t.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	t.done = make(chan struct{})
+
+	go func() {
+		defer close(t.done)
+
+		ticker := time.NewTicker(t.cfg.ScanInterval)
+		defer ticker.Stop()
+		for {
+			t.scan(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	t.logger.InfoContext(ctx, "started schema usage tracker", slog.Duration("scan_interval", t.cfg.ScanInterval))
+}
+
+// Stop stops scanning and waits for an ongoing scan to finish.
+func (t *SchemaUsageTracker) Stop() {
+	if t.cancel == nil {
+		return
+	}
+	t.cancel()
+	<-t.done
+}
+
+func (t *SchemaUsageTracker) scan(ctx context.Context) {
+	start := time.Now()
+	scannedTopics, err := t.scanTopics(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+	finished := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		t.status.LastScanError = err.Error()
+		t.logger.WarnContext(ctx, "failed to scan topics for schema usage", slog.Any("error", err))
+		return
+	}
+	finishedMs := finished.UnixMilli()
+	t.status.LastScanTimestamp = &finishedMs
+	t.status.LastScanDurationMs = finished.Sub(start).Milliseconds()
+	t.status.ScannedTopics = scannedTopics
+	t.status.LastScanError = ""
+}
+
+// scanTopics samples the most recent records of all topics and merges the found
+// schema IDs into the index. It returns the number of topics whose samples have
+// been fetched completely.
+func (t *SchemaUsageTracker) scanTopics(ctx context.Context) (int, error) {
+	cl, adminCl, err := t.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, err := adminCl.Metadata(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+	topicNames := make([]string, 0, len(metadata.Topics))
+	for _, topic := range metadata.Topics {
+		if topic.Err != nil || (topic.IsInternal && !t.cfg.IncludeInternal) {
+			continue
+		}
+		topicNames = append(topicNames, topic.Topic)
+	}
+	if len(topicNames) == 0 {
+		t.merge(topicNames, nil, nil)
+		return 0, nil
+	}
+
+	startOffsets, err := adminCl.ListStartOffsets(ctx, topicNames...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list topic start offsets: %w", err)
+	}
+	endOffsets, err := adminCl.ListEndOffsets(ctx, topicNames...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list topic end offsets: %w", err)
+	}
+
+	// Only partitions that contain records are sampled
+	watermarks := make(map[string]map[int32][2]int64)
+	endOffsets.Each(func(end kadm.ListedOffset) {
+		start, ok := startOffsets.Lookup(end.Topic, end.Partition)
+		if end.Err != nil || !ok || start.Err != nil || end.Offset <= start.Offset {
+			return
+		}
+		if _, exists := watermarks[end.Topic]; !exists {
+			watermarks[end.Topic] = make(map[int32][2]int64)
+		}
+		watermarks[end.Topic][end.Partition] = [2]int64{start.Offset, end.Offset}
+	})
+
+	partitionOffsets := make(map[string]map[int32]kgo.Offset, len(watermarks))
+	lastOffsets := make(map[string]map[int32]int64, len(watermarks))
+	for topic, partitions := range watermarks {
+		perPartition := int64(math.Ceil(float64(t.cfg.RecordsPerTopic) / float64(len(partitions))))
+		partitionOffsets[topic] = make(map[int32]kgo.Offset, len(partitions))
+		lastOffsets[topic] = make(map[int32]int64, len(partitions))
+		for partitionID, marks := range partitions {
+			partitionOffsets[topic][partitionID] = kgo.NewOffset().At(max(marks[1]-perPartition, marks[0]))
+			lastOffsets[topic][partitionID] = marks[1] - 1
+		}
+	}
+
+	sightings, incomplete, err := t.sampleSchemaIDs(ctx, cl, partitionOffsets, lastOffsets)
+	if err != nil {
+		return 0, err
+	}
+	t.merge(topicNames, sightings, incomplete)
+
+	return len(topicNames) - len(incomplete), nil
+}
+
+// sampleSchemaIDs consumes all partitions from the given offsets up to the last
+// offsets and collects the schema IDs by topic. Topics whose partitions could not
+// be consumed up to the last offset before the scan timeout are returned as incomplete.
+func (t *SchemaUsageTracker) sampleSchemaIDs(
+	ctx context.Context,
+	cl *kgo.Client,
+	partitionOffsets map[string]map[int32]kgo.Offset,
+	lastOffsets map[string]map[int32]int64,
+) (map[string]map[int]*schemaIDSighting, map[string]bool, error) {
+	sightings := make(map[string]map[int]*schemaIDSighting)
+	incomplete := make(map[string]bool)
+	if len(partitionOffsets) == 0 {
+		return sightings, incomplete, nil
+	}
+
+	remaining := make(map[string]int, len(lastOffsets))
+	for topic, partitions := range lastOffsets {
+		remaining[topic] = len(partitions)
+	}
+
+	opts := append(cl.Opts(), kgo.ConsumePartitions(partitionOffsets))
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create kafka client for sampling records: %w", err)
+	}
+	defer client.Close()
+
+	fetchCtx, cancel := context.WithTimeout(ctx, t.cfg.ScanTimeout)
+	defer cancel()
+
+	var header sr.ConfluentHeader
+	observe := func(record *kgo.Record, payload []byte, isKey bool) {
+		schemaID, _, err := header.DecodeID(payload)
+		if err != nil {
+			return
+		}
+		if _, exists := sightings[record.Topic]; !exists {
+			sightings[record.Topic] = make(map[int]*schemaIDSighting)
+		}
+		sighting, exists := sightings[record.Topic][schemaID]
+		if !exists {
+			sighting = &schemaIDSighting{}
+			sightings[record.Topic][schemaID] = sighting
+		}
+		sighting.observe(record.Timestamp, isKey)
+	}
+
+	pendingTopics := len(remaining)
+	for pendingTopics > 0 {
+		fetches := client.PollFetches(fetchCtx)
+		if fetchCtx.Err() != nil {
+			break
+		}
+		for _, fetchErr := range fetches.Errors() {
+			t.logger.DebugContext(ctx, "failed to fetch records for schema usage",
+				slog.String("topic_name", fetchErr.Topic),
+				slog.Int("partition", int(fetchErr.Partition)),
+				slog.Any("error", fetchErr.Err))
+		}
+
+		fetches.EachRecord(func(record *kgo.Record) {
+			lastOffset, pending := lastOffsets[record.Topic][record.Partition]
+			if !pending {
+				return
+			}
+			// Transaction markers don't carry schema IDs, but they may be the last
+			// offset of a partition, so they still advance the scan
+			if !record.Attrs.IsControl() {
+				observe(record, record.Key, true)
+				observe(record, record.Value, false)
+			}
+			if record.Offset >= lastOffset {
+				delete(lastOffsets[record.Topic], record.Partition)
+				remaining[record.Topic]--
+				if remaining[record.Topic] == 0 {
+					pendingTopics--
+				}
+			}
+		})
+	}
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+
+	for topic, count := range remaining {
+		if count > 0 {
+			incomplete[topic] = true
+		}
+	}
+	return sightings, incomplete, nil
+}
+
+// merge updates the index with the schema IDs that have been sampled from the given
+// topics. Schema IDs of completely sampled topics that are not part of the sample
+// anymore are kept, but are no longer in the latest sample. Topics that don't exist
+// anymore are removed.
+func (t *SchemaUsageTracker) merge(topicNames []string, sightings map[string]map[int]*schemaIDSighting, incomplete map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for topic := range t.topics {
+		if !slices.Contains(topicNames, topic) {
+			delete(t.topics, topic)
+		}
+	}
+
+	for _, topic := range topicNames {
+		entries, exists := t.topics[topic]
+		if !exists {
+			entries = make(map[int]*schemaIDEntry)
+		}
+		if !incomplete[topic] {
+			for _, entry := range entries {
+				entry.inLatestSample = false
+			}
+		}
+		for schemaID, sighting := range sightings[topic] {
+			entry, exists := entries[schemaID]
+			if !exists {
+				entry = &schemaIDEntry{schemaIDSighting: *sighting}
+				entries[schemaID] = entry
+			}
+			entry.isKey = entry.isKey || sighting.isKey
+			entry.isValue = entry.isValue || sighting.isValue
+			if sighting.firstSeen.Before(entry.firstSeen) {
+				entry.firstSeen = sighting.firstSeen
+			}
+			if sighting.lastSeen.After(entry.lastSeen) {
+				entry.lastSeen = sighting.lastSeen
+			}
+			entry.inLatestSample = true
+		}
+		if len(entries) > 0 {
+			t.topics[topic] = entries
+		}
+	}
+}
+
+// snapshot returns the scan status and the index, sorted by topic name and schema ID.
+func (t *SchemaUsageTracker) snapshot() (SchemaUsageScanStatus, []TopicSchemaUsage) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	topics := make([]TopicSchemaUsage, 0, len(t.topics))
+	for topic, entries := range t.topics {
+		usage := TopicSchemaUsage{TopicName: topic, SchemaIDs: make([]SchemaIDUsage, 0, len(entries))}
+		for schemaID, entry := range entries {
+			usage.SchemaIDs = append(usage.SchemaIDs, SchemaIDUsage{
+				SchemaID:           schemaID,
+				IsKey:              entry.isKey,
+				IsValue:            entry.isValue,
+				FirstSeenTimestamp: entry.firstSeen.UnixMilli(),
+				LastSeenTimestamp:  entry.lastSeen.UnixMilli(),
+				InLatestSample:     entry.inLatestSample,
+			})
+		}
+		slices.SortFunc(usage.SchemaIDs, func(a, b SchemaIDUsage) int { return a.SchemaID - b.SchemaID })
+		topics = append(topics, usage)
+	}
+	slices.SortFunc(topics, func(a, b TopicSchemaUsage) int { return strings.Compare(a.TopicName, b.TopicName) })
+
+	return t.status, topics
+}
+
+// subjectVersionID is a registered subject version along with its schema ID.
+type subjectVersionID struct {
+	subject       string
+	version       int
+	schemaID      int
+	isSoftDeleted bool
+}
+
+// GetSchemaUsage returns which topics use the versions of all subjects, along
+// with the subjects that are not used by any topic.
+func (s *Service) GetSchemaUsage(ctx context.Context) (*SchemaUsageReport, *rest.Error) {
+	if s.schemaUsage == nil {
+		return nil, errSchemaUsageNotEnabled()
+	}
+
+	// The schemas endpoint can't tell which versions are soft-deleted,
+	// hence we compare the listings with and without deleted versions.
+	allSchemas, err := s.GetAllSchemas(ctx, GetAllSchemasOptions{Deleted: true})
+	if err != nil {
+		return nil, errorToRestError(fmt.Errorf("failed to list schemas: %w", err))
+	}
+	activeSchemas, err := s.GetAllSchemas(ctx, GetAllSchemasOptions{})
+	if err != nil {
+		return nil, errorToRestError(fmt.Errorf("failed to list schemas: %w", err))
+	}
+	isActive := make(map[SchemaVersion]bool, len(activeSchemas))
+	for _, schema := range activeSchemas {
+		isActive[SchemaVersion{Subject: schema.Subject, Version: schema.Version}] = true
+	}
+	versions := make([]subjectVersionID, len(allSchemas))
+	for i, schema := range allSchemas {
+		versions[i] = subjectVersionID{
+			subject:       schema.Subject,
+			version:       schema.Version,
+			schemaID:      schema.ID,
+			isSoftDeleted: !isActive[SchemaVersion{Subject: schema.Subject, Version: schema.Version}],
+		}
+	}
+
+	status, topics := s.schemaUsage.snapshot()
+	subjects := buildSubjectSchemaUsages(versions, topics, status.LastScanTimestamp != nil)
+	report := &SchemaUsageReport{
+		Scan:           status,
+		Subjects:       subjects,
+		UnusedSubjects: make([]string, 0),
+		Topics:         topics,
+	}
+	for _, subject := range subjects {
+		if subject.IsUnused {
+			report.UnusedSubjects = append(report.UnusedSubjects, subject.Subject)
+		}
+	}
+	return report, nil
+}
+
+// GetSubjectSchemaUsage returns which topics use the versions of the given subject.
+func (s *Service) GetSubjectSchemaUsage(ctx context.Context, subjectName string) (*SubjectSchemaUsage, *rest.Error) {
+	if s.schemaUsage == nil {
+		return nil, errSchemaUsageNotEnabled()
+	}
+
+	srClient, err := s.schemaClientFactory.GetSchemaRegistryClient(ctx)
+	if err != nil {
+		return nil, errorToRestError(err)
+	}
+	allSchemas, err := srClient.Schemas(sr.WithParams(ctx, sr.ShowDeleted), subjectName)
+	if err != nil {
+		var schemaErr *sr.ResponseError
+		if errors.As(err, &schemaErr) && schemaErr.ErrorCode == 40401 {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotFound,
+				Message:  fmt.Sprintf("Subject %q does not exist", subjectName),
+				IsSilent: false,
+			}
+		}
+		return nil, errorToRestError(fmt.Errorf("failed to retrieve versions of subject %q: %w", subjectName, err))
+	}
+	// All versions of a soft-deleted subject are soft-deleted
+	activeSchemas, err := srClient.Schemas(ctx, subjectName)
+	if err != nil {
+		var schemaErr *sr.ResponseError
+		if !errors.As(err, &schemaErr) || schemaErr.ErrorCode != 40401 {
+			return nil, errorToRestError(fmt.Errorf("failed to retrieve versions of subject %q: %w", subjectName, err))
+		}
+	}
+
+	versions := make([]subjectVersionID, len(allSchemas))
+	for i, schema := range allSchemas {
+		versions[i] = subjectVersionID{
+			subject:  schema.Subject,
+			version:  schema.Version,
+			schemaID: schema.ID,
+			isSoftDeleted: !slices.ContainsFunc(activeSchemas, func(active sr.SubjectSchema) bool {
+				return active.Version == schema.Version
+			}),
+		}
+	}
+
+	status, topics := s.schemaUsage.snapshot()
+	subjects := buildSubjectSchemaUsages(versions, topics, status.LastScanTimestamp != nil)
+	if len(subjects) == 0 {
+		return &SubjectSchemaUsage{Subject: subjectName, Versions: make([]SchemaVersionUsage, 0)}, nil
+	}
+	return &subjects[0], nil
+}
+
+// buildSubjectSchemaUsages maps the schema IDs found in the topics to the subject
+// versions. Subjects are only reported as unused once a scan has finished.
+func buildSubjectSchemaUsages(versions []subjectVersionID, topics []TopicSchemaUsage, hasScanned bool) []SubjectSchemaUsage {
+	topicsBySchemaID := make(map[int][]SchemaTopicUsage)
+	for _, topic := range topics {
+		for _, usage := range topic.SchemaIDs {
+			topicsBySchemaID[usage.SchemaID] = append(topicsBySchemaID[usage.SchemaID], SchemaTopicUsage{
+				TopicName:         topic.TopicName,
+				IsKey:             usage.IsKey,
+				IsValue:           usage.IsValue,
+				LastSeenTimestamp: usage.LastSeenTimestamp,
+				InLatestSample:    usage.InLatestSample,
+			})
+		}
+	}
+
+	subjects := make([]SubjectSchemaUsage, 0)
+	indexBySubject := make(map[string]int)
+	for _, version := range versions {
+		idx, exists := indexBySubject[version.subject]
+		if !exists {
+			idx = len(subjects)
+			indexBySubject[version.subject] = idx
+			subjects = append(subjects, SubjectSchemaUsage{
+				Subject:  version.subject,
+				IsUnused: hasScanned,
+				Versions: make([]SchemaVersionUsage, 0),
+			})
+		}
+
+		usage := SchemaVersionUsage{
+			Version:       version.version,
+			SchemaID:      version.schemaID,
+			IsSoftDeleted: version.isSoftDeleted,
+			Topics:        make([]SchemaTopicUsage, 0),
+		}
+		for _, topic := range topicsBySchemaID[version.schemaID] {
+			usage.Topics = append(usage.Topics, topic)
+			usage.IsProduced = usage.IsProduced || topic.InLatestSample
+			if usage.LastSeenTimestamp == nil || topic.LastSeenTimestamp > *usage.LastSeenTimestamp {
+				lastSeen := topic.LastSeenTimestamp
+				usage.LastSeenTimestamp = &lastSeen
+			}
+		}
+		if len(usage.Topics) > 0 {
+			subjects[idx].IsUnused = false
+		}
+		subjects[idx].Versions = append(subjects[idx].Versions, usage)
+	}
+
+	slices.SortFunc(subjects, func(a, b SubjectSchemaUsage) int { return strings.Compare(a.Subject, b.Subject) })
+	for _, subject := range subjects {
+		slices.SortFunc(subject.Versions, func(a, b SchemaVersionUsage) int { return a.Version - b.Version })
+	}
+	return subjects
+}
+
+func errSchemaUsageNotEnabled() *rest.Error {
+	return &rest.Error{
+		Err:      errors.New("schema usage tracking is not enabled"),
+		Status:   http.StatusNotImplemented,
+		Message:  "Schema usage tracking is not enabled. Set console.schemaUsage.enabled to track which topics use which schemas",
+		IsSilent: true,
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+type staticKafkaClientFactory struct {
+	cl *kgo.Client
+}
+
+func (f staticKafkaClientFactory) GetKafkaClient(context.Context) (*kgo.Client, *kadm.Client, error) {
+	return f.cl, kadm.NewClient(f.cl), nil
+}
+
+func TestSchemaUsageTracker(t *testing.T) {
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(2, "orders", "payments"))
+	require.NoError(t, err)
+	defer fakeCluster.Close()
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...), kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	require.NoError(t, err)
+	defer cl.Close()
+
+	var header sr.ConfluentHeader
+	encode := func(schemaID int, payload string) []byte {
+		b, err := header.AppendEncode(nil, schemaID, nil)
+		require.NoError(t, err)
+		return append(b, payload...)
+	}
+	produce := func(records ...*kgo.Record) {
+		require.NoError(t, cl.ProduceSync(t.Context(), records...).FirstErr())
+	}
+
+	base := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	produce(
+		&kgo.Record{Topic: "orders", Partition: 0, Key: encode(1, "key"), Value: encode(2, "v1"), Timestamp: base},
+		&kgo.Record{Topic: "orders", Partition: 0, Value: encode(3, "v2"), Timestamp: base.Add(time.Minute)},
+		&kgo.Record{Topic: "payments", Partition: 1, Value: []byte(`{"plain":"json"}`), Timestamp: base},
+	)
+
+	cfg := config.ConsoleSchemaUsage{}
+	cfg.SetDefaults()
+	tracker := NewSchemaUsageTracker(staticKafkaClientFactory{cl}, slog.New(slog.DiscardHandler), cfg)
+	tracker.scan(t.Context())
+
+	status, topics := tracker.snapshot()
+	require.NotNil(t, status.LastScanTimestamp)
+	assert.Empty(t, status.LastScanError)
+	assert.Equal(t, 2, status.ScannedTopics)
+	assert.Equal(t, []TopicSchemaUsage{
+		{
+			TopicName: "orders",
+			SchemaIDs: []SchemaIDUsage{
+				{SchemaID: 1, IsKey: true, FirstSeenTimestamp: base.UnixMilli(), LastSeenTimestamp: base.UnixMilli(), InLatestSample: true},
+				{SchemaID: 2, IsValue: true, FirstSeenTimestamp: base.UnixMilli(), LastSeenTimestamp: base.UnixMilli(), InLatestSample: true},
+				{SchemaID: 3, IsValue: true, FirstSeenTimestamp: base.Add(time.Minute).UnixMilli(), LastSeenTimestamp: base.Add(time.Minute).UnixMilli(), InLatestSample: true},
+			},
+		},
+	}, topics)
+
+	t.Run("schema ids that are no longer sampled are kept", func(t *testing.T) {
+		tracker.cfg.RecordsPerTopic = 1
+		produce(&kgo.Record{Topic: "orders", Partition: 0, Value: encode(3, "v2"), Timestamp: base.Add(2 * time.Minute)})
+		tracker.scan(t.Context())
+
+		_, topics := tracker.snapshot()
+		require.Len(t, topics, 1)
+		ids := topics[0].SchemaIDs
+		require.Len(t, ids, 3)
+		assert.False(t, ids[0].InLatestSample)
+		assert.False(t, ids[1].InLatestSample)
+		assert.True(t, ids[2].InLatestSample)
+		assert.Equal(t, base.Add(time.Minute).UnixMilli(), ids[2].FirstSeenTimestamp)
+		assert.Equal(t, base.Add(2*time.Minute).UnixMilli(), ids[2].LastSeenTimestamp)
+	})
+
+	t.Run("deleted topics are removed", func(t *testing.T) {
+		tracker.merge([]string{"payments"}, nil, nil)
+
+		_, topics := tracker.snapshot()
+		assert.Empty(t, topics)
+	})
+}
+
+func TestBuildSubjectSchemaUsages(t *testing.T) {
+	versions := []subjectVersionID{
+		{subject: "orders-value", version: 2, schemaID: 3},
+		{subject: "orders-value", version: 1, schemaID: 2, isSoftDeleted: true},
+		{subject: "legacy-value", version: 1, schemaID: 7},
+	}
+	topics := []TopicSchemaUsage{
+		{
+			TopicName: "orders",
+			SchemaIDs: []SchemaIDUsage{
+				{SchemaID: 2, IsValue: true, LastSeenTimestamp: 100},
+				{SchemaID: 3, IsValue: true, LastSeenTimestamp: 200, InLatestSample: true},
+			},
+		},
+		{
+			TopicName: "orders-backup",
+			SchemaIDs: []SchemaIDUsage{{SchemaID: 3, IsValue: true, LastSeenTimestamp: 300}},
+		},
+	}
+	ts := func(v int64) *int64 { return &v }
+
+	assert.Equal(t, []SubjectSchemaUsage{
+		{
+			Subject:  "legacy-value",
+			IsUnused: true,
+			Versions: []SchemaVersionUsage{{Version: 1, SchemaID: 7, Topics: []SchemaTopicUsage{}}},
+		},
+		{
+			Subject: "orders-value",
+			Versions: []SchemaVersionUsage{
+				{
+					Version:           1,
+					SchemaID:          2,
+					IsSoftDeleted:     true,
+					LastSeenTimestamp: ts(100),
+					Topics:            []SchemaTopicUsage{{TopicName: "orders", IsValue: true, LastSeenTimestamp: 100}},
+				},
+				{
+					Version:           2,
+					SchemaID:          3,
+					IsProduced:        true,
+					LastSeenTimestamp: ts(300),
+					Topics: []SchemaTopicUsage{
+						{TopicName: "orders", IsValue: true, LastSeenTimestamp: 200, InLatestSample: true},
+						{TopicName: "orders-backup", IsValue: true, LastSeenTimestamp: 300},
+					},
+				},
+			},
+		},
+	}, buildSubjectSchemaUsages(versions, topics, true))
+
+	// Subjects are not reported as unused before the first scan has finished
+	subjects := buildSubjectSchemaUsages(versions, nil, false)
+	require.Len(t, subjects, 2)
+	assert.False(t, subjects[0].IsUnused)
+	assert.False(t, subjects[1].IsUnused)
+}
@@ -15,6 +15,8 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redpanda-data/common-go/rpsr"
 	"github.com/twmb/franz-go/pkg/kerr"
 	"github.com/twmb/franz-go/pkg/kgo"
 	"github.com/twmb/franz-go/pkg/kmsg"
@@ -32,6 +34,7 @@ import (
 	"github.com/redpanda-data/console/backend/pkg/msgpack"
 	"github.com/redpanda-data/console/backend/pkg/proto"
 	schemacache "github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/schema/lint"
 	"github.com/redpanda-data/console/backend/pkg/serde"
 )
 
@@ -48,6 +51,9 @@ type Service struct {
 	cachedSchemaClient    schemacache.Client
 	serdeSvc              *serde.Service
 	protoSvc              *proto.Service
+	schemaUsage           *SchemaUsageTracker // nil if schema usage tracking is disabled
+	schemaSyncClients     map[string]*rpsr.Client
+	schemaLinter          *lint.Linter // nil if schema linting is disabled
 	logger                *slog.Logger
 	cfg                   *config.Config
 
@@ -66,6 +72,7 @@ func NewService(
 	redpandaClientFactory redpandafactory.ClientFactory,
 	cacheNamespaceFn func(context.Context) (string, error),
 	connectSvc *connect.Service,
+	metricsRegistry prometheus.Registerer,
 ) (Servicer, error) {
 	var gitSvc *git.Service
 	cfg.Console.TopicDocumentation.Git.AllowedFileExtensions = []string{"md"}
@@ -98,14 +105,6 @@ func NewService(
 		}
 	}
 
-	var cachedSchemaClient schemacache.Client
-	if cfg.SchemaRegistry.Enabled {
-		cachedSchemaClient, err = schemacache.NewCachedClient(schemaClientFactory, cacheNamespaceFn)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create schema client: %w", err)
-		}
-	}
-
 	var bsrClient *bsr.Client
 	if cfg.Serde.Protobuf.BufSchemaRegistry.Enabled {
 		bsrClient, err = bsr.NewClient(cfg.Serde.Protobuf.BufSchemaRegistry, loggerpkg.Named(logger, "bsr_client"))
@@ -114,10 +113,55 @@ func NewService(
 		}
 	}
 
+	var cachedSchemaClient schemacache.Client
+	if cfg.SchemaRegistry.Enabled {
+		protoImportSources, err := newProtoImportSources(cfg.SchemaRegistry.ProtobufImports, protoSvc, bsrClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create protobuf import sources: %w", err)
+		}
+		cachedSchemaClient, err = schemacache.NewCachedClient(schemaClientFactory, cacheNamespaceFn,
+			schemacache.WithProtoImportSources(protoImportSources...))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema client: %w", err)
+		}
+	}
+
 	serdeSvc, err := serde.NewService(protoSvc, msgPackSvc, cachedSchemaClient, bsrClient, cfg.Serde.Cbor)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating serde service: %w", err)
 	}
+	if cfg.Serde.JSONValidation.Enabled && cachedSchemaClient != nil {
+		serdeSvc.EnableJSONValidation(cachedSchemaClient, cfg.Serde.JSONValidation)
+	}
+	if cfg.Serde.DetectionCache.Enabled {
+		serdeSvc.DetectionCache = serde.NewDetectionCache(cfg.Serde.DetectionCache.MaxEntries, cfg.MetricsNamespace)
+		if metricsRegistry != nil {
+			if err := metricsRegistry.Register(serdeSvc.DetectionCache); err != nil {
+				return nil, fmt.Errorf("failed to register serde detection cache metrics: %w", err)
+			}
+		}
+	}
+
+	var schemaUsage *SchemaUsageTracker
+	if cfg.Console.SchemaUsage.Enabled {
+		schemaUsage = NewSchemaUsageTracker(kafkaClientFactory, logger, cfg.Console.SchemaUsage)
+	}
+
+	var schemaSyncClients map[string]*rpsr.Client
+	if cfg.SchemaRegistry.Enabled && cfg.SchemaRegistry.Sync.Enabled {
+		schemaSyncClients, err = newSchemaSyncClients(cfg.SchemaRegistry.Sync)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema sync clients: %w", err)
+		}
+	}
+
+	var schemaLinter *lint.Linter
+	if cfg.SchemaRegistry.Enabled && cfg.SchemaRegistry.Lint.Enabled {
+		schemaLinter, err = lint.NewLinter(cfg.SchemaRegistry.Lint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema linter: %w", err)
+		}
+	}
 
 	return &Service{
 		kafkaClientFactory:    kafkaClientFactory,
@@ -128,6 +172,9 @@ func NewService(
 		cachedSchemaClient:    cachedSchemaClient,
 		serdeSvc:              serdeSvc,
 		protoSvc:              protoSvc,
+		schemaUsage:           schemaUsage,
+		schemaSyncClients:     schemaSyncClients,
+		schemaLinter:          schemaLinter,
 		logger:                logger,
 		cfg:                   cfg,
 
@@ -155,12 +202,19 @@ func (s *Service) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to test kafka connectivity: %w", err)
 	}
 
+	if s.schemaUsage != nil {
+		s.schemaUsage.Start(ctx)
+	}
+
 	return nil
 }
 
 // Stop stops running go routines and releases allocated resources.
-func (*Service) Stop() {
-	// Nothing to stop, the gitSvc listens for OS signals itself and stops its goroutines then.
+func (s *Service) Stop() {
+	// The gitSvc listens for OS signals itself and stops its goroutines then.
+	if s.schemaUsage != nil {
+		s.schemaUsage.Stop()
+	}
 }
 
 func (s *Service) testKafkaConnectivity(ctx context.Context) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/twmb/franz-go/pkg/kmsg"
 	"github.com/twmb/franz-go/pkg/sr"
 
+	"github.com/redpanda-data/console/backend/pkg/schema/compatibility"
 	"github.com/redpanda-data/console/backend/pkg/serde"
 )
 
@@ -34,6 +35,7 @@ type Servicer interface {
 	GetEndpointCompatibility(ctx context.Context) (EndpointCompatibility, error)
 	IncrementalAlterConfigs(ctx context.Context, alterConfigs []kmsg.IncrementalAlterConfigsRequestResource) ([]IncrementalAlterConfigsResourceResponse, *rest.Error)
 	ListAllACLs(ctx context.Context, req kmsg.DescribeACLsRequest) (*ACLOverview, error)
+	SimulateACLPermissions(ctx context.Context, req ACLSimulationRequest) (*ACLSimulationResponse, error)
 	ListMessages(ctx context.Context, listReq ListMessageRequest, progress IListMessagesProgress) error
 	ListOffsets(ctx context.Context, topicNames []string, timestamp int64) ([]TopicOffset, error)
 	GetKafkaVersion(ctx context.Context) (string, error)
@@ -42,6 +44,7 @@ type Servicer interface {
 	ProducePlainRecords(ctx context.Context, records []*kgo.Record, useTransactions bool, compressionOpts []kgo.CompressionCodec) ProduceRecordsResponse
 	ProduceRecord(context.Context, string, int32, []kgo.RecordHeader, *serde.RecordPayloadInput, *serde.RecordPayloadInput, bool, []kgo.CompressionCodec) (*ProduceRecordResponse, error)
 	GenerateSchemaSampleJSON(ctx context.Context, schemaID int, indexPath []int) ([]byte, error)
+	GenerateRecords(ctx context.Context, req GenerateRecordsRequest) (*GenerateRecordsResponse, *rest.Error)
 	Start(ctx context.Context) error
 	Stop()
 	GetTopicConfigs(ctx context.Context, topicName string, configNames []string) (*TopicConfig, *rest.Error)
@@ -51,6 +54,12 @@ type Servicer interface {
 	GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error)
 	GetAllTopicNames(ctx context.Context) ([]string, error)
 	GetTopicDetails(ctx context.Context, topicNames []string) ([]TopicDetails, *rest.Error)
+	AnalyzeTopicUsage(ctx context.Context, req TopicUsageRequest) (*TopicUsageReport, error)
+	GetTopicHealth(ctx context.Context, req TopicHealthRequest) (*TopicHealth, *rest.Error)
+	GetLineageGraph(ctx context.Context, req LineageGraphRequest) (*LineageGraph, *rest.Error)
+	GetConnectorOffsets(ctx context.Context, clusterName string, connectorName string) (*ConnectorOffsetsOverview, *rest.Error)
+	GetConnectorDLQ(ctx context.Context, clusterName, connectorName string, messageCount int) (*ConnectorDLQ, *rest.Error)
+	ReplayConnectorDLQRecords(ctx context.Context, clusterName, connectorName string, refs []ConnectorDLQRecordRef) (*ConnectorDLQReplayResult, *rest.Error)
 
 	// ------------------------------------------------------------------
 	// Plain Kafka requests, used by Connect API.
@@ -107,8 +116,13 @@ type SchemaRegistryServicer interface {
 	GetSchemaRegistrySchemaTypes(ctx context.Context) (*SchemaRegistrySchemaTypes, error)
 	CreateSchemaRegistrySchema(ctx context.Context, subjectName string, schema sr.Schema, params CreateSchemaRequestParams) (*CreateSchemaResponse, error)
 	ValidateSchemaRegistrySchema(ctx context.Context, subjectName string, version int, schema sr.Schema) (*SchemaRegistrySchemaValidation, error)
+	CheckSchemaRegistrySchemaCompatibility(ctx context.Context, subjectName string, schema sr.Schema, level *sr.CompatibilityLevel) (*compatibility.Result, error)
+	DiffSchemaRegistrySchemas(ctx context.Context, fromSubject string, fromVersion int, toSubject string, toVersion int) (*SchemaRegistrySchemaDiff, error)
+	GetSchemaUsage(ctx context.Context) (*SchemaUsageReport, *rest.Error)
+	GetSubjectSchemaUsage(ctx context.Context, subjectName string) (*SubjectSchemaUsage, *rest.Error)
 	GetSchemaUsagesByID(ctx context.Context, schemaID int, subject string) ([]SchemaVersion, error)
 	GetSchemaRegistryContexts(ctx context.Context) ([]SchemaRegistryContext, error)
+	SyncSchemaRegistry(ctx context.Context, req SchemaRegistrySyncRequest) (*SchemaRegistrySyncResult, *rest.Error)
 
 	// Custom Redpanda-only methods for managing ACLs within the schema registry.
 
@@ -0,0 +1,437 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const (
+	// hotPartitionFactor is the factor by which a partition must exceed the mean
+	// across all partitions to be reported as hot.
+	hotPartitionFactor = 2.0
+	// topHotKeysCount is the number of most frequent keys reported in the key distribution.
+	topHotKeysCount = 10
+	// maxHotKeyLength is the max number of bytes of a key that will be returned.
+	maxHotKeyLength = 128
+	// keySampleTimeout is the max time we wait for sampled records to be fetched.
+	keySampleTimeout = 10 * time.Second
+)
+
+// TopicHealthRequest configures the skew and hot partition analysis of a topic.
+type TopicHealthRequest struct {
+	TopicName string
+	// SampleWindow is the duration between the two watermark snapshots that
+	// are used to calculate the produce rate.
+	SampleWindow time.Duration
+	// KeySampleSize is the max number of recent records whose keys are hashed
+	// to determine the key distribution. Set to 0 to skip the key analysis.
+	KeySampleSize int
+}
+
+// TopicHealth describes how evenly data and traffic are distributed across a
+// topic's partitions.
+type TopicHealth struct {
+	TopicName      string            `json:"topicName"`
+	PartitionCount int               `json:"partitionCount"`
+	Partitions     []PartitionHealth `json:"partitions"`
+
+	MessageSkew SkewStats        `json:"messageSkew"`
+	ByteSkew    SkewStats        `json:"byteSkew"`
+	ProduceRate ProduceRateStats `json:"produceRate"`
+
+	// HotPartitions are the partition IDs whose message count, size or produce
+	// rate exceed the mean across all partitions by the hot partition factor.
+	HotPartitions   []int32          `json:"hotPartitions"`
+	KeyDistribution *KeyDistribution `json:"keyDistribution,omitempty"`
+}
+
+// PartitionHealth contains the metrics of a single partition that are used to
+// compute the topic's skew.
+type PartitionHealth struct {
+	PartitionID       int32   `json:"partitionId"`
+	Leader            int32   `json:"leader"`
+	MessageCount      int64   `json:"messageCount"`
+	SizeBytes         int64   `json:"sizeBytes"`
+	MessagesPerSecond float64 `json:"messagesPerSecond"`
+	Error             string  `json:"error,omitempty"`
+}
+
+// SkewStats summarizes the distribution of a metric across partitions.
+type SkewStats struct {
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stdDev"`
+	// CoefficientOfVariation is the standard deviation divided by the mean. A value
+	// of 0 means all partitions are perfectly balanced.
+	CoefficientOfVariation float64 `json:"coefficientOfVariation"`
+	// MaxToMeanRatio is the max value divided by the mean.
+	MaxToMeanRatio float64 `json:"maxToMeanRatio"`
+}
+
+// ProduceRateStats is the produce rate sampled from two watermark snapshots.
+type ProduceRateStats struct {
+	WindowMs                int64     `json:"windowMs"`
+	TotalMessagesPerSecond  float64   `json:"totalMessagesPerSecond"`
+	PartitionMessagesPerSec SkewStats `json:"partitionMessagesPerSecond"`
+}
+
+// KeyDistribution describes how sampled record keys are distributed across
+// partitions, if partitioned with Kafka's default murmur2 partitioner.
+type KeyDistribution struct {
+	SampledRecords int `json:"sampledRecords"`
+	NullKeys       int `json:"nullKeys"`
+	DistinctKeys   int `json:"distinctKeys"`
+	// MismatchedRecords is the number of sampled records that are not stored in
+	// the partition the murmur2 partitioner would choose. A high number indicates
+	// that producers use a custom partitioner.
+	MismatchedRecords int `json:"mismatchedRecords"`
+	// RecordsByPartition is the number of sampled keyed records per partition
+	// according to the murmur2 partitioner.
+	RecordsByPartition map[int32]int `json:"recordsByPartition"`
+	Skew               SkewStats     `json:"skew"`
+	HotKeys            []HotKey      `json:"hotKeys"`
+}
+
+// HotKey is one of the most frequent keys within the sampled records.
+type HotKey struct {
+	Key       string  `json:"key"`
+	IsHex     bool    `json:"isHex"`
+	Count     int     `json:"count"`
+	Share     float64 `json:"share"`
+	Partition int32   `json:"partition"`
+}
+
+// GetTopicHealth computes message count and byte skew across a topic's partitions,
+// samples the produce rate from watermark deltas and hashes the keys of recent
+// records to find keys that overload single partitions.
+func (s *Service) GetTopicHealth(ctx context.Context, req TopicHealthRequest) (*TopicHealth, *rest.Error) {
+	cl, adminCl, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return nil, errorToRestError(err)
+	}
+
+	topicDetails, restErr := s.GetTopicDetails(ctx, []string{req.TopicName})
+	if restErr != nil {
+		return nil, restErr
+	}
+	if len(topicDetails) != 1 {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("expected exactly one topic detail in response, but got '%d'", len(topicDetails)),
+			Status:   http.StatusInternalServerError,
+			Message:  "Failed to describe topic partitions",
+			IsSilent: false,
+		}
+	}
+	details := topicDetails[0]
+	if details.Error != "" {
+		return nil, &rest.Error{
+			Err:      errors.New(details.Error),
+			Status:   http.StatusNotFound,
+			Message:  details.Error,
+			IsSilent: false,
+		}
+	}
+
+	// 1. Message count and size per partition
+	health := &TopicHealth{
+		TopicName:      req.TopicName,
+		PartitionCount: len(details.Partitions),
+		Partitions:     make([]PartitionHealth, len(details.Partitions)),
+		HotPartitions:  make([]int32, 0),
+	}
+	partitionIdx := make(map[int32]int, len(details.Partitions))
+	for i, partition := range details.Partitions {
+		ph := PartitionHealth{
+			PartitionID: partition.ID,
+			Leader:      partition.Leader,
+			SizeBytes:   partitionSize(partition),
+		}
+		switch {
+		case partition.PartitionError != "":
+			ph.Error = partition.PartitionError
+		case partition.WaterMarksError != "":
+			ph.Error = partition.WaterMarksError
+		default:
+			ph.MessageCount = partition.WaterMarkHigh - partition.WaterMarkLow
+		}
+		health.Partitions[i] = ph
+		partitionIdx[partition.ID] = i
+	}
+
+	// 2. Produce rate from the delta of two high watermark snapshots
+	if req.SampleWindow > 0 {
+		rates, window, err := s.sampleProduceRates(ctx, adminCl, req.TopicName, req.SampleWindow)
+		if err != nil {
+			return nil, errorToRestError(err)
+		}
+		health.ProduceRate.WindowMs = window.Milliseconds()
+		for partitionID, rate := range rates {
+			idx, exists := partitionIdx[partitionID]
+			if !exists {
+				continue
+			}
+			health.Partitions[idx].MessagesPerSecond = rate
+			health.ProduceRate.TotalMessagesPerSecond += rate
+		}
+	}
+
+	// 3. Skew stats and hot partitions
+	messageCounts := make([]float64, len(health.Partitions))
+	sizes := make([]float64, len(health.Partitions))
+	rates := make([]float64, len(health.Partitions))
+	for i, ph := range health.Partitions {
+		messageCounts[i] = float64(ph.MessageCount)
+		sizes[i] = float64(ph.SizeBytes)
+		rates[i] = ph.MessagesPerSecond
+	}
+	health.MessageSkew = computeSkewStats(messageCounts)
+	health.ByteSkew = computeSkewStats(sizes)
+	health.ProduceRate.PartitionMessagesPerSec = computeSkewStats(rates)
+	for i, ph := range health.Partitions {
+		if isHotValue(messageCounts[i], health.MessageSkew) ||
+			isHotValue(sizes[i], health.ByteSkew) ||
+			isHotValue(rates[i], health.ProduceRate.PartitionMessagesPerSec) {
+			health.HotPartitions = append(health.HotPartitions, ph.PartitionID)
+		}
+	}
+
+	// 4. Key distribution of recent records
+	if req.KeySampleSize > 0 {
+		records := s.sampleRecentRecords(ctx, cl, req.TopicName, details.Partitions, req.KeySampleSize)
+		health.KeyDistribution = computeKeyDistribution(records, len(details.Partitions))
+	}
+
+	return health, nil
+}
+
+// sampleProduceRates lists the high watermarks of all partitions twice, separated by
+// the given window, and returns the produce rate in messages per second by partition.
+func (*Service) sampleProduceRates(ctx context.Context, adminCl *kadm.Client, topicName string, window time.Duration) (map[int32]float64, time.Duration, error) {
+	before, err := adminCl.ListEndOffsets(ctx, topicName)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list topic end offsets: %w", err)
+	}
+	startedAt := time.Now()
+
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	case <-time.After(window):
+	}
+
+	after, err := adminCl.ListEndOffsets(ctx, topicName)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list topic end offsets: %w", err)
+	}
+	elapsed := time.Since(startedAt)
+
+	rates := make(map[int32]float64)
+	after.Each(func(end kadm.ListedOffset) {
+		start, exists := before.Lookup(end.Topic, end.Partition)
+		if !exists || start.Err != nil || end.Err != nil {
+			return
+		}
+		rates[end.Partition] = float64(max(end.Offset-start.Offset, 0)) / elapsed.Seconds()
+	})
+
+	return rates, elapsed, nil
+}
+
+// partitionSize returns the size of the leader's replica. If the leader's log dir
+// could not be described, the largest reported replica size is returned.
+func partitionSize(partition TopicPartitionDetails) int64 {
+	var size int64
+	for _, logDir := range partition.PartitionLogDirs {
+		if logDir.Error != "" {
+			continue
+		}
+		if logDir.BrokerID == partition.Leader {
+			return logDir.Size
+		}
+		size = max(size, logDir.Size)
+	}
+	return size
+}
+
+// sampleRecentRecords consumes up to sampleSize of the most recent records,
+// spread evenly across all partitions.
+func (s *Service) sampleRecentRecords(ctx context.Context, cl *kgo.Client, topicName string, partitions []TopicPartitionDetails, sampleSize int) []*kgo.Record {
+	perPartition := int64(math.Ceil(float64(sampleSize) / float64(max(len(partitions), 1))))
+
+	partitionOffsets := make(map[int32]kgo.Offset)
+	endOffsets := make(map[int32]int64)
+	for _, partition := range partitions {
+		if partition.PartitionError != "" || partition.WaterMarksError != "" || partition.WaterMarkHigh <= partition.WaterMarkLow {
+			continue
+		}
+		start := max(partition.WaterMarkHigh-perPartition, partition.WaterMarkLow)
+		partitionOffsets[partition.ID] = kgo.NewOffset().At(start)
+		endOffsets[partition.ID] = partition.WaterMarkHigh - 1
+	}
+	if len(partitionOffsets) == 0 {
+		return nil
+	}
+
+	opts := append(cl.Opts(), kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{topicName: partitionOffsets}))
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to create kafka client for sampling records", slog.Any("error", err))
+		return nil
+	}
+	defer client.Close()
+
+	fetchCtx, cancel := context.WithTimeout(ctx, keySampleTimeout)
+	defer cancel()
+
+	records := make([]*kgo.Record, 0, sampleSize)
+	remaining := len(endOffsets)
+	for remaining > 0 && len(records) < sampleSize {
+		fetches := client.PollFetches(fetchCtx)
+		if fetchCtx.Err() != nil {
+			break
+		}
+		fetches.EachRecord(func(record *kgo.Record) {
+			end, pending := endOffsets[record.Partition]
+			if !pending || len(records) >= sampleSize {
+				return
+			}
+			records = append(records, record)
+			if record.Offset >= end {
+				delete(endOffsets, record.Partition)
+				remaining--
+			}
+		})
+	}
+
+	return records
+}
+
+// computeKeyDistribution hashes the keys of the given records with Kafka's default
+// murmur2 partitioner and summarizes how the keys are spread across partitions.
+func computeKeyDistribution(records []*kgo.Record, partitionCount int) *KeyDistribution {
+	dist := &KeyDistribution{
+		SampledRecords:     len(records),
+		RecordsByPartition: make(map[int32]int, partitionCount),
+		HotKeys:            make([]HotKey, 0),
+	}
+	if partitionCount == 0 {
+		return dist
+	}
+
+	partitioner := kgo.StickyKeyPartitioner(nil).ForTopic("")
+	countByKey := make(map[string]int)
+	partitionByKey := make(map[string]int32)
+	keyed := 0
+	for _, record := range records {
+		if record.Key == nil {
+			dist.NullKeys++
+			continue
+		}
+		keyed++
+		partition := int32(partitioner.Partition(&kgo.Record{Key: record.Key}, partitionCount))
+		if partition != record.Partition {
+			dist.MismatchedRecords++
+		}
+		dist.RecordsByPartition[partition]++
+		countByKey[string(record.Key)]++
+		partitionByKey[string(record.Key)] = partition
+	}
+	dist.DistinctKeys = len(countByKey)
+
+	counts := make([]float64, partitionCount)
+	for partition, count := range dist.RecordsByPartition {
+		counts[partition] = float64(count)
+	}
+	dist.Skew = computeSkewStats(counts)
+
+	keys := make([]string, 0, len(countByKey))
+	for key := range countByKey {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if countByKey[keys[i]] != countByKey[keys[j]] {
+			return countByKey[keys[i]] > countByKey[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys[:min(len(keys), topHotKeysCount)] {
+		displayKey, isHex := displayRecordKey([]byte(key))
+		dist.HotKeys = append(dist.HotKeys, HotKey{
+			Key:       displayKey,
+			IsHex:     isHex,
+			Count:     countByKey[key],
+			Share:     float64(countByKey[key]) / float64(keyed),
+			Partition: partitionByKey[key],
+		})
+	}
+
+	return dist
+}
+
+// displayRecordKey returns a printable representation of a record key. Keys that are
+// not valid UTF-8 are hex encoded.
+func displayRecordKey(key []byte) (string, bool) {
+	if len(key) > maxHotKeyLength {
+		key = key[:maxHotKeyLength]
+	}
+	if utf8.Valid(key) {
+		return string(key), false
+	}
+	return hex.EncodeToString(key), true
+}
+
+// computeSkewStats returns the distribution stats of the given values.
+func computeSkewStats(values []float64) SkewStats {
+	if len(values) == 0 {
+		return SkewStats{}
+	}
+
+	stats := SkewStats{Min: values[0], Max: values[0]}
+	var sum float64
+	for _, v := range values {
+		stats.Min = math.Min(stats.Min, v)
+		stats.Max = math.Max(stats.Max, v)
+		sum += v
+	}
+	stats.Mean = sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - stats.Mean) * (v - stats.Mean)
+	}
+	stats.StdDev = math.Sqrt(variance / float64(len(values)))
+
+	if stats.Mean > 0 {
+		stats.CoefficientOfVariation = stats.StdDev / stats.Mean
+		stats.MaxToMeanRatio = stats.Max / stats.Mean
+	}
+
+	return stats
+}
+
+// isHotValue returns whether the value exceeds the mean by the hot partition factor.
+func isHotValue(value float64, stats SkewStats) bool {
+	return stats.Mean > 0 && value > stats.Mean*hotPartitionFactor
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestComputeSkewStats(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, SkewStats{}, computeSkewStats(nil))
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		stats := computeSkewStats([]float64{10, 10, 10, 10})
+		assert.InDelta(t, 10, stats.Mean, 0.0001)
+		assert.InDelta(t, 0, stats.StdDev, 0.0001)
+		assert.InDelta(t, 0, stats.CoefficientOfVariation, 0.0001)
+		assert.InDelta(t, 1, stats.MaxToMeanRatio, 0.0001)
+	})
+
+	t.Run("one hot partition", func(t *testing.T) {
+		stats := computeSkewStats([]float64{0, 0, 0, 100})
+		assert.InDelta(t, 25, stats.Mean, 0.0001)
+		assert.InDelta(t, 0, stats.Min, 0.0001)
+		assert.InDelta(t, 100, stats.Max, 0.0001)
+		assert.InDelta(t, 4, stats.MaxToMeanRatio, 0.0001)
+		assert.True(t, isHotValue(100, stats))
+		assert.False(t, isHotValue(0, stats))
+	})
+}
+
+func TestComputeKeyDistribution(t *testing.T) {
+	const partitionCount = 6
+	// Partitions of Kafka's murmur2 test vectors (see Kafka's UtilsTest), computed
+	// as toPositive(murmur2(key)) % 6
+	records := []*kgo.Record{
+		{Key: []byte("abc"), Partition: 3},
+		{Key: []byte("abc"), Partition: 3},
+		{Key: []byte("abc"), Partition: 3},
+		{Key: []byte("a-little-bit-long-string"), Partition: 2},
+		{Key: nil, Partition: 0},
+		{Key: []byte{0xff, 0xfe}, Partition: -1}, // never matches the hashed partition
+	}
+
+	dist := computeKeyDistribution(records, partitionCount)
+	assert.Equal(t, 6, dist.SampledRecords)
+	assert.Equal(t, 1, dist.NullKeys)
+	assert.Equal(t, 3, dist.DistinctKeys)
+	assert.Equal(t, 1, dist.MismatchedRecords)
+
+	total := 0
+	for _, count := range dist.RecordsByPartition {
+		total += count
+	}
+	assert.Equal(t, 5, total)
+
+	require.Len(t, dist.HotKeys, 3)
+	assert.Equal(t, "abc", dist.HotKeys[0].Key)
+	assert.Equal(t, 3, dist.HotKeys[0].Count)
+	assert.InDelta(t, 0.6, dist.HotKeys[0].Share, 0.0001)
+	assert.Equal(t, int32(3), dist.HotKeys[0].Partition)
+
+	var hexKey *HotKey
+	for i := range dist.HotKeys {
+		if dist.HotKeys[i].IsHex {
+			hexKey = &dist.HotKeys[i]
+		}
+	}
+	require.NotNil(t, hexKey)
+	assert.Equal(t, "fffe", hexKey.Key)
+}
+
+func TestComputeKeyDistributionMurmur2Vectors(t *testing.T) {
+	// Kafka's murmur2 test vectors with their partitions for 6 and 10 partitions
+	tests := []struct {
+		key           string
+		partitionOf6  int32
+		partitionOf10 int32
+	}{
+		{key: "21", partitionOf6: 0, partitionOf10: 0},
+		{key: "foobar", partitionOf6: 0, partitionOf10: 6},
+		{key: "a-little-bit-long-string", partitionOf6: 2, partitionOf10: 2},
+		{key: "a-little-bit-longer-string", partitionOf6: 5, partitionOf10: 9},
+		{key: "abc", partitionOf6: 3, partitionOf10: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			records := []*kgo.Record{{Key: []byte(tt.key), Partition: tt.partitionOf6}}
+			dist := computeKeyDistribution(records, 6)
+			assert.Equal(t, map[int32]int{tt.partitionOf6: 1}, dist.RecordsByPartition)
+			assert.Zero(t, dist.MismatchedRecords)
+
+			records = []*kgo.Record{{Key: []byte(tt.key), Partition: tt.partitionOf10}}
+			dist = computeKeyDistribution(records, 10)
+			assert.Equal(t, map[int32]int{tt.partitionOf10: 1}, dist.RecordsByPartition)
+			assert.Zero(t, dist.MismatchedRecords)
+		})
+	}
+}
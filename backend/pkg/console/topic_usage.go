@@ -0,0 +1,390 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// TopicUsageFlag is a finding on a topic that indicates it may no longer be in use.
+type TopicUsageFlag string
+
+const (
+	// TopicUsageFlagEmpty is set if the topic does not contain any records.
+	TopicUsageFlagEmpty TopicUsageFlag = "EMPTY"
+	// TopicUsageFlagNoRecentWrites is set if the newest record in the topic is older
+	// than the requested idle threshold.
+	TopicUsageFlagNoRecentWrites TopicUsageFlag = "NO_RECENT_WRITES"
+	// TopicUsageFlagNoConsumers is set if no consumer group has committed offsets
+	// for the topic.
+	TopicUsageFlagNoConsumers TopicUsageFlag = "NO_CONSUMERS"
+	// TopicUsageFlagInactiveConsumers is set if consumer groups have committed offsets
+	// for the topic, but none of these groups has active members.
+	TopicUsageFlagInactiveConsumers TopicUsageFlag = "INACTIVE_CONSUMERS"
+)
+
+// lastProducedTimeout is the maximum time we wait for the newest record of all
+// requested partitions to be fetched.
+const lastProducedTimeout = 10 * time.Second
+
+// TopicUsageRequest describes which topics shall be analyzed and which thresholds
+// shall be applied.
+type TopicUsageRequest struct {
+	// TopicNames to analyze. Pass nil to analyze all topics.
+	TopicNames []string
+	// IdleThreshold is the duration without any writes after which a topic is
+	// flagged as idle.
+	IdleThreshold time.Duration
+	// IncludeInternal controls whether internal topics are part of the report.
+	IncludeInternal bool
+}
+
+// TopicUsageReport is the result of analyzing whether topics are still in use.
+type TopicUsageReport struct {
+	IdleThresholdMs     int64        `json:"idleThresholdMs"`
+	IsAuthorizerEnabled bool         `json:"isAuthorizerEnabled"`
+	Topics              []TopicUsage `json:"topics"`
+}
+
+// TopicUsage contains all signals that indicate whether a single topic is still
+// produced to or consumed from.
+type TopicUsage struct {
+	TopicName      string `json:"topicName"`
+	IsInternal     bool   `json:"isInternal"`
+	PartitionCount int    `json:"partitionCount"`
+	MessageCount   int64  `json:"messageCount"`
+
+	// LastProducedTimestamp is the unix timestamp in ms of the newest record across
+	// all partitions. It is nil if the topic is empty or the timestamp could not
+	// be determined.
+	LastProducedTimestamp *int64 `json:"lastProducedTimestamp"`
+	// LastProducedError is set if the newest record could not be fetched for
+	// at least one non-empty partition.
+	LastProducedError string `json:"lastProducedError,omitempty"`
+
+	ConsumerGroups []TopicUsageConsumerGroup `json:"consumerGroups"`
+	ACLs           []TopicUsageACLReference  `json:"acls"`
+	Flags          []TopicUsageFlag          `json:"flags"`
+}
+
+// TopicUsageConsumerGroup is a consumer group that has committed offsets for a topic.
+type TopicUsageConsumerGroup struct {
+	GroupID   string `json:"groupId"`
+	State     string `json:"state"`
+	IsActive  bool   `json:"isActive"`
+	SummedLag int64  `json:"summedLag"`
+}
+
+// TopicUsageACLReference is an ACL whose resource pattern matches a topic.
+type TopicUsageACLReference struct {
+	ResourceName        string `json:"resourceName"`
+	ResourcePatternType string `json:"resourcePatternType"`
+	Principal           string `json:"principal"`
+	Host                string `json:"host"`
+	Operation           string `json:"operation"`
+	PermissionType      string `json:"permissionType"`
+}
+
+// AnalyzeTopicUsage returns a report that combines the last produced timestamp,
+// the committed consumer groups along with their lag and state, as well as the
+// ACLs that reference each topic. Topics that look unused are flagged so that they
+// can be reviewed for cleanup.
+func (s *Service) AnalyzeTopicUsage(ctx context.Context, req TopicUsageRequest) (*TopicUsageReport, error) {
+	cl, adminCl, err := s.kafkaClientFactory.GetKafkaClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Collect topics and their watermarks
+	metadata, err := adminCl.Metadata(ctx, req.TopicNames...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+
+	usageByTopic := make(map[string]*TopicUsage)
+	topicNames := make([]string, 0, len(metadata.Topics))
+	for _, topic := range metadata.Topics {
+		if topic.Err != nil {
+			return nil, fmt.Errorf("failed to get metadata for topic %q: %w", topic.Topic, topic.Err)
+		}
+		if topic.IsInternal && !req.IncludeInternal {
+			continue
+		}
+		usageByTopic[topic.Topic] = &TopicUsage{
+			TopicName:      topic.Topic,
+			IsInternal:     topic.IsInternal,
+			PartitionCount: len(topic.Partitions),
+			ConsumerGroups: make([]TopicUsageConsumerGroup, 0),
+			ACLs:           make([]TopicUsageACLReference, 0),
+			Flags:          make([]TopicUsageFlag, 0),
+		}
+		topicNames = append(topicNames, topic.Topic)
+	}
+	if len(topicNames) == 0 {
+		return &TopicUsageReport{IdleThresholdMs: req.IdleThreshold.Milliseconds(), Topics: []TopicUsage{}}, nil
+	}
+
+	startOffsets, err := adminCl.ListStartOffsets(ctx, topicNames...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topic start offsets: %w", err)
+	}
+	endOffsets, err := adminCl.ListEndOffsets(ctx, topicNames...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topic end offsets: %w", err)
+	}
+
+	// lastOffsets contains the offset of the newest record for all partitions that are not empty
+	lastOffsets := make(map[string]map[int32]int64)
+	endOffsets.Each(func(end kadm.ListedOffset) {
+		usage, exists := usageByTopic[end.Topic]
+		if !exists || end.Err != nil {
+			return
+		}
+		start, ok := startOffsets.Lookup(end.Topic, end.Partition)
+		if !ok || start.Err != nil || end.Offset <= start.Offset {
+			return
+		}
+		usage.MessageCount += end.Offset - start.Offset
+		if _, exists := lastOffsets[end.Topic]; !exists {
+			lastOffsets[end.Topic] = make(map[int32]int64)
+		}
+		lastOffsets[end.Topic][end.Partition] = end.Offset - 1
+	})
+
+	// 2. Fetch the newest record of each partition so that we know when it was last written to
+	timestamps := s.fetchLastRecordTimestamps(ctx, cl, lastOffsets)
+	for topic, partitions := range lastOffsets {
+		usage := usageByTopic[topic]
+		missing := 0
+		for partitionID := range partitions {
+			ts, ok := timestamps[topic][partitionID]
+			if !ok {
+				missing++
+				continue
+			}
+			if usage.LastProducedTimestamp == nil || ts > *usage.LastProducedTimestamp {
+				usage.LastProducedTimestamp = &ts
+			}
+		}
+		if missing > 0 {
+			usage.LastProducedError = fmt.Sprintf("Failed to fetch the newest record for %d partition(s) within %v", missing, lastProducedTimeout)
+		}
+	}
+
+	// 3. Collect consumer groups along with their state and lag
+	groups, err := adminCl.ListGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumer groups: %w", err)
+	}
+	if len(groups) > 0 {
+		offsetsByGroup, err := s.getConsumerGroupOffsets(ctx, adminCl, groups.Groups())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get consumer group offsets: %w", err)
+		}
+		for groupID, topicOffsets := range offsetsByGroup {
+			state := groups[groupID].State
+			for _, topicOffset := range topicOffsets {
+				usage, exists := usageByTopic[topicOffset.Topic]
+				if !exists || topicOffset.PartitionsWithOffset == 0 {
+					continue
+				}
+				usage.ConsumerGroups = append(usage.ConsumerGroups, TopicUsageConsumerGroup{
+					GroupID:   groupID,
+					State:     state,
+					IsActive:  isGroupStateActive(state),
+					SummedLag: topicOffset.SummedLag,
+				})
+			}
+		}
+	}
+
+	// 4. Collect ACLs that reference the topics. A missing authorizer or missing
+	// permissions to describe ACLs should not fail the whole report.
+	aclReq := kmsg.NewDescribeACLsRequest()
+	aclReq.ResourceType = kmsg.ACLResourceTypeTopic
+	aclReq.ResourcePatternType = kmsg.ACLResourcePatternTypeAny
+	aclReq.Operation = kmsg.ACLOperationAny
+	aclReq.PermissionType = kmsg.ACLPermissionTypeAny
+	aclOverview, err := s.ListAllACLs(ctx, aclReq)
+	isAuthorizerEnabled := false
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to list ACLs for topic usage report", slog.Any("error", err))
+	} else {
+		isAuthorizerEnabled = aclOverview.IsAuthorizerEnabled
+		for _, usage := range usageByTopic {
+			usage.ACLs = matchTopicACLs(usage.TopicName, aclOverview.ACLResources)
+		}
+	}
+
+	// 5. Flag topics and construct the response
+	now := time.Now()
+	topics := make([]TopicUsage, 0, len(usageByTopic))
+	for _, usage := range usageByTopic {
+		sort.Slice(usage.ConsumerGroups, func(i, j int) bool {
+			return usage.ConsumerGroups[i].GroupID < usage.ConsumerGroups[j].GroupID
+		})
+		usage.Flags = classifyTopicUsage(usage, now, req.IdleThreshold)
+		topics = append(topics, *usage)
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].TopicName < topics[j].TopicName
+	})
+
+	return &TopicUsageReport{
+		IdleThresholdMs:     req.IdleThreshold.Milliseconds(),
+		IsAuthorizerEnabled: isAuthorizerEnabled,
+		Topics:              topics,
+	}, nil
+}
+
+// fetchLastRecordTimestamps consumes the record at the given offset for each partition
+// and returns the record timestamps in unix ms, indexed by topic and partition.
+// Partitions whose record could not be fetched in time are omitted.
+func (s *Service) fetchLastRecordTimestamps(ctx context.Context, cl *kgo.Client, lastOffsets map[string]map[int32]int64) map[string]map[int32]int64 {
+	timestamps := make(map[string]map[int32]int64)
+	if len(lastOffsets) == 0 {
+		return timestamps
+	}
+
+	partitionOffsets := make(map[string]map[int32]kgo.Offset, len(lastOffsets))
+	remaining := 0
+	for topic, partitions := range lastOffsets {
+		partitionOffsets[topic] = make(map[int32]kgo.Offset, len(partitions))
+		for partitionID, offset := range partitions {
+			partitionOffsets[topic][partitionID] = kgo.NewOffset().At(offset)
+			remaining++
+		}
+	}
+
+	// Control records are kept, because the last record in a transactional topic
+	// is the commit marker, which is a write as well.
+	opts := append(cl.Opts(), kgo.ConsumePartitions(partitionOffsets), kgo.KeepControlRecords())
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to create kafka client for fetching last records", slog.Any("error", err))
+		return timestamps
+	}
+	defer client.Close()
+
+	fetchCtx, cancel := context.WithTimeout(ctx, lastProducedTimeout)
+	defer cancel()
+
+	finished := make(map[string]map[int32]bool, len(lastOffsets))
+
+	for remaining > 0 {
+		fetches := client.PollFetches(fetchCtx)
+		if fetchCtx.Err() != nil {
+			break
+		}
+		for _, fetchErr := range fetches.Errors() {
+			if !errors.Is(fetchErr.Err, context.DeadlineExceeded) && !errors.Is(fetchErr.Err, context.Canceled) {
+				s.logger.WarnContext(ctx, "failed to fetch last record",
+					slog.String("topic_name", fetchErr.Topic),
+					slog.Int("partition", int(fetchErr.Partition)),
+					slog.Any("error", fetchErr.Err))
+			}
+		}
+
+		fetches.EachRecord(func(record *kgo.Record) {
+			lastOffset := lastOffsets[record.Topic][record.Partition]
+			if _, exists := timestamps[record.Topic]; !exists {
+				timestamps[record.Topic] = make(map[int32]int64)
+			}
+			prev, seen := timestamps[record.Topic][record.Partition]
+			ts := record.Timestamp.UnixMilli()
+			if !seen || ts > prev {
+				timestamps[record.Topic][record.Partition] = ts
+			}
+			if record.Offset >= lastOffset && !finished[record.Topic][record.Partition] {
+				if _, exists := finished[record.Topic]; !exists {
+					finished[record.Topic] = make(map[int32]bool)
+				}
+				finished[record.Topic][record.Partition] = true
+				remaining--
+			}
+		})
+	}
+
+	return timestamps
+}
+
+// matchTopicACLs returns all ACLs whose resource pattern matches the given topic name.
+func matchTopicACLs(topicName string, resources []*ACLResource) []TopicUsageACLReference {
+	refs := make([]TopicUsageACLReference, 0)
+	for _, resource := range resources {
+		if resource.ResourceType != kmsg.ACLResourceTypeTopic.String() {
+			continue
+		}
+
+		var matches bool
+		switch resource.ResourcePatternType {
+		case kmsg.ACLResourcePatternTypeLiteral.String():
+			matches = resource.ResourceName == topicName || resource.ResourceName == "*"
+		case kmsg.ACLResourcePatternTypePrefixed.String():
+			matches = strings.HasPrefix(topicName, resource.ResourceName)
+		}
+		if !matches {
+			continue
+		}
+
+		for _, acl := range resource.ACLs {
+			refs = append(refs, TopicUsageACLReference{
+				ResourceName:        resource.ResourceName,
+				ResourcePatternType: resource.ResourcePatternType,
+				Principal:           acl.Principal,
+				Host:                acl.Host,
+				Operation:           acl.Operation,
+				PermissionType:      acl.PermissionType,
+			})
+		}
+	}
+	return refs
+}
+
+// isGroupStateActive returns whether a group in the given state has members.
+// Unknown states (e.g. reported by older Kafka versions) are considered active
+// so that topics are not wrongly flagged.
+func isGroupStateActive(state string) bool {
+	return state != "Empty" && state != "Dead"
+}
+
+// classifyTopicUsage returns all flags that apply for the given topic usage.
+func classifyTopicUsage(usage *TopicUsage, now time.Time, idleThreshold time.Duration) []TopicUsageFlag {
+	flags := make([]TopicUsageFlag, 0)
+
+	switch {
+	case usage.MessageCount == 0:
+		flags = append(flags, TopicUsageFlagEmpty)
+	case usage.LastProducedTimestamp != nil && idleThreshold > 0:
+		lastProduced := time.UnixMilli(*usage.LastProducedTimestamp)
+		if now.Sub(lastProduced) > idleThreshold {
+			flags = append(flags, TopicUsageFlagNoRecentWrites)
+		}
+	}
+
+	if len(usage.ConsumerGroups) == 0 {
+		flags = append(flags, TopicUsageFlagNoConsumers)
+	} else if !slices.ContainsFunc(usage.ConsumerGroups, func(g TopicUsageConsumerGroup) bool { return g.IsActive }) {
+		flags = append(flags, TopicUsageFlagInactiveConsumers)
+	}
+
+	return flags
+}
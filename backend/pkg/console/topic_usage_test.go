@@ -0,0 +1,119 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyTopicUsage(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	idleThreshold := 7 * 24 * time.Hour
+	ts := func(d time.Duration) *int64 {
+		v := now.Add(-d).UnixMilli()
+		return &v
+	}
+
+	tests := []struct {
+		name  string
+		usage TopicUsage
+		want  []TopicUsageFlag
+	}{
+		{
+			name:  "empty topic without consumers",
+			usage: TopicUsage{MessageCount: 0},
+			want:  []TopicUsageFlag{TopicUsageFlagEmpty, TopicUsageFlagNoConsumers},
+		},
+		{
+			name: "recently written with active consumer",
+			usage: TopicUsage{
+				MessageCount:          10,
+				LastProducedTimestamp: ts(time.Hour),
+				ConsumerGroups:        []TopicUsageConsumerGroup{{GroupID: "a", State: "Stable", IsActive: true}},
+			},
+			want: []TopicUsageFlag{},
+		},
+		{
+			name: "idle with only inactive consumers",
+			usage: TopicUsage{
+				MessageCount:          10,
+				LastProducedTimestamp: ts(30 * 24 * time.Hour),
+				ConsumerGroups: []TopicUsageConsumerGroup{
+					{GroupID: "a", State: "Empty", IsActive: false},
+					{GroupID: "b", State: "Dead", IsActive: false},
+				},
+			},
+			want: []TopicUsageFlag{TopicUsageFlagNoRecentWrites, TopicUsageFlagInactiveConsumers},
+		},
+		{
+			name: "unknown last produced timestamp is not flagged as idle",
+			usage: TopicUsage{
+				MessageCount:   10,
+				ConsumerGroups: []TopicUsageConsumerGroup{{GroupID: "a", IsActive: true}},
+			},
+			want: []TopicUsageFlag{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyTopicUsage(&tt.usage, now, idleThreshold)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchTopicACLs(t *testing.T) {
+	resources := []*ACLResource{
+		{
+			ResourceType:        "TOPIC",
+			ResourceName:        "orders",
+			ResourcePatternType: "LITERAL",
+			ACLs:                []*ACLRule{{Principal: "User:literal", Host: "*", Operation: "READ", PermissionType: "ALLOW"}},
+		},
+		{
+			ResourceType:        "TOPIC",
+			ResourceName:        "ord",
+			ResourcePatternType: "PREFIXED",
+			ACLs:                []*ACLRule{{Principal: "User:prefixed", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"}},
+		},
+		{
+			ResourceType:        "TOPIC",
+			ResourceName:        "*",
+			ResourcePatternType: "LITERAL",
+			ACLs:                []*ACLRule{{Principal: "User:wildcard", Host: "*", Operation: "DESCRIBE", PermissionType: "ALLOW"}},
+		},
+		{
+			ResourceType:        "TOPIC",
+			ResourceName:        "payments",
+			ResourcePatternType: "LITERAL",
+			ACLs:                []*ACLRule{{Principal: "User:other", Host: "*", Operation: "READ", PermissionType: "ALLOW"}},
+		},
+		{
+			ResourceType:        "GROUP",
+			ResourceName:        "orders",
+			ResourcePatternType: "LITERAL",
+			ACLs:                []*ACLRule{{Principal: "User:group", Host: "*", Operation: "READ", PermissionType: "ALLOW"}},
+		},
+	}
+
+	refs := matchTopicACLs("orders", resources)
+	require.Len(t, refs, 3)
+
+	principals := make([]string, len(refs))
+	for i, ref := range refs {
+		principals[i] = ref.Principal
+	}
+	assert.ElementsMatch(t, []string{"User:literal", "User:prefixed", "User:wildcard"}, principals)
+}
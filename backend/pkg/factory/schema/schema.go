@@ -50,24 +50,37 @@ func NewSingleClientProvider(cfg *config.Config) (ClientFactory, error) {
 		return &DisabledClientProvider{}, nil
 	}
 
+	client, err := NewClient(schemaCfg.URLs, schemaCfg.Authentication, schemaCfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SingleClientProvider{
+		srClient: client,
+	}, nil
+}
+
+// NewClient creates a schema registry client for the given URLs, authentication
+// and TLS configuration.
+func NewClient(urls []string, auth config.HTTPAuthentication, tls config.TLS) (*rpsr.Client, error) {
 	// If TLS is not enabled this will return the default TLS config.
-	tlsCfg, err := schemaCfg.TLS.TLSConfig()
+	tlsCfg, err := tls.TLSConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed creating tls config: %w", err)
 	}
 
 	opts := []sr.ClientOpt{
-		sr.URLs(schemaCfg.URLs...),
+		sr.URLs(urls...),
 		sr.UserAgent("redpanda-console"),
 		sr.DialTLSConfig(tlsCfg),
 	}
 
-	if schemaCfg.Authentication.BasicAuth.Username != "" {
-		opts = append(opts, sr.BasicAuth(schemaCfg.Authentication.BasicAuth.Username, schemaCfg.Authentication.BasicAuth.Password))
+	if auth.BasicAuth.Username != "" {
+		opts = append(opts, sr.BasicAuth(auth.BasicAuth.Username, auth.BasicAuth.Password))
 	}
 
-	if schemaCfg.Authentication.BearerToken != "" {
-		opts = append(opts, sr.BearerToken(schemaCfg.Authentication.BearerToken))
+	if auth.BearerToken != "" {
+		opts = append(opts, sr.BearerToken(auth.BearerToken))
 	}
 
 	srClient, err := sr.NewClient(opts...)
@@ -75,14 +88,7 @@ func NewSingleClientProvider(cfg *config.Config) (ClientFactory, error) {
 		return nil, err
 	}
 
-	client, err := rpsr.NewClient(srClient)
-	if err != nil {
-		return nil, err
-	}
-
-	return &SingleClientProvider{
-		srClient: client,
-	}, nil
+	return rpsr.NewClient(srClient)
 }
 
 // GetSchemaRegistryClient returns a schema registry client for the given context.
@@ -18,6 +18,7 @@ import (
 	"io"
 	"log/slog"
 	"maps"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -67,6 +68,11 @@ type Service struct {
 	registryMutex sync.RWMutex
 	registry      *protoregistry.Types
 
+	// sourcesByImportPath are the contents of the git and filesystem proto files by
+	// import path. They are rebuilt together with the registry.
+	sourcesByImportPath      map[string]string
+	sourcesByImportPathMutex sync.RWMutex
+
 	sfGroup singleflight.Group
 }
 
@@ -420,8 +426,9 @@ func (s *Service) tryCreateProtoRegistry() {
 func (s *Service) createProtoRegistry() error {
 	startTime := time.Now()
 
-	files := s.collectProtoFiles()
-	fileDescriptors, err := s.protoFileToDescriptor(files)
+	sources := s.protoSources(s.collectProtoFiles())
+	s.updateSources(sources)
+	fileDescriptors, err := s.protoFileToDescriptor(maps.Clone(sources))
 	if err != nil {
 		return fmt.Errorf("failed to compile proto files to descriptors: %w", err)
 	}
@@ -468,6 +475,12 @@ func (s *Service) buildMessageRegistry(fileDescriptors []protoreflect.FileDescri
 	return registry
 }
 
+func (s *Service) updateSources(sources map[string]string) {
+	s.sourcesByImportPathMutex.Lock()
+	defer s.sourcesByImportPathMutex.Unlock()
+	s.sourcesByImportPath = sources
+}
+
 func (s *Service) updateRegistry(registry *protoregistry.Types) {
 	s.registryMutex.Lock()
 	defer s.registryMutex.Unlock()
@@ -526,33 +539,11 @@ func (s *Service) checkProtoType(protoType, topicName, fieldName string) bool {
 	return true
 }
 
-// protoFileToDescriptor parses a .proto file and compiles it to a descriptor using protocompile.
-// Imported dependencies (such as Protobuf timestamp) are included so that the descriptors are self-contained.
-func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]protoreflect.FileDescriptor, error) {
-	filesStr := make(map[string]string, len(files))
-	filePaths := make([]string, 0, len(filesStr))
-	for _, file := range files {
-		// Apparently a slash prepends the filepath on some OS (not windows). Hence let's try to remove the prefix if it
-		// exists, so that there's no filename mismatch because of that.
-		trimmedFilepath := strings.TrimPrefix(file.Path, "/")
-
-		if len(s.cfg.ImportPaths) > 0 {
-			for _, prefix := range s.cfg.ImportPaths {
-				// Check if file is in one of the import paths. If not, ignore it.
-				// If yes, pick up the file,
-				// and trim the prefix because an import path is effectively a root.
-				if after, ok := strings.CutPrefix(trimmedFilepath, prefix); ok {
-					trimmedFilepath = after
-					trimmedFilepath = strings.TrimPrefix(trimmedFilepath, "/")
-					filesStr[trimmedFilepath] = string(file.Payload)
-					filePaths = append(filePaths, trimmedFilepath)
-				}
-			}
-		} else {
-			filesStr[trimmedFilepath] = string(file.Payload)
-			filePaths = append(filePaths, trimmedFilepath)
-		}
-	}
+// protoFileToDescriptor parses the proto sources by import path and compiles them to descriptors using
+// protocompile. Imported dependencies (such as Protobuf timestamp) are included so that the descriptors
+// are self-contained. The common proto types are added to the given map.
+func (*Service) protoFileToDescriptor(filesStr map[string]string) ([]protoreflect.FileDescriptor, error) {
+	filePaths := slices.Sorted(maps.Keys(filesStr))
 
 	// Add common proto types
 	// The well known types are automatically added in the protoreflect protoparse package.
@@ -598,6 +589,43 @@ func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]pro
 	return descriptors, nil
 }
 
+// protoSources returns the content of the given proto files by the path that
+// is used to import them.
+func (s *Service) protoSources(files map[string]filesystem.File) map[string]string {
+	filesStr := make(map[string]string, len(files))
+	for _, file := range files {
+		// Apparently a slash prepends the filepath on some OS (not windows). Hence let's try to remove the prefix if it
+		// exists, so that there's no filename mismatch because of that.
+		trimmedFilepath := strings.TrimPrefix(file.Path, "/")
+
+		if len(s.cfg.ImportPaths) > 0 {
+			for _, prefix := range s.cfg.ImportPaths {
+				// Check if file is in one of the import paths. If not, ignore it.
+				// If yes, pick up the file,
+				// and trim the prefix because an import path is effectively a root.
+				if after, ok := strings.CutPrefix(trimmedFilepath, prefix); ok {
+					trimmedFilepath = after
+					trimmedFilepath = strings.TrimPrefix(trimmedFilepath, "/")
+					filesStr[trimmedFilepath] = string(file.Payload)
+				}
+			}
+		} else {
+			filesStr[trimmedFilepath] = string(file.Payload)
+		}
+	}
+	return filesStr
+}
+
+// ProtoFileSource returns the content of the proto file with the given import
+// path from the configured git and filesystem sources. This allows schemas
+// from other sources, such as the schema registry, to import these files.
+func (s *Service) ProtoFileSource(path string) (string, bool) {
+	s.sourcesByImportPathMutex.RLock()
+	defer s.sourcesByImportPathMutex.RUnlock()
+	content, ok := s.sourcesByImportPath[path]
+	return content, ok
+}
+
 // GetFileDescriptorBySchemaID gets the file descriptor by schema ID.
 func (s *Service) GetFileDescriptorBySchemaID(schemaID int) (protoreflect.FileDescriptor, bool) {
 	s.fileDescriptorsBySchemaIDMutex.Lock()
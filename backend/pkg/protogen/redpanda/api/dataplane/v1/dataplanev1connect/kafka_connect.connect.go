@@ -81,26 +81,38 @@ const (
 	// KafkaConnectServiceResetConnectorTopicsProcedure is the fully-qualified name of the
 	// KafkaConnectService's ResetConnectorTopics RPC.
 	KafkaConnectServiceResetConnectorTopicsProcedure = "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorTopics"
+	// KafkaConnectServiceGetConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's GetConnectorOffsets RPC.
+	KafkaConnectServiceGetConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorOffsets"
+	// KafkaConnectServiceAlterConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's AlterConnectorOffsets RPC.
+	KafkaConnectServiceAlterConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1.KafkaConnectService/AlterConnectorOffsets"
+	// KafkaConnectServiceResetConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's ResetConnectorOffsets RPC.
+	KafkaConnectServiceResetConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorOffsets"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	kafkaConnectServiceServiceDescriptor                    = v1.File_redpanda_api_dataplane_v1_kafka_connect_proto.Services().ByName("KafkaConnectService")
-	kafkaConnectServiceListConnectClustersMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
-	kafkaConnectServiceGetConnectClusterMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
-	kafkaConnectServiceListConnectorsMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
-	kafkaConnectServiceCreateConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
-	kafkaConnectServiceRestartConnectorMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
-	kafkaConnectServiceGetConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
-	kafkaConnectServiceGetConnectorStatusMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
-	kafkaConnectServicePauseConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
-	kafkaConnectServiceResumeConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
-	kafkaConnectServiceStopConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
-	kafkaConnectServiceDeleteConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
-	kafkaConnectServiceUpsertConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
-	kafkaConnectServiceGetConnectorConfigMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
-	kafkaConnectServiceListConnectorTopicsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
-	kafkaConnectServiceResetConnectorTopicsMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceServiceDescriptor                     = v1.File_redpanda_api_dataplane_v1_kafka_connect_proto.Services().ByName("KafkaConnectService")
+	kafkaConnectServiceListConnectClustersMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
+	kafkaConnectServiceGetConnectClusterMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
+	kafkaConnectServiceListConnectorsMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
+	kafkaConnectServiceCreateConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
+	kafkaConnectServiceRestartConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
+	kafkaConnectServiceGetConnectorMethodDescriptor          = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
+	kafkaConnectServiceGetConnectorStatusMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
+	kafkaConnectServicePauseConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
+	kafkaConnectServiceResumeConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
+	kafkaConnectServiceStopConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
+	kafkaConnectServiceDeleteConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
+	kafkaConnectServiceUpsertConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
+	kafkaConnectServiceGetConnectorConfigMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
+	kafkaConnectServiceListConnectorTopicsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
+	kafkaConnectServiceResetConnectorTopicsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceGetConnectorOffsetsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorOffsets")
+	kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("AlterConnectorOffsets")
+	kafkaConnectServiceResetConnectorOffsetsMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorOffsets")
 )
 
 // KafkaConnectServiceClient is a client for the redpanda.api.dataplane.v1.KafkaConnectService
@@ -153,6 +165,12 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetConnectorOffsets implements the get connector offsets method, expose a kafka connect equivalent REST endpoint
+	GetConnectorOffsets(context.Context, *connect.Request[v1.GetConnectorOffsetsRequest]) (*connect.Response[v1.GetConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets implements the alter connector offsets method, expose a kafka connect equivalent REST endpoint
+	AlterConnectorOffsets(context.Context, *connect.Request[v1.AlterConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ResetConnectorOffsets implements the reset connector offsets method, expose a kafka connect equivalent REST endpoint
+	ResetConnectorOffsets(context.Context, *connect.Request[v1.ResetConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewKafkaConnectServiceClient constructs a client for the
@@ -256,26 +274,47 @@ func NewKafkaConnectServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getConnectorOffsets: connect.NewClient[v1.GetConnectorOffsetsRequest, v1.GetConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceGetConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceGetConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		alterConnectorOffsets: connect.NewClient[v1.AlterConnectorOffsetsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+KafkaConnectServiceAlterConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resetConnectorOffsets: connect.NewClient[v1.ResetConnectorOffsetsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+KafkaConnectServiceResetConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // kafkaConnectServiceClient implements KafkaConnectServiceClient.
 type kafkaConnectServiceClient struct {
-	listConnectClusters  *connect.Client[v1.ListConnectClustersRequest, v1.ListConnectClustersResponse]
-	getConnectCluster    *connect.Client[v1.GetConnectClusterRequest, v1.GetConnectClusterResponse]
-	listConnectors       *connect.Client[v1.ListConnectorsRequest, v1.ListConnectorsResponse]
-	createConnector      *connect.Client[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
-	restartConnector     *connect.Client[v1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         *connect.Client[v1.GetConnectorRequest, v1.GetConnectorResponse]
-	getConnectorStatus   *connect.Client[v1.GetConnectorStatusRequest, v1.GetConnectorStatusResponse]
-	pauseConnector       *connect.Client[v1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      *connect.Client[v1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        *connect.Client[v1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      *connect.Client[v1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      *connect.Client[v1.UpsertConnectorRequest, v1.UpsertConnectorResponse]
-	getConnectorConfig   *connect.Client[v1.GetConnectorConfigRequest, v1.GetConnectorConfigResponse]
-	listConnectorTopics  *connect.Client[v1.ListConnectorTopicsRequest, v1.ListConnectorTopicsResponse]
-	resetConnectorTopics *connect.Client[v1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters   *connect.Client[v1.ListConnectClustersRequest, v1.ListConnectClustersResponse]
+	getConnectCluster     *connect.Client[v1.GetConnectClusterRequest, v1.GetConnectClusterResponse]
+	listConnectors        *connect.Client[v1.ListConnectorsRequest, v1.ListConnectorsResponse]
+	createConnector       *connect.Client[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
+	restartConnector      *connect.Client[v1.RestartConnectorRequest, emptypb.Empty]
+	getConnector          *connect.Client[v1.GetConnectorRequest, v1.GetConnectorResponse]
+	getConnectorStatus    *connect.Client[v1.GetConnectorStatusRequest, v1.GetConnectorStatusResponse]
+	pauseConnector        *connect.Client[v1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector       *connect.Client[v1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector         *connect.Client[v1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector       *connect.Client[v1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector       *connect.Client[v1.UpsertConnectorRequest, v1.UpsertConnectorResponse]
+	getConnectorConfig    *connect.Client[v1.GetConnectorConfigRequest, v1.GetConnectorConfigResponse]
+	listConnectorTopics   *connect.Client[v1.ListConnectorTopicsRequest, v1.ListConnectorTopicsResponse]
+	resetConnectorTopics  *connect.Client[v1.ResetConnectorTopicsRequest, emptypb.Empty]
+	getConnectorOffsets   *connect.Client[v1.GetConnectorOffsetsRequest, v1.GetConnectorOffsetsResponse]
+	alterConnectorOffsets *connect.Client[v1.AlterConnectorOffsetsRequest, emptypb.Empty]
+	resetConnectorOffsets *connect.Client[v1.ResetConnectorOffsetsRequest, emptypb.Empty]
 }
 
 // ListConnectClusters calls redpanda.api.dataplane.v1.KafkaConnectService.ListConnectClusters.
@@ -353,6 +392,21 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, re
 	return c.resetConnectorTopics.CallUnary(ctx, req)
 }
 
+// GetConnectorOffsets calls redpanda.api.dataplane.v1.KafkaConnectService.GetConnectorOffsets.
+func (c *kafkaConnectServiceClient) GetConnectorOffsets(ctx context.Context, req *connect.Request[v1.GetConnectorOffsetsRequest]) (*connect.Response[v1.GetConnectorOffsetsResponse], error) {
+	return c.getConnectorOffsets.CallUnary(ctx, req)
+}
+
+// AlterConnectorOffsets calls redpanda.api.dataplane.v1.KafkaConnectService.AlterConnectorOffsets.
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1.AlterConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.alterConnectorOffsets.CallUnary(ctx, req)
+}
+
+// ResetConnectorOffsets calls redpanda.api.dataplane.v1.KafkaConnectService.ResetConnectorOffsets.
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1.ResetConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.resetConnectorOffsets.CallUnary(ctx, req)
+}
+
 // KafkaConnectServiceHandler is an implementation of the
 // redpanda.api.dataplane.v1.KafkaConnectService service.
 type KafkaConnectServiceHandler interface {
@@ -403,6 +457,12 @@ type KafkaConnectServiceHandler interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetConnectorOffsets implements the get connector offsets method, expose a kafka connect equivalent REST endpoint
+	GetConnectorOffsets(context.Context, *connect.Request[v1.GetConnectorOffsetsRequest]) (*connect.Response[v1.GetConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets implements the alter connector offsets method, expose a kafka connect equivalent REST endpoint
+	AlterConnectorOffsets(context.Context, *connect.Request[v1.AlterConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ResetConnectorOffsets implements the reset connector offsets method, expose a kafka connect equivalent REST endpoint
+	ResetConnectorOffsets(context.Context, *connect.Request[v1.ResetConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewKafkaConnectServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -501,6 +561,24 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 		connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kafkaConnectServiceGetConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceGetConnectorOffsetsProcedure,
+		svc.GetConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceGetConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceAlterConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceAlterConnectorOffsetsProcedure,
+		svc.AlterConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceResetConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceResetConnectorOffsetsProcedure,
+		svc.ResetConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/redpanda.api.dataplane.v1.KafkaConnectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KafkaConnectServiceListConnectClustersProcedure:
@@ -533,6 +611,12 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 			kafkaConnectServiceListConnectorTopicsHandler.ServeHTTP(w, r)
 		case KafkaConnectServiceResetConnectorTopicsProcedure:
 			kafkaConnectServiceResetConnectorTopicsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceGetConnectorOffsetsProcedure:
+			kafkaConnectServiceGetConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceAlterConnectorOffsetsProcedure:
+			kafkaConnectServiceAlterConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceResetConnectorOffsetsProcedure:
+			kafkaConnectServiceResetConnectorOffsetsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -601,3 +685,15 @@ func (UnimplementedKafkaConnectServiceHandler) ListConnectorTopics(context.Conte
 func (UnimplementedKafkaConnectServiceHandler) ResetConnectorTopics(context.Context, *connect.Request[v1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1.KafkaConnectService.ResetConnectorTopics is not implemented"))
 }
+
+func (UnimplementedKafkaConnectServiceHandler) GetConnectorOffsets(context.Context, *connect.Request[v1.GetConnectorOffsetsRequest]) (*connect.Response[v1.GetConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1.KafkaConnectService.GetConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) AlterConnectorOffsets(context.Context, *connect.Request[v1.AlterConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1.KafkaConnectService.AlterConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ResetConnectorOffsets(context.Context, *connect.Request[v1.ResetConnectorOffsetsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1.KafkaConnectService.ResetConnectorOffsets is not implemented"))
+}
@@ -19,42 +19,48 @@ import (
 // service.
 type KafkaConnectServiceGatewayServer struct {
 	v1.UnimplementedKafkaConnectServiceServer
-	listConnectClusters  connect_gateway.UnaryHandler[v1.ListConnectClustersRequest, v1.ListConnectClustersResponse]
-	getConnectCluster    connect_gateway.UnaryHandler[v1.GetConnectClusterRequest, v1.GetConnectClusterResponse]
-	listConnectors       connect_gateway.UnaryHandler[v1.ListConnectorsRequest, v1.ListConnectorsResponse]
-	createConnector      connect_gateway.UnaryHandler[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
-	restartConnector     connect_gateway.UnaryHandler[v1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         connect_gateway.UnaryHandler[v1.GetConnectorRequest, v1.GetConnectorResponse]
-	getConnectorStatus   connect_gateway.UnaryHandler[v1.GetConnectorStatusRequest, v1.GetConnectorStatusResponse]
-	pauseConnector       connect_gateway.UnaryHandler[v1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      connect_gateway.UnaryHandler[v1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        connect_gateway.UnaryHandler[v1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      connect_gateway.UnaryHandler[v1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      connect_gateway.UnaryHandler[v1.UpsertConnectorRequest, v1.UpsertConnectorResponse]
-	getConnectorConfig   connect_gateway.UnaryHandler[v1.GetConnectorConfigRequest, v1.GetConnectorConfigResponse]
-	listConnectorTopics  connect_gateway.UnaryHandler[v1.ListConnectorTopicsRequest, v1.ListConnectorTopicsResponse]
-	resetConnectorTopics connect_gateway.UnaryHandler[v1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters   connect_gateway.UnaryHandler[v1.ListConnectClustersRequest, v1.ListConnectClustersResponse]
+	getConnectCluster     connect_gateway.UnaryHandler[v1.GetConnectClusterRequest, v1.GetConnectClusterResponse]
+	listConnectors        connect_gateway.UnaryHandler[v1.ListConnectorsRequest, v1.ListConnectorsResponse]
+	createConnector       connect_gateway.UnaryHandler[v1.CreateConnectorRequest, v1.CreateConnectorResponse]
+	restartConnector      connect_gateway.UnaryHandler[v1.RestartConnectorRequest, emptypb.Empty]
+	getConnector          connect_gateway.UnaryHandler[v1.GetConnectorRequest, v1.GetConnectorResponse]
+	getConnectorStatus    connect_gateway.UnaryHandler[v1.GetConnectorStatusRequest, v1.GetConnectorStatusResponse]
+	pauseConnector        connect_gateway.UnaryHandler[v1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector       connect_gateway.UnaryHandler[v1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector         connect_gateway.UnaryHandler[v1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector       connect_gateway.UnaryHandler[v1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector       connect_gateway.UnaryHandler[v1.UpsertConnectorRequest, v1.UpsertConnectorResponse]
+	getConnectorConfig    connect_gateway.UnaryHandler[v1.GetConnectorConfigRequest, v1.GetConnectorConfigResponse]
+	listConnectorTopics   connect_gateway.UnaryHandler[v1.ListConnectorTopicsRequest, v1.ListConnectorTopicsResponse]
+	resetConnectorTopics  connect_gateway.UnaryHandler[v1.ResetConnectorTopicsRequest, emptypb.Empty]
+	getConnectorOffsets   connect_gateway.UnaryHandler[v1.GetConnectorOffsetsRequest, v1.GetConnectorOffsetsResponse]
+	alterConnectorOffsets connect_gateway.UnaryHandler[v1.AlterConnectorOffsetsRequest, emptypb.Empty]
+	resetConnectorOffsets connect_gateway.UnaryHandler[v1.ResetConnectorOffsetsRequest, emptypb.Empty]
 }
 
 // NewKafkaConnectServiceGatewayServer constructs a Connect-Gateway gRPC server for the
 // KafkaConnectService service.
 func NewKafkaConnectServiceGatewayServer(svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) *KafkaConnectServiceGatewayServer {
 	return &KafkaConnectServiceGatewayServer{
-		listConnectClusters:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
-		getConnectCluster:    connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
-		listConnectors:       connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
-		createConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
-		restartConnector:     connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
-		getConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
-		getConnectorStatus:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
-		pauseConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
-		resumeConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
-		stopConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
-		deleteConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
-		upsertConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
-		getConnectorConfig:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
-		listConnectorTopics:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
-		resetConnectorTopics: connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		listConnectClusters:   connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
+		getConnectCluster:     connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
+		listConnectors:        connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
+		createConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
+		restartConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
+		getConnector:          connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
+		getConnectorStatus:    connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
+		pauseConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
+		resumeConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
+		stopConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
+		deleteConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
+		upsertConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
+		getConnectorConfig:    connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
+		listConnectorTopics:   connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
+		resetConnectorTopics:  connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		getConnectorOffsets:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorOffsetsProcedure, svc.GetConnectorOffsets, opts...),
+		alterConnectorOffsets: connect_gateway.NewUnaryHandler(KafkaConnectServiceAlterConnectorOffsetsProcedure, svc.AlterConnectorOffsets, opts...),
+		resetConnectorOffsets: connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorOffsetsProcedure, svc.ResetConnectorOffsets, opts...),
 	}
 }
 
@@ -118,6 +124,18 @@ func (s *KafkaConnectServiceGatewayServer) ResetConnectorTopics(ctx context.Cont
 	return s.resetConnectorTopics(ctx, req)
 }
 
+func (s *KafkaConnectServiceGatewayServer) GetConnectorOffsets(ctx context.Context, req *v1.GetConnectorOffsetsRequest) (*v1.GetConnectorOffsetsResponse, error) {
+	return s.getConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) AlterConnectorOffsets(ctx context.Context, req *v1.AlterConnectorOffsetsRequest) (*emptypb.Empty, error) {
+	return s.alterConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ResetConnectorOffsets(ctx context.Context, req *v1.ResetConnectorOffsetsRequest) (*emptypb.Empty, error) {
+	return s.resetConnectorOffsets(ctx, req)
+}
+
 // RegisterKafkaConnectServiceHandlerGatewayServer registers the Connect handlers for the
 // KafkaConnectService "svc" to "mux".
 func RegisterKafkaConnectServiceHandlerGatewayServer(mux *runtime.ServeMux, svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) {
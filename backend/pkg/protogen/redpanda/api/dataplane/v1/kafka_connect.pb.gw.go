@@ -810,6 +810,177 @@ func local_request_KafkaConnectService_ResetConnectorTopics_0(ctx context.Contex
 	return msg, metadata, err
 }
 
+func request_KafkaConnectService_GetConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, client KafkaConnectServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.GetConnectorOffsets(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_KafkaConnectService_GetConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, server KafkaConnectServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.GetConnectorOffsets(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_KafkaConnectService_AlterConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, client KafkaConnectServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq AlterConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.AlterConnectorOffsets(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_KafkaConnectService_AlterConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, server KafkaConnectServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq AlterConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.AlterConnectorOffsets(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_KafkaConnectService_ResetConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, client KafkaConnectServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ResetConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.ResetConnectorOffsets(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_KafkaConnectService_ResetConnectorOffsets_0(ctx context.Context, marshaler runtime.Marshaler, server KafkaConnectServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ResetConnectorOffsetsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster_name")
+	}
+	protoReq.ClusterName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster_name", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.ResetConnectorOffsets(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterKafkaConnectServiceHandlerServer registers the http handlers for service KafkaConnectService to "mux".
 // UnaryRPC     :call KafkaConnectServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -1116,6 +1287,66 @@ func RegisterKafkaConnectServiceHandlerServer(ctx context.Context, mux *runtime.
 		}
 		forward_KafkaConnectService_ResetConnectorTopics_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_KafkaConnectService_GetConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_KafkaConnectService_GetConnectorOffsets_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_GetConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPatch, pattern_KafkaConnectService_AlterConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/AlterConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_KafkaConnectService_AlterConnectorOffsets_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_AlterConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_KafkaConnectService_ResetConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_KafkaConnectService_ResetConnectorOffsets_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_ResetConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -1411,41 +1642,98 @@ func RegisterKafkaConnectServiceHandlerClient(ctx context.Context, mux *runtime.
 		}
 		forward_KafkaConnectService_ResetConnectorTopics_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_KafkaConnectService_GetConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_KafkaConnectService_GetConnectorOffsets_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_GetConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPatch, pattern_KafkaConnectService_AlterConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/AlterConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_KafkaConnectService_AlterConnectorOffsets_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_AlterConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_KafkaConnectService_ResetConnectorOffsets_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorOffsets", runtime.WithHTTPPathPattern("/v1/kafka-connect/clusters/{cluster_name}/connectors/{name}/offsets"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_KafkaConnectService_ResetConnectorOffsets_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_KafkaConnectService_ResetConnectorOffsets_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_KafkaConnectService_ListConnectClusters_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "kafka-connect", "clusters"}, ""))
-	pattern_KafkaConnectService_GetConnectCluster_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"v1", "kafka-connect", "clusters", "cluster_name"}, ""))
-	pattern_KafkaConnectService_ListConnectors_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors"}, ""))
-	pattern_KafkaConnectService_CreateConnector_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors"}, ""))
-	pattern_KafkaConnectService_RestartConnector_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "restart"}, ""))
-	pattern_KafkaConnectService_GetConnector_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name"}, ""))
-	pattern_KafkaConnectService_GetConnectorStatus_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "status"}, ""))
-	pattern_KafkaConnectService_PauseConnector_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "pause"}, ""))
-	pattern_KafkaConnectService_ResumeConnector_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "resume"}, ""))
-	pattern_KafkaConnectService_StopConnector_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "stop"}, ""))
-	pattern_KafkaConnectService_DeleteConnector_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name"}, ""))
-	pattern_KafkaConnectService_UpsertConnector_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "config"}, ""))
-	pattern_KafkaConnectService_GetConnectorConfig_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "config"}, ""))
-	pattern_KafkaConnectService_ListConnectorTopics_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "topics"}, ""))
-	pattern_KafkaConnectService_ResetConnectorTopics_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6, 2, 7}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "topics", "reset"}, ""))
+	pattern_KafkaConnectService_ListConnectClusters_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "kafka-connect", "clusters"}, ""))
+	pattern_KafkaConnectService_GetConnectCluster_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"v1", "kafka-connect", "clusters", "cluster_name"}, ""))
+	pattern_KafkaConnectService_ListConnectors_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors"}, ""))
+	pattern_KafkaConnectService_CreateConnector_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors"}, ""))
+	pattern_KafkaConnectService_RestartConnector_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "restart"}, ""))
+	pattern_KafkaConnectService_GetConnector_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name"}, ""))
+	pattern_KafkaConnectService_GetConnectorStatus_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "status"}, ""))
+	pattern_KafkaConnectService_PauseConnector_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "pause"}, ""))
+	pattern_KafkaConnectService_ResumeConnector_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "resume"}, ""))
+	pattern_KafkaConnectService_StopConnector_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "stop"}, ""))
+	pattern_KafkaConnectService_DeleteConnector_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name"}, ""))
+	pattern_KafkaConnectService_UpsertConnector_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "config"}, ""))
+	pattern_KafkaConnectService_GetConnectorConfig_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "config"}, ""))
+	pattern_KafkaConnectService_ListConnectorTopics_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "topics"}, ""))
+	pattern_KafkaConnectService_ResetConnectorTopics_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6, 2, 7}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "topics", "reset"}, ""))
+	pattern_KafkaConnectService_GetConnectorOffsets_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "offsets"}, ""))
+	pattern_KafkaConnectService_AlterConnectorOffsets_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "offsets"}, ""))
+	pattern_KafkaConnectService_ResetConnectorOffsets_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"v1", "kafka-connect", "clusters", "cluster_name", "connectors", "name", "offsets"}, ""))
 )
 
 var (
-	forward_KafkaConnectService_ListConnectClusters_0  = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_GetConnectCluster_0    = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_ListConnectors_0       = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_CreateConnector_0      = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_RestartConnector_0     = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_GetConnector_0         = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_GetConnectorStatus_0   = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_PauseConnector_0       = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_ResumeConnector_0      = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_StopConnector_0        = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_DeleteConnector_0      = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_UpsertConnector_0      = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_GetConnectorConfig_0   = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_ListConnectorTopics_0  = runtime.ForwardResponseMessage
-	forward_KafkaConnectService_ResetConnectorTopics_0 = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ListConnectClusters_0   = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_GetConnectCluster_0     = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ListConnectors_0        = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_CreateConnector_0       = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_RestartConnector_0      = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_GetConnector_0          = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_GetConnectorStatus_0    = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_PauseConnector_0        = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ResumeConnector_0       = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_StopConnector_0         = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_DeleteConnector_0       = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_UpsertConnector_0       = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_GetConnectorConfig_0    = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ListConnectorTopics_0   = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ResetConnectorTopics_0  = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_GetConnectorOffsets_0   = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_AlterConnectorOffsets_0 = runtime.ForwardResponseMessage
+	forward_KafkaConnectService_ResetConnectorOffsets_0 = runtime.ForwardResponseMessage
 )
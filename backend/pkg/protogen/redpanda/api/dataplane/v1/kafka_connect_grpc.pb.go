@@ -21,21 +21,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KafkaConnectService_ListConnectClusters_FullMethodName  = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectClusters"
-	KafkaConnectService_GetConnectCluster_FullMethodName    = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectCluster"
-	KafkaConnectService_ListConnectors_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectors"
-	KafkaConnectService_CreateConnector_FullMethodName      = "/redpanda.api.dataplane.v1.KafkaConnectService/CreateConnector"
-	KafkaConnectService_RestartConnector_FullMethodName     = "/redpanda.api.dataplane.v1.KafkaConnectService/RestartConnector"
-	KafkaConnectService_GetConnector_FullMethodName         = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnector"
-	KafkaConnectService_GetConnectorStatus_FullMethodName   = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorStatus"
-	KafkaConnectService_PauseConnector_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/PauseConnector"
-	KafkaConnectService_ResumeConnector_FullMethodName      = "/redpanda.api.dataplane.v1.KafkaConnectService/ResumeConnector"
-	KafkaConnectService_StopConnector_FullMethodName        = "/redpanda.api.dataplane.v1.KafkaConnectService/StopConnector"
-	KafkaConnectService_DeleteConnector_FullMethodName      = "/redpanda.api.dataplane.v1.KafkaConnectService/DeleteConnector"
-	KafkaConnectService_UpsertConnector_FullMethodName      = "/redpanda.api.dataplane.v1.KafkaConnectService/UpsertConnector"
-	KafkaConnectService_GetConnectorConfig_FullMethodName   = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorConfig"
-	KafkaConnectService_ListConnectorTopics_FullMethodName  = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectorTopics"
-	KafkaConnectService_ResetConnectorTopics_FullMethodName = "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_ListConnectClusters_FullMethodName   = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectClusters"
+	KafkaConnectService_GetConnectCluster_FullMethodName     = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectCluster"
+	KafkaConnectService_ListConnectors_FullMethodName        = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectors"
+	KafkaConnectService_CreateConnector_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/CreateConnector"
+	KafkaConnectService_RestartConnector_FullMethodName      = "/redpanda.api.dataplane.v1.KafkaConnectService/RestartConnector"
+	KafkaConnectService_GetConnector_FullMethodName          = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnector"
+	KafkaConnectService_GetConnectorStatus_FullMethodName    = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorStatus"
+	KafkaConnectService_PauseConnector_FullMethodName        = "/redpanda.api.dataplane.v1.KafkaConnectService/PauseConnector"
+	KafkaConnectService_ResumeConnector_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/ResumeConnector"
+	KafkaConnectService_StopConnector_FullMethodName         = "/redpanda.api.dataplane.v1.KafkaConnectService/StopConnector"
+	KafkaConnectService_DeleteConnector_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/DeleteConnector"
+	KafkaConnectService_UpsertConnector_FullMethodName       = "/redpanda.api.dataplane.v1.KafkaConnectService/UpsertConnector"
+	KafkaConnectService_GetConnectorConfig_FullMethodName    = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorConfig"
+	KafkaConnectService_ListConnectorTopics_FullMethodName   = "/redpanda.api.dataplane.v1.KafkaConnectService/ListConnectorTopics"
+	KafkaConnectService_ResetConnectorTopics_FullMethodName  = "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_GetConnectorOffsets_FullMethodName   = "/redpanda.api.dataplane.v1.KafkaConnectService/GetConnectorOffsets"
+	KafkaConnectService_AlterConnectorOffsets_FullMethodName = "/redpanda.api.dataplane.v1.KafkaConnectService/AlterConnectorOffsets"
+	KafkaConnectService_ResetConnectorOffsets_FullMethodName = "/redpanda.api.dataplane.v1.KafkaConnectService/ResetConnectorOffsets"
 )
 
 // KafkaConnectServiceClient is the client API for KafkaConnectService service.
@@ -95,6 +98,12 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(ctx context.Context, in *ResetConnectorTopicsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetConnectorOffsets implements the get connector offsets method, expose a kafka connect equivalent REST endpoint
+	GetConnectorOffsets(ctx context.Context, in *GetConnectorOffsetsRequest, opts ...grpc.CallOption) (*GetConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets implements the alter connector offsets method, expose a kafka connect equivalent REST endpoint
+	AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResetConnectorOffsets implements the reset connector offsets method, expose a kafka connect equivalent REST endpoint
+	ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type kafkaConnectServiceClient struct {
@@ -255,6 +264,36 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, in
 	return out, nil
 }
 
+func (c *kafkaConnectServiceClient) GetConnectorOffsets(ctx context.Context, in *GetConnectorOffsetsRequest, opts ...grpc.CallOption) (*GetConnectorOffsetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_GetConnectorOffsets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KafkaConnectService_AlterConnectorOffsets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ResetConnectorOffsets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KafkaConnectServiceServer is the server API for KafkaConnectService service.
 // All implementations must embed UnimplementedKafkaConnectServiceServer
 // for forward compatibility.
@@ -312,6 +351,12 @@ type KafkaConnectServiceServer interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error)
+	// GetConnectorOffsets implements the get connector offsets method, expose a kafka connect equivalent REST endpoint
+	GetConnectorOffsets(context.Context, *GetConnectorOffsetsRequest) (*GetConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets implements the alter connector offsets method, expose a kafka connect equivalent REST endpoint
+	AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*emptypb.Empty, error)
+	// ResetConnectorOffsets implements the reset connector offsets method, expose a kafka connect equivalent REST endpoint
+	ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedKafkaConnectServiceServer()
 }
 
@@ -367,6 +412,15 @@ func (UnimplementedKafkaConnectServiceServer) ListConnectorTopics(context.Contex
 func (UnimplementedKafkaConnectServiceServer) ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorTopics not implemented")
 }
+func (UnimplementedKafkaConnectServiceServer) GetConnectorOffsets(context.Context, *GetConnectorOffsetsRequest) (*GetConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorOffsets not implemented")
+}
 func (UnimplementedKafkaConnectServiceServer) mustEmbedUnimplementedKafkaConnectServiceServer() {}
 func (UnimplementedKafkaConnectServiceServer) testEmbeddedByValue()                             {}
 
@@ -658,6 +712,60 @@ func _KafkaConnectService_ResetConnectorTopics_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaConnectService_GetConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).GetConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_GetConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).GetConnectorOffsets(ctx, req.(*GetConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_AlterConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_AlterConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, req.(*AlterConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ResetConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ResetConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, req.(*ResetConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KafkaConnectService_ServiceDesc is the grpc.ServiceDesc for KafkaConnectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -725,6 +833,18 @@ var KafkaConnectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetConnectorTopics",
 			Handler:    _KafkaConnectService_ResetConnectorTopics_Handler,
 		},
+		{
+			MethodName: "GetConnectorOffsets",
+			Handler:    _KafkaConnectService_GetConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "AlterConnectorOffsets",
+			Handler:    _KafkaConnectService_AlterConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "ResetConnectorOffsets",
+			Handler:    _KafkaConnectService_ResetConnectorOffsets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "redpanda/api/dataplane/v1/kafka_connect.proto",
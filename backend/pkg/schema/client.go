@@ -17,11 +17,8 @@
 package schema
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io/fs"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -32,10 +29,8 @@ import (
 	"github.com/twmb/avro"
 	"github.com/twmb/franz-go/pkg/sr"
 	"github.com/twmb/go-cache/cache"
-	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/redpanda-data/console/backend/pkg/factory/schema"
-	"github.com/redpanda-data/console/backend/pkg/proto/embed"
 )
 
 const mainProtoFilename = "__console_tmp.proto"
@@ -50,7 +45,9 @@ type CachedClient struct {
 	// multi-tenant environments where compiled schemas must be isolated per tenant.
 	cacheNamespace func(context.Context) (string, error)
 
-	standardImportsResolver func(protocompile.Resolver) protocompile.Resolver
+	// protoImportSources resolve imports of Protobuf schemas that are not
+	// registered as schema references.
+	protoImportSources []ProtoImportSource
 
 	schemaCache        *cache.Cache[string, sr.Schema]
 	subjectSchemaCache *cache.Cache[string, sr.SubjectSchema]
@@ -70,6 +67,7 @@ type Client interface {
 	SchemaByID(ctx context.Context, id int) (sr.Schema, error)
 	SchemaByVersion(ctx context.Context, subject string, id int) (sr.SubjectSchema, error)
 	CompileProtoSchemaWithReferences(ctx context.Context, schema sr.Schema, accessorMap map[string]string) (linker.Files, error)
+	CompileProtoSchemaWithImports(ctx context.Context, schema sr.Schema, accessorMap map[string]string) (linker.Files, []ProtoImport, error)
 }
 
 // Ensure CachedClient implements the Client interface.
@@ -82,100 +80,40 @@ var _ Client = (*CachedClient)(nil)
 // The cacheNamespaceFn should return a unique tenant identifier (e.g., virtual cluster ID,
 // tenant ID) for multi-tenant resource isolation. This ensures compiled schemas are
 // cached separately per tenant, preventing cross-tenant data leakage.
-func NewCachedClient(schemaClientFactory schema.ClientFactory, cacheNamespaceFn func(context.Context) (string, error)) (*CachedClient, error) {
+func NewCachedClient(
+	schemaClientFactory schema.ClientFactory,
+	cacheNamespaceFn func(context.Context) (string, error),
+	opts ...ClientOpt,
+) (*CachedClient, error) {
 	cacheSettings := []cache.Opt{
 		cache.MaxAge(30 * time.Second),
 		cache.MaxErrorAge(time.Second),
 	}
 
-	standardImportsResolver, err := createCustomProtoResolver(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	return &CachedClient{
+	c := &CachedClient{
 		schemaClientFactory: schemaClientFactory,
 		cacheNamespace:      cacheNamespaceFn,
 
-		standardImportsResolver: standardImportsResolver,
-
 		schemaCache:        cache.New[string, sr.Schema](cacheSettings...),
 		subjectSchemaCache: cache.New[string, sr.SubjectSchema](cacheSettings...),
 
 		avroSchemaCache:  cache.New[string, *avro.Schema](cacheSettings...),
 		protoSchemaCache: cache.New[string, linker.Files](cacheSettings...),
 		jsonSchemaCache:  cache.New[string, *jsonschema.Schema](cacheSettings...),
-	}, nil
-}
-
-// createCustomProtoResolver compiles embedded .proto files into file
-// descriptors and returns a resolver function that prioritizes resolving proto
-// files from the embedded filesystem. If a file is not found in the embedded
-// filesystem, it defers to the external resolver. An error is returned if
-// there's an issue reading or compiling the embedded proto files.
-func createCustomProtoResolver(ctx context.Context) (func(protocompile.Resolver) protocompile.Resolver, error) {
-	protoFilesFs, err := fs.Sub(embed.ProtobufStandardSchemas, "protobuf")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load embedded proto files: %w", err)
 	}
-	protoFilepaths := make([]string, 0)
-
-	// Walk through the embedded FS to find .proto files
-	err = fs.WalkDir(protoFilesFs, ".", func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-		if !d.IsDir() && filepath.Ext(path) == ".proto" {
-			protoFilepaths = append(protoFilepaths, path)
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	// Use protocompile.Compiler to compile the .proto files into descriptors
-	resolveFromEmbeddedProtosFn := protocompile.ResolverFunc(func(name string) (protocompile.SearchResult, error) {
-		data, err := fs.ReadFile(protoFilesFs, name)
+	if c.protoImportSources == nil {
+		embedded, err := EmbeddedProtoImportSource(context.Background(), "embedded")
 		if err != nil {
-			return protocompile.SearchResult{}, fmt.Errorf("file not found: %s", name)
+			return nil, err
 		}
-		return protocompile.SearchResult{Source: bytes.NewReader(data)}, nil
-	})
-	compiler := protocompile.Compiler{
-		Resolver:       protocompile.WithStandardImports(resolveFromEmbeddedProtosFn),
-		SourceInfoMode: protocompile.SourceInfoNone,
-	}
-
-	// Compile proto files and store them by filepath so that we can refer to them
-	// in the proto resolver
-	descriptors, err := compiler.Compile(ctx, protoFilepaths...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to compile proto files: %w", err)
-	}
-
-	descriptorsByPath := make(map[string]protoreflect.FileDescriptor, len(protoFilepaths))
-	for _, desc := range descriptors {
-		descriptorsByPath[desc.Path()] = desc
-	}
-
-	// withEmbeddedProtoResolver is a resolver that checks the embedded proto files for descriptors.
-	withEmbeddedProtoResolver := func(r protocompile.Resolver) protocompile.Resolver {
-		return protocompile.ResolverFunc(func(name string) (protocompile.SearchResult, error) {
-			res, err := r.FindFileByPath(name)
-			if err != nil {
-				// error from given resolver? see if it's a known standard file
-				if desc, ok := descriptorsByPath[name]; ok {
-					return protocompile.SearchResult{Desc: desc}, nil
-				}
-			}
-
-			// if not found in embedded files, defer to the provided resolver
-			return res, err
-		})
+		c.protoImportSources = []ProtoImportSource{embedded}
 	}
 
-	return withEmbeddedProtoResolver, nil
+	return c, nil
 }
 
 // AvroSchemaByID retrieves and parses an Avro schema by its ID, using a cached
@@ -271,6 +209,18 @@ func (c *CachedClient) CompileProtoSchemaWithReferences(
 	schema sr.Schema,
 	accessorMap map[string]string,
 ) (linker.Files, error) {
+	files, _, err := c.CompileProtoSchemaWithImports(ctx, schema, accessorMap)
+	return files, err
+}
+
+// CompileProtoSchemaWithImports compiles the schema like CompileProtoSchemaWithReferences
+// and additionally returns each import of the schema along with the source that resolved
+// it, so that users can verify where their imports come from.
+func (c *CachedClient) CompileProtoSchemaWithImports(
+	ctx context.Context,
+	schema sr.Schema,
+	accessorMap map[string]string,
+) (linker.Files, []ProtoImport, error) {
 	// Helper function to recursively fetch and parse all schema references.
 	var loadReferencesFn func(s sr.Schema) error
 	loadReferencesFn = func(s sr.Schema) error {
@@ -290,7 +240,7 @@ func (c *CachedClient) CompileProtoSchemaWithReferences(
 
 	// Load all references into accessorMap before proceeding.
 	if err := loadReferencesFn(schema); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Add the main schema to the accessorMap with a temporary filename.
@@ -300,17 +250,18 @@ func (c *CachedClient) CompileProtoSchemaWithReferences(
 	sourceResolver := &protocompile.SourceResolver{
 		Accessor: protocompile.SourceAccessorFromMap(accessorMap),
 	}
+	trace := newProtoImportTrace()
 	compiler := protocompile.Compiler{
-		Resolver:       protocompile.WithStandardImports(c.standardImportsResolver(sourceResolver)),
+		Resolver:       c.protoImportResolver(ctx, sourceResolver, trace),
 		SourceInfoMode: protocompile.SourceInfoNone,
 	}
 
 	compiled, err := compiler.Compile(ctx, mainProtoFilename)
 	if err != nil {
-		return nil, fmt.Errorf("unable to compile the given schema: %w", err)
+		return nil, nil, fmt.Errorf("unable to compile the given schema (resolved imports: %v): %w", trace, err)
 	}
 
-	return compiled, nil
+	return compiled, trace.imports(), nil
 }
 
 // ParseAvroSchemaWithReferences parses an avro schema that potentially has references
@@ -0,0 +1,329 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/twmb/avro"
+)
+
+// avroChecker implements the schema resolution rules of the Avro specification,
+// but continues after the first incompatibility to collect all of them.
+type avroChecker struct {
+	direction Direction
+	// readerNames and writerNames index the named types of both schemas by
+	// their full and short name, so that name references can be resolved.
+	readerNames map[string]*avro.SchemaNode
+	writerNames map[string]*avro.SchemaNode
+	seen        map[[2]*avro.SchemaNode]bool
+	changes     []BreakingChange
+}
+
+func checkAvro(reader, writer *avro.Schema, direction Direction) []BreakingChange {
+	readerRoot, writerRoot := reader.Root(), writer.Root()
+	c := &avroChecker{
+		direction:   direction,
+		readerNames: make(map[string]*avro.SchemaNode),
+		writerNames: make(map[string]*avro.SchemaNode),
+		seen:        make(map[[2]*avro.SchemaNode]bool),
+	}
+	collectAvroNames(readerRoot, c.readerNames)
+	collectAvroNames(writerRoot, c.writerNames)
+	c.check(readerRoot, writerRoot, "")
+	return c.changes
+}
+
+func (c *avroChecker) report(changeType ChangeType, path, format string, args ...any) {
+	c.changes = append(c.changes, BreakingChange{
+		Type:        changeType,
+		Path:        path,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *avroChecker) check(reader, writer *avro.SchemaNode, path string) {
+	reader, writer = resolveAvroNode(reader, c.readerNames), resolveAvroNode(writer, c.writerNames)
+	pair := [2]*avro.SchemaNode{reader, writer}
+	if c.seen[pair] {
+		return
+	}
+	c.seen[pair] = true
+
+	switch {
+	case writer.Type == "union":
+		for i := range writer.Branches {
+			branch := &writer.Branches[i]
+			if reader.Type == "union" {
+				readerBranch := c.matchingBranch(reader, branch)
+				if readerBranch == nil {
+					c.reportTypeChanged(path, reader, branch)
+					continue
+				}
+				c.check(readerBranch, branch, path)
+				continue
+			}
+			c.check(reader, branch, path)
+		}
+		return
+	case reader.Type == "union":
+		readerBranch := c.matchingBranch(reader, writer)
+		if readerBranch == nil {
+			c.reportTypeChanged(path, reader, writer)
+			return
+		}
+		c.check(readerBranch, writer, path)
+		return
+	case reader.Type != writer.Type:
+		if !isAvroPromotable(writer.Type, reader.Type) {
+			c.reportTypeChanged(path, reader, writer)
+		}
+		return
+	}
+
+	switch reader.Type {
+	case "record", "error":
+		if !avroNamesMatch(reader, writer) {
+			c.reportNameChanged(path, reader, writer)
+			return
+		}
+		c.checkRecord(reader, writer, path)
+	case "enum":
+		if !avroNamesMatch(reader, writer) {
+			c.reportNameChanged(path, reader, writer)
+			return
+		}
+		c.checkEnum(reader, writer, path)
+	case "fixed":
+		if !avroNamesMatch(reader, writer) {
+			c.reportNameChanged(path, reader, writer)
+			return
+		}
+		if reader.Size != writer.Size {
+			c.report(ChangeTypeTypeChanged, path, "Size of fixed type %q changed from %d to %d", reader.Name, c.oldNode(reader, writer).Size, c.newNode(reader, writer).Size)
+		}
+	case "array":
+		c.check(reader.Items, writer.Items, path+"[]")
+	case "map":
+		c.check(reader.Values, writer.Values, path+"{}")
+	}
+}
+
+func (c *avroChecker) checkRecord(reader, writer *avro.SchemaNode, path string) {
+	writerFields := make(map[string]*avro.SchemaField, len(writer.Fields))
+	for i := range writer.Fields {
+		writerFields[writer.Fields[i].Name] = &writer.Fields[i]
+	}
+
+	for i := range reader.Fields {
+		readerField := &reader.Fields[i]
+		fieldPath := joinPath(path, readerField.Name)
+
+		writerField, exists := writerFields[readerField.Name]
+		for _, alias := range readerField.Aliases {
+			if exists {
+				break
+			}
+			writerField, exists = writerFields[alias]
+		}
+		if exists {
+			c.check(&readerField.Type, &writerField.Type, fieldPath)
+			continue
+		}
+		if readerField.HasDefault {
+			continue
+		}
+
+		if c.direction == DirectionBackward {
+			c.report(ChangeTypeFieldAddedWithoutDefault, fieldPath, "Field %q has been added without a default value", readerField.Name)
+		} else {
+			c.report(ChangeTypeFieldRemoved, fieldPath, "Field %q has been removed, but it has no default value in the previous schema", readerField.Name)
+		}
+	}
+}
+
+func (c *avroChecker) checkEnum(reader, writer *avro.SchemaNode, path string) {
+	if reader.HasEnumDefault {
+		return
+	}
+	for _, symbol := range writer.Symbols {
+		if slices.Contains(reader.Symbols, symbol) {
+			continue
+		}
+		if c.direction == DirectionBackward {
+			c.report(ChangeTypeEnumSymbolRemoved, path, "Symbol %q has been removed from enum %q, which has no default symbol", symbol, reader.Name)
+		} else {
+			c.report(ChangeTypeEnumSymbolAdded, path, "Symbol %q has been added to enum %q, but the previous schema has no default symbol", symbol, reader.Name)
+		}
+	}
+}
+
+// matchingBranch returns the first reader union branch that can read the writer
+// schema, preferring branches of the same type.
+func (c *avroChecker) matchingBranch(readerUnion, writer *avro.SchemaNode) *avro.SchemaNode {
+	writer = resolveAvroNode(writer, c.writerNames)
+	var promotable *avro.SchemaNode
+	for i := range readerUnion.Branches {
+		branch := resolveAvroNode(&readerUnion.Branches[i], c.readerNames)
+		if branch.Type == writer.Type {
+			if !isAvroNamedType(branch.Type) || avroNamesMatch(branch, writer) {
+				return branch
+			}
+			continue
+		}
+		if promotable == nil && isAvroPromotable(writer.Type, branch.Type) {
+			promotable = branch
+		}
+	}
+	return promotable
+}
+
+func (c *avroChecker) reportTypeChanged(path string, reader, writer *avro.SchemaNode) {
+	oldNode, newNode := c.oldNode(reader, writer), c.newNode(reader, writer)
+	c.report(ChangeTypeTypeChanged, path, "Type changed from %s to %s", avroTypeName(oldNode, c.namesOf(oldNode, reader)), avroTypeName(newNode, c.namesOf(newNode, reader)))
+}
+
+func (c *avroChecker) reportNameChanged(path string, reader, writer *avro.SchemaNode) {
+	c.report(ChangeTypeNameChanged, path, "Type %q has been renamed to %q without an alias", avroFullName(c.oldNode(reader, writer)), avroFullName(c.newNode(reader, writer)))
+}
+
+// oldNode returns the node of the existing schema.
+func (c *avroChecker) oldNode(reader, writer *avro.SchemaNode) *avro.SchemaNode {
+	if c.direction == DirectionBackward {
+		return writer
+	}
+	return reader
+}
+
+// newNode returns the node of the proposed schema.
+func (c *avroChecker) newNode(reader, writer *avro.SchemaNode) *avro.SchemaNode {
+	if c.direction == DirectionBackward {
+		return reader
+	}
+	return writer
+}
+
+func (c *avroChecker) namesOf(node, reader *avro.SchemaNode) map[string]*avro.SchemaNode {
+	if node == reader {
+		return c.readerNames
+	}
+	return c.writerNames
+}
+
+// isAvroPromotable returns true if data of the writer type can be read as the reader type.
+func isAvroPromotable(writerType, readerType string) bool {
+	switch writerType {
+	case "int":
+		return readerType == "long" || readerType == "float" || readerType == "double"
+	case "long":
+		return readerType == "float" || readerType == "double"
+	case "float":
+		return readerType == "double"
+	case "string":
+		return readerType == "bytes"
+	case "bytes":
+		return readerType == "string"
+	}
+	return false
+}
+
+func isAvroNamedType(t string) bool {
+	return t == "record" || t == "error" || t == "enum" || t == "fixed"
+}
+
+func isAvroBuiltinType(t string) bool {
+	switch t {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string",
+		"record", "error", "enum", "array", "map", "fixed", "union":
+		return true
+	}
+	return false
+}
+
+// avroNamesMatch returns true if the unqualified names match or the reader has an
+// alias for the writer's name, as required by the specification.
+func avroNamesMatch(reader, writer *avro.SchemaNode) bool {
+	if avroShortName(reader.Name) == avroShortName(writer.Name) {
+		return true
+	}
+	for _, alias := range reader.Aliases {
+		if avroShortName(alias) == avroShortName(writer.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func avroShortName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func avroFullName(node *avro.SchemaNode) string {
+	if node.Namespace == "" || strings.Contains(node.Name, ".") {
+		return node.Name
+	}
+	return node.Namespace + "." + node.Name
+}
+
+func avroTypeName(node *avro.SchemaNode, names map[string]*avro.SchemaNode) string {
+	node = resolveAvroNode(node, names)
+	switch {
+	case isAvroNamedType(node.Type):
+		return fmt.Sprintf("%s %q", node.Type, avroFullName(node))
+	case node.Type == "union":
+		branches := make([]string, len(node.Branches))
+		for i := range node.Branches {
+			branches[i] = avroTypeName(&node.Branches[i], names)
+		}
+		return "[" + strings.Join(branches, ", ") + "]"
+	}
+	return node.Type
+}
+
+// collectAvroNames indexes all named types that are defined in the tree.
+func collectAvroNames(node *avro.SchemaNode, names map[string]*avro.SchemaNode) {
+	if node == nil {
+		return
+	}
+	if isAvroNamedType(node.Type) {
+		if _, exists := names[avroFullName(node)]; exists {
+			return
+		}
+		names[avroFullName(node)] = node
+		if _, exists := names[avroShortName(node.Name)]; !exists {
+			names[avroShortName(node.Name)] = node
+		}
+	}
+	for i := range node.Fields {
+		collectAvroNames(&node.Fields[i].Type, names)
+	}
+	for i := range node.Branches {
+		collectAvroNames(&node.Branches[i], names)
+	}
+	collectAvroNames(node.Items, names)
+	collectAvroNames(node.Values, names)
+}
+
+// resolveAvroNode returns the definition of a name reference. Other nodes and
+// references that can't be resolved are returned as they are.
+func resolveAvroNode(node *avro.SchemaNode, names map[string]*avro.SchemaNode) *avro.SchemaNode {
+	if isAvroBuiltinType(node.Type) {
+		return node
+	}
+	if definition, exists := names[node.Type]; exists {
+		return definition
+	}
+	if definition, exists := names[avroShortName(node.Type)]; exists {
+		return definition
+	}
+	return node
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package compatibility checks Avro, Protobuf and JSON schemas for compatibility
+// locally, without asking the schema registry. Unlike the registry's compatibility
+// endpoint, it checks a proposed schema against any set of versions and reports
+// every breaking change it finds rather than just the first one.
+package compatibility
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/twmb/avro"
+	"github.com/twmb/franz-go/pkg/sr"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ChangeType describes the kind of a breaking change.
+type ChangeType string
+
+const (
+	// ChangeTypeFieldRemoved is reported if a field that is required to read
+	// or write the data has been removed.
+	ChangeTypeFieldRemoved ChangeType = "FIELD_REMOVED"
+	// ChangeTypeFieldAdded is reported if a field has been added where no
+	// additional fields are allowed.
+	ChangeTypeFieldAdded ChangeType = "FIELD_ADDED"
+	// ChangeTypeFieldAddedWithoutDefault is reported if a field without a default
+	// value has been added, so that data without this field can't be read.
+	ChangeTypeFieldAddedWithoutDefault ChangeType = "FIELD_ADDED_WITHOUT_DEFAULT"
+	// ChangeTypeRequiredFieldAdded is reported if a field is required that was
+	// optional or did not exist before.
+	ChangeTypeRequiredFieldAdded ChangeType = "REQUIRED_FIELD_ADDED"
+	// ChangeTypeFieldMadeOptional is reported if a required field has become optional.
+	ChangeTypeFieldMadeOptional ChangeType = "FIELD_MADE_OPTIONAL"
+	// ChangeTypeTypeChanged is reported if the type of a field changed in a way
+	// that can't be read.
+	ChangeTypeTypeChanged ChangeType = "TYPE_CHANGED"
+	// ChangeTypeNameChanged is reported if a named type has been renamed
+	// without an alias.
+	ChangeTypeNameChanged ChangeType = "NAME_CHANGED"
+	// ChangeTypeTypeRemoved is reported if a message or enum type has been removed.
+	ChangeTypeTypeRemoved ChangeType = "TYPE_REMOVED"
+	// ChangeTypeEnumSymbolRemoved is reported if an enum symbol has been removed.
+	ChangeTypeEnumSymbolRemoved ChangeType = "ENUM_SYMBOL_REMOVED"
+	// ChangeTypeEnumSymbolAdded is reported if an enum symbol has been added
+	// that can't be read by previous schemas.
+	ChangeTypeEnumSymbolAdded ChangeType = "ENUM_SYMBOL_ADDED"
+	// ChangeTypeReservedFieldReused is reported if a field or enum value uses
+	// a number or name that has been reserved.
+	ChangeTypeReservedFieldReused ChangeType = "RESERVED_FIELD_REUSED"
+	// ChangeTypeConstraintNarrowed is reported if a constraint, such as a maximum
+	// length, has been narrowed so that previously valid data is rejected.
+	ChangeTypeConstraintNarrowed ChangeType = "CONSTRAINT_NARROWED"
+)
+
+// Direction is the direction in which two schemas are checked.
+type Direction string
+
+const (
+	// DirectionBackward checks whether the proposed schema can read data
+	// written with an existing schema.
+	DirectionBackward Direction = "BACKWARD"
+	// DirectionForward checks whether an existing schema can read data
+	// written with the proposed schema.
+	DirectionForward Direction = "FORWARD"
+)
+
+// BreakingChange is a single incompatibility between the proposed schema and
+// an existing schema version.
+type BreakingChange struct {
+	// Version is the existing schema version that is incompatible.
+	Version   int        `json:"version"`
+	Direction Direction  `json:"direction"`
+	Type      ChangeType `json:"type"`
+	// Path is the location of the change, such as the dot separated field
+	// path or the fully qualified name of a Protobuf element.
+	Path        string `json:"path"`
+	Description string `json:"description"`
+}
+
+// Schema is a parsed schema. Exactly one of the format specific fields must be set.
+type Schema struct {
+	Avro *avro.Schema
+	// Protobuf is the file that contains the message types of the schema.
+	Protobuf protoreflect.FileDescriptor
+	// JSON is the decoded JSON schema document.
+	JSON any
+}
+
+// VersionedSchema is a parsed schema of a subject version.
+type VersionedSchema struct {
+	Version int
+	Schema  Schema
+}
+
+// Result is the outcome of checking a proposed schema against existing versions.
+type Result struct {
+	Level           sr.CompatibilityLevel `json:"compatibilityLevel"`
+	IsCompatible    bool                  `json:"isCompatible"`
+	CheckedVersions []int                 `json:"checkedVersions"`
+	BreakingChanges []BreakingChange      `json:"breakingChanges"`
+}
+
+// Check checks the proposed schema against the existing versions as required by
+// the compatibility level. Non-transitive levels only check the latest version.
+func Check(level sr.CompatibilityLevel, proposed Schema, existing []VersionedSchema) (*Result, error) {
+	var directions []Direction
+	transitive := false
+	switch level {
+	case sr.CompatNone:
+	case sr.CompatBackward:
+		directions = []Direction{DirectionBackward}
+	case sr.CompatBackwardTransitive:
+		directions, transitive = []Direction{DirectionBackward}, true
+	case sr.CompatForward:
+		directions = []Direction{DirectionForward}
+	case sr.CompatForwardTransitive:
+		directions, transitive = []Direction{DirectionForward}, true
+	case sr.CompatFull:
+		directions = []Direction{DirectionBackward, DirectionForward}
+	case sr.CompatFullTransitive:
+		directions, transitive = []Direction{DirectionBackward, DirectionForward}, true
+	default:
+		return nil, fmt.Errorf("unsupported compatibility level %q", level)
+	}
+
+	versions := slices.Clone(existing)
+	slices.SortFunc(versions, func(a, b VersionedSchema) int { return a.Version - b.Version })
+	if !transitive && len(versions) > 1 {
+		versions = versions[len(versions)-1:]
+	}
+
+	result := &Result{
+		Level:           level,
+		CheckedVersions: make([]int, 0, len(versions)),
+		BreakingChanges: make([]BreakingChange, 0),
+	}
+	if len(directions) == 0 {
+		result.IsCompatible = true
+		return result, nil
+	}
+
+	for _, version := range versions {
+		result.CheckedVersions = append(result.CheckedVersions, version.Version)
+
+		// Some changes are found in both directions, they are only reported once
+		type changeKey struct {
+			changeType ChangeType
+			path       string
+		}
+		seen := make(map[changeKey]bool)
+		for _, direction := range directions {
+			reader, writer := proposed, version.Schema
+			if direction == DirectionForward {
+				reader, writer = version.Schema, proposed
+			}
+			changes, err := checkPair(reader, writer, direction)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check compatibility with version %d: %w", version.Version, err)
+			}
+			for _, change := range changes {
+				key := changeKey{change.Type, change.Path}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				change.Version = version.Version
+				change.Direction = direction
+				result.BreakingChanges = append(result.BreakingChanges, change)
+			}
+		}
+	}
+	result.IsCompatible = len(result.BreakingChanges) == 0
+
+	return result, nil
+}
+
+// checkPair returns the changes that prevent the reader schema from reading data
+// that has been written with the writer schema.
+func checkPair(reader, writer Schema, direction Direction) ([]BreakingChange, error) {
+	switch {
+	case reader.Avro != nil && writer.Avro != nil:
+		return checkAvro(reader.Avro, writer.Avro, direction), nil
+	case reader.Protobuf != nil && writer.Protobuf != nil:
+		oldFile, newFile := writer.Protobuf, reader.Protobuf
+		if direction == DirectionForward {
+			oldFile, newFile = reader.Protobuf, writer.Protobuf
+		}
+		return checkProtobuf(oldFile, newFile), nil
+	case reader.JSON != nil && writer.JSON != nil:
+		return checkJSONSchema(reader.JSON, writer.JSON, direction), nil
+	}
+
+	readerType, readerOk := reader.schemaType()
+	writerType, writerOk := writer.schemaType()
+	if !readerOk || !writerOk {
+		return nil, errors.New("schema must not be empty")
+	}
+	oldType, newType := writerType, readerType
+	if direction == DirectionForward {
+		oldType, newType = readerType, writerType
+	}
+	return []BreakingChange{{
+		Type:        ChangeTypeTypeChanged,
+		Description: fmt.Sprintf("Schema type changed from %v to %v", oldType, newType),
+	}}, nil
+}
+
+func (s Schema) schemaType() (sr.SchemaType, bool) {
+	switch {
+	case s.Avro != nil:
+		return sr.TypeAvro, true
+	case s.Protobuf != nil:
+		return sr.TypeProtobuf, true
+	case s.JSON != nil:
+		return sr.TypeJSON, true
+	}
+	return 0, false
+}
+
+// joinPath appends the element to the dot separated path.
+func joinPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
+
+// sortBreakingChanges sorts changes by path and type, so that changes that are
+// detected by iterating maps are returned in a stable order.
+func sortBreakingChanges(changes []BreakingChange) {
+	slices.SortFunc(changes, func(a, b BreakingChange) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/avro"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+func mustParseAvro(t *testing.T, schema string) Schema {
+	t.Helper()
+	parsed, err := avro.Parse(schema)
+	require.NoError(t, err)
+	return Schema{Avro: parsed}
+}
+
+func changeTypes(changes []BreakingChange) []ChangeType {
+	types := make([]ChangeType, len(changes))
+	for i, change := range changes {
+		types[i] = change.Type
+	}
+	return types
+}
+
+func TestCheckLevels(t *testing.T) {
+	v1 := mustParseAvro(t, `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`)
+	v2 := mustParseAvro(t, `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"note","type":"string","default":""}]}`)
+	// Drops the field "note" that has a default value and adds "amount" without a default
+	proposed := mustParseAvro(t, `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"long"}]}`)
+	existing := []VersionedSchema{{Version: 2, Schema: v2}, {Version: 1, Schema: v1}}
+
+	tt := []struct {
+		level           sr.CompatibilityLevel
+		checkedVersions []int
+		expected        []BreakingChange
+	}{
+		{
+			level:           sr.CompatNone,
+			checkedVersions: []int{},
+			expected:        []BreakingChange{},
+		},
+		{
+			level:           sr.CompatBackward,
+			checkedVersions: []int{2},
+			expected: []BreakingChange{
+				{Version: 2, Direction: DirectionBackward, Type: ChangeTypeFieldAddedWithoutDefault, Path: "amount"},
+			},
+		},
+		{
+			level:           sr.CompatBackwardTransitive,
+			checkedVersions: []int{1, 2},
+			expected: []BreakingChange{
+				{Version: 1, Direction: DirectionBackward, Type: ChangeTypeFieldAddedWithoutDefault, Path: "amount"},
+				{Version: 2, Direction: DirectionBackward, Type: ChangeTypeFieldAddedWithoutDefault, Path: "amount"},
+			},
+		},
+		{
+			level:           sr.CompatForward,
+			checkedVersions: []int{2},
+			expected:        []BreakingChange{},
+		},
+		{
+			level:           sr.CompatFullTransitive,
+			checkedVersions: []int{1, 2},
+			expected: []BreakingChange{
+				{Version: 1, Direction: DirectionBackward, Type: ChangeTypeFieldAddedWithoutDefault, Path: "amount"},
+				{Version: 2, Direction: DirectionBackward, Type: ChangeTypeFieldAddedWithoutDefault, Path: "amount"},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.level.String(), func(t *testing.T) {
+			result, err := Check(tc.level, proposed, existing)
+			require.NoError(t, err)
+			for i := range result.BreakingChanges {
+				result.BreakingChanges[i].Description = ""
+			}
+			assert.Equal(t, tc.checkedVersions, result.CheckedVersions)
+			assert.Equal(t, tc.expected, result.BreakingChanges)
+			assert.Equal(t, len(tc.expected) == 0, result.IsCompatible)
+		})
+	}
+
+	t.Run("schema type changed", func(t *testing.T) {
+		result, err := Check(sr.CompatBackward, proposed, []VersionedSchema{{Version: 1, Schema: Schema{JSON: map[string]any{}}}})
+		require.NoError(t, err)
+		require.Len(t, result.BreakingChanges, 1)
+		assert.Equal(t, ChangeTypeTypeChanged, result.BreakingChanges[0].Type)
+		assert.Equal(t, "Schema type changed from JSON to AVRO", result.BreakingChanges[0].Description)
+	})
+
+	t.Run("empty schema", func(t *testing.T) {
+		_, err := Check(sr.CompatBackward, proposed, []VersionedSchema{{Version: 1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckAvro(t *testing.T) {
+	tt := []struct {
+		name     string
+		old      string
+		new      string
+		backward []ChangeType
+		forward  []ChangeType
+	}{
+		{
+			name:     "field removed without default",
+			old:      `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"note","type":"string"}]}`,
+			new:      `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{ChangeTypeFieldRemoved},
+		},
+		{
+			name:     "type promotion",
+			old:      `{"type":"record","name":"Order","fields":[{"name":"amount","type":"int"}]}`,
+			new:      `{"type":"record","name":"Order","fields":[{"name":"amount","type":"long"}]}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{ChangeTypeTypeChanged},
+		},
+		{
+			name:     "type change in nested record",
+			old:      `{"type":"record","name":"Order","fields":[{"name":"customer","type":{"type":"record","name":"Customer","fields":[{"name":"age","type":"int"}]}}]}`,
+			new:      `{"type":"record","name":"Order","fields":[{"name":"customer","type":{"type":"record","name":"Customer","fields":[{"name":"age","type":"string"}]}}]}`,
+			backward: []ChangeType{ChangeTypeTypeChanged},
+			forward:  []ChangeType{ChangeTypeTypeChanged},
+		},
+		{
+			name:     "enum symbol removed",
+			old:      `{"type":"enum","name":"Status","symbols":["OPEN","CLOSED","DELETED"]}`,
+			new:      `{"type":"enum","name":"Status","symbols":["OPEN","CLOSED"]}`,
+			backward: []ChangeType{ChangeTypeEnumSymbolRemoved},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "enum symbol removed with default",
+			old:      `{"type":"enum","name":"Status","symbols":["OPEN","CLOSED","DELETED"]}`,
+			new:      `{"type":"enum","name":"Status","symbols":["OPEN","CLOSED"],"default":"OPEN"}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "record renamed with alias",
+			old:      `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`,
+			new:      `{"type":"record","name":"Purchase","aliases":["Order"],"fields":[{"name":"id","type":"string"}]}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{ChangeTypeNameChanged},
+		},
+		{
+			name:     "union branch removed",
+			old:      `{"type":"record","name":"Order","fields":[{"name":"note","type":["null","string","int"]}]}`,
+			new:      `{"type":"record","name":"Order","fields":[{"name":"note","type":["null","string"]}]}`,
+			backward: []ChangeType{ChangeTypeTypeChanged},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "recursive named references",
+			old:      `{"type":"record","name":"Node","fields":[{"name":"children","type":{"type":"array","items":"Node"}}]}`,
+			new:      `{"type":"record","name":"Node","fields":[{"name":"children","type":{"type":"array","items":"Node"}},{"name":"label","type":"string","default":""}]}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSchema, newSchema := mustParseAvro(t, tc.old), mustParseAvro(t, tc.new)
+			assert.Equal(t, tc.backward, changeTypes(checkAvro(newSchema.Avro, oldSchema.Avro, DirectionBackward)), "backward")
+			assert.Equal(t, tc.forward, changeTypes(checkAvro(oldSchema.Avro, newSchema.Avro, DirectionForward)), "forward")
+		})
+	}
+
+	t.Run("path of nested field", func(t *testing.T) {
+		oldSchema := mustParseAvro(t, `{"type":"record","name":"Order","fields":[{"name":"items","type":{"type":"array","items":{"type":"record","name":"Item","fields":[{"name":"sku","type":"string"}]}}}]}`)
+		newSchema := mustParseAvro(t, `{"type":"record","name":"Order","fields":[{"name":"items","type":{"type":"array","items":{"type":"record","name":"Item","fields":[{"name":"sku","type":"long"}]}}}]}`)
+		changes := checkAvro(newSchema.Avro, oldSchema.Avro, DirectionBackward)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "items[].sku", changes[0].Path)
+		assert.Equal(t, "Type changed from string to long", changes[0].Description)
+	})
+}
@@ -0,0 +1,298 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// jsonSchemaChecker checks whether every document that is valid against the writer
+// schema is also valid against the reader schema. It covers types, properties,
+// required properties, enums and the common size and range constraints. Local
+// references are resolved, other references are compared by their URI.
+type jsonSchemaChecker struct {
+	direction  Direction
+	readerRoot any
+	writerRoot any
+	seen       map[[2]string]bool
+	changes    []BreakingChange
+}
+
+func checkJSONSchema(reader, writer any, direction Direction) []BreakingChange {
+	c := &jsonSchemaChecker{
+		direction:  direction,
+		readerRoot: reader,
+		writerRoot: writer,
+		seen:       make(map[[2]string]bool),
+	}
+	c.check(reader, writer, "", "#", "#")
+	sortBreakingChanges(c.changes)
+	return c.changes
+}
+
+func (c *jsonSchemaChecker) report(changeType ChangeType, path, format string, args ...any) {
+	c.changes = append(c.changes, BreakingChange{
+		Type:        changeType,
+		Path:        path,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// check compares two schemas. The pointers identify the schemas within their
+// documents to detect recursive references.
+func (c *jsonSchemaChecker) check(reader, writer any, path, readerPointer, writerPointer string) {
+	reader, readerPointer = resolveJSONSchemaRef(c.readerRoot, reader, readerPointer)
+	writer, writerPointer = resolveJSONSchemaRef(c.writerRoot, writer, writerPointer)
+	pair := [2]string{readerPointer, writerPointer}
+	if c.seen[pair] {
+		return
+	}
+	c.seen[pair] = true
+
+	readerSchema, ok := reader.(map[string]any)
+	if !ok {
+		// A boolean schema that is true accepts everything
+		if accepted, isBool := reader.(bool); isBool && !accepted {
+			c.report(ChangeTypeTypeChanged, path, "Schema rejects all values")
+		}
+		return
+	}
+	writerSchema, _ := writer.(map[string]any)
+	if writerSchema == nil {
+		// The writer accepts everything, but the reader does not. It's most likely
+		// a placeholder rather than data that has actually been written, hence we
+		// don't report it.
+		return
+	}
+
+	if ref, isRef := readerSchema["$ref"].(string); isRef {
+		if writerRef, _ := writerSchema["$ref"].(string); writerRef != ref {
+			c.report(ChangeTypeTypeChanged, path, "Reference changed from %q to %q", c.old(writerRef, ref), c.new(writerRef, ref))
+		}
+		return
+	}
+
+	readerTypes, writerTypes := jsonSchemaTypes(readerSchema), jsonSchemaTypes(writerSchema)
+	if len(readerTypes) > 0 {
+		if len(writerTypes) == 0 || slices.ContainsFunc(writerTypes, func(t string) bool { return !jsonSchemaTypeAccepted(readerTypes, t) }) {
+			c.report(ChangeTypeTypeChanged, path, "Type changed from %v to %v", c.old(jsonSchemaTypeName(writerTypes), jsonSchemaTypeName(readerTypes)), c.new(jsonSchemaTypeName(writerTypes), jsonSchemaTypeName(readerTypes)))
+			return
+		}
+	}
+
+	c.checkEnum(readerSchema, writerSchema, path)
+	c.checkConstraints(readerSchema, writerSchema, path)
+	c.checkObject(readerSchema, writerSchema, path, readerPointer, writerPointer)
+
+	if readerItems, exists := readerSchema["items"]; exists {
+		if writerItems, exists := writerSchema["items"]; exists {
+			c.check(readerItems, writerItems, path+"[]", readerPointer+"/items", writerPointer+"/items")
+		}
+	}
+}
+
+func (c *jsonSchemaChecker) checkEnum(readerSchema, writerSchema map[string]any, path string) {
+	readerEnum, isEnum := readerSchema["enum"].([]any)
+	if !isEnum {
+		return
+	}
+	writerEnum, isEnum := writerSchema["enum"].([]any)
+	if !isEnum {
+		c.report(ChangeTypeConstraintNarrowed, path, "Values have been restricted to an enum")
+		return
+	}
+	for _, value := range writerEnum {
+		if slices.ContainsFunc(readerEnum, func(v any) bool { return reflect.DeepEqual(v, value) }) {
+			continue
+		}
+		if c.direction == DirectionBackward {
+			c.report(ChangeTypeEnumSymbolRemoved, path, "Enum value %v has been removed", value)
+		} else {
+			c.report(ChangeTypeEnumSymbolAdded, path, "Enum value %v has been added", value)
+		}
+	}
+}
+
+// jsonSchemaUpperBounds and jsonSchemaLowerBounds are the constraints that must
+// not decrease respectively increase.
+var (
+	jsonSchemaUpperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+	jsonSchemaLowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+)
+
+func (c *jsonSchemaChecker) checkConstraints(readerSchema, writerSchema map[string]any, path string) {
+	for _, keyword := range jsonSchemaUpperBounds {
+		readerBound, exists := readerSchema[keyword].(float64)
+		if !exists {
+			continue
+		}
+		if writerBound, exists := writerSchema[keyword].(float64); !exists || writerBound > readerBound {
+			c.report(ChangeTypeConstraintNarrowed, path, "%v has been narrowed from %v to %v", keyword, c.old(writerSchema[keyword], readerBound), c.new(writerSchema[keyword], readerBound))
+		}
+	}
+	for _, keyword := range jsonSchemaLowerBounds {
+		readerBound, exists := readerSchema[keyword].(float64)
+		if !exists {
+			continue
+		}
+		if writerBound, exists := writerSchema[keyword].(float64); !exists || writerBound < readerBound {
+			c.report(ChangeTypeConstraintNarrowed, path, "%v has been narrowed from %v to %v", keyword, c.old(writerSchema[keyword], readerBound), c.new(writerSchema[keyword], readerBound))
+		}
+	}
+}
+
+func (c *jsonSchemaChecker) checkObject(readerSchema, writerSchema map[string]any, path, readerPointer, writerPointer string) {
+	readerProperties, _ := readerSchema["properties"].(map[string]any)
+	writerProperties, _ := writerSchema["properties"].(map[string]any)
+	writerRequired := jsonSchemaRequired(writerSchema)
+
+	for _, name := range jsonSchemaRequired(readerSchema) {
+		if slices.Contains(writerRequired, name) {
+			continue
+		}
+		propertyPath := joinPath(path, name)
+		_, existsInWriter := writerProperties[name]
+		switch {
+		case c.direction == DirectionBackward:
+			c.report(ChangeTypeRequiredFieldAdded, propertyPath, "Property %q is required", name)
+		case existsInWriter:
+			c.report(ChangeTypeFieldMadeOptional, propertyPath, "Property %q is no longer required", name)
+		default:
+			c.report(ChangeTypeFieldRemoved, propertyPath, "Required property %q has been removed", name)
+		}
+	}
+
+	for name, writerProperty := range writerProperties {
+		propertyPath := joinPath(path, name)
+		if readerProperty, exists := readerProperties[name]; exists {
+			c.check(readerProperty, writerProperty, propertyPath, readerPointer+"/properties/"+name, writerPointer+"/properties/"+name)
+			continue
+		}
+
+		switch additional := readerSchema["additionalProperties"].(type) {
+		case bool:
+			if additional {
+				continue
+			}
+			if c.direction == DirectionBackward {
+				c.report(ChangeTypeFieldRemoved, propertyPath, "Property %q has been removed, but additional properties are not allowed", name)
+			} else {
+				c.report(ChangeTypeFieldAdded, propertyPath, "Property %q has been added, but the previous schema does not allow additional properties", name)
+			}
+		case map[string]any:
+			c.check(additional, writerProperty, propertyPath, readerPointer+"/additionalProperties", writerPointer+"/properties/"+name)
+		}
+	}
+}
+
+// old returns the value of the existing schema, given the values of the writer and reader.
+func (c *jsonSchemaChecker) old(writerValue, readerValue any) any {
+	if c.direction == DirectionBackward {
+		return jsonSchemaValueOrNone(writerValue)
+	}
+	return jsonSchemaValueOrNone(readerValue)
+}
+
+// new returns the value of the proposed schema, given the values of the writer and reader.
+func (c *jsonSchemaChecker) new(writerValue, readerValue any) any {
+	if c.direction == DirectionBackward {
+		return jsonSchemaValueOrNone(readerValue)
+	}
+	return jsonSchemaValueOrNone(writerValue)
+}
+
+func jsonSchemaValueOrNone(value any) any {
+	if value == nil || value == "" {
+		return "none"
+	}
+	return value
+}
+
+// resolveJSONSchemaRef follows local references, such as #/definitions/Address.
+func resolveJSONSchemaRef(root, schema any, pointer string) (any, string) {
+	for range 32 {
+		schemaMap, isMap := schema.(map[string]any)
+		if !isMap {
+			return schema, pointer
+		}
+		ref, isRef := schemaMap["$ref"].(string)
+		if !isRef || !strings.HasPrefix(ref, "#") {
+			return schema, pointer
+		}
+		resolved, found := jsonPointerLookup(root, strings.TrimPrefix(ref, "#"))
+		if !found {
+			return schema, pointer
+		}
+		schema, pointer = resolved, ref
+	}
+	return schema, pointer
+}
+
+func jsonPointerLookup(root any, pointer string) (any, bool) {
+	current := root
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		object, isObject := current.(map[string]any)
+		if !isObject {
+			return nil, false
+		}
+		var exists bool
+		if current, exists = object[token]; !exists {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func jsonSchemaTypes(schema map[string]any) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// jsonSchemaTypeAccepted returns true if values of the given type are valid for one
+// of the types. Integers are also numbers.
+func jsonSchemaTypeAccepted(types []string, t string) bool {
+	return slices.Contains(types, t) || (t == "integer" && slices.Contains(types, "number"))
+}
+
+func jsonSchemaTypeName(types []string) string {
+	if len(types) == 0 {
+		return ""
+	}
+	return strings.Join(types, "|")
+}
+
+func jsonSchemaRequired(schema map[string]any) []string {
+	required, _ := schema["required"].([]any)
+	names := make([]string, 0, len(required))
+	for _, v := range required {
+		if name, ok := v.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
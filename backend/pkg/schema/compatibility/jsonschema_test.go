@@ -0,0 +1,115 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeJSON(t *testing.T, schema string) any {
+	t.Helper()
+	var decoded any
+	require.NoError(t, json.Unmarshal([]byte(schema), &decoded))
+	return decoded
+}
+
+func TestCheckJSONSchema(t *testing.T) {
+	tt := []struct {
+		name     string
+		old      string
+		new      string
+		backward []ChangeType
+		forward  []ChangeType
+	}{
+		{
+			name:     "property added to open content model",
+			old:      `{"type":"object","properties":{"id":{"type":"string"}}}`,
+			new:      `{"type":"object","properties":{"id":{"type":"string"},"note":{"type":"string"}}}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "property removed from closed content model",
+			old:      `{"type":"object","properties":{"id":{"type":"string"},"note":{"type":"string"}},"additionalProperties":false}`,
+			new:      `{"type":"object","properties":{"id":{"type":"string"}},"additionalProperties":false}`,
+			backward: []ChangeType{ChangeTypeFieldRemoved},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "required property added",
+			old:      `{"type":"object","properties":{"id":{"type":"string"}}}`,
+			new:      `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`,
+			backward: []ChangeType{ChangeTypeRequiredFieldAdded},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "required property removed",
+			old:      `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`,
+			new:      `{"type":"object","properties":{}}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{ChangeTypeFieldRemoved},
+		},
+		{
+			name:     "type widened",
+			old:      `{"type":"object","properties":{"amount":{"type":"integer"}}}`,
+			new:      `{"type":"object","properties":{"amount":{"type":"number"}}}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{ChangeTypeTypeChanged},
+		},
+		{
+			name:     "enum value removed",
+			old:      `{"type":"string","enum":["OPEN","CLOSED"]}`,
+			new:      `{"type":"string","enum":["OPEN"]}`,
+			backward: []ChangeType{ChangeTypeEnumSymbolRemoved},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "max length narrowed",
+			old:      `{"type":"string","maxLength":100}`,
+			new:      `{"type":"string","maxLength":10}`,
+			backward: []ChangeType{ChangeTypeConstraintNarrowed},
+			forward:  []ChangeType{},
+		},
+		{
+			name:     "local references",
+			old:      `{"type":"object","properties":{"address":{"$ref":"#/definitions/Address"}},"definitions":{"Address":{"type":"object","properties":{"zip":{"type":"string"}}}}}`,
+			new:      `{"type":"object","properties":{"address":{"$ref":"#/$defs/Address"}},"$defs":{"Address":{"type":"object","properties":{"zip":{"type":"integer"}}}}}`,
+			backward: []ChangeType{ChangeTypeTypeChanged},
+			forward:  []ChangeType{ChangeTypeTypeChanged},
+		},
+		{
+			name:     "recursive references",
+			old:      `{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#"}}}}`,
+			new:      `{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#"}},"name":{"type":"string"}}}`,
+			backward: []ChangeType{},
+			forward:  []ChangeType{},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSchema, newSchema := mustDecodeJSON(t, tc.old), mustDecodeJSON(t, tc.new)
+			assert.Equal(t, tc.backward, changeTypes(checkJSONSchema(newSchema, oldSchema, DirectionBackward)), "backward")
+			assert.Equal(t, tc.forward, changeTypes(checkJSONSchema(oldSchema, newSchema, DirectionForward)), "forward")
+		})
+	}
+
+	t.Run("path of nested property", func(t *testing.T) {
+		oldSchema := mustDecodeJSON(t, `{"type":"object","properties":{"customer":{"type":"object","properties":{"age":{"type":"integer"}}}}}`)
+		newSchema := mustDecodeJSON(t, `{"type":"object","properties":{"customer":{"type":"object","properties":{"age":{"type":"string"}}}}}`)
+		changes := checkJSONSchema(newSchema, oldSchema, DirectionBackward)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "customer.age", changes[0].Path)
+		assert.Equal(t, "Type changed from integer to string", changes[0].Description)
+	})
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// checkProtobuf compares the message and enum types of two Protobuf files. The
+// Protobuf wire format is the same in both directions, hence the rules only
+// depend on which file is the existing one.
+func checkProtobuf(oldFile, newFile protoreflect.FileDescriptor) []BreakingChange {
+	var changes []BreakingChange
+	report := func(changeType ChangeType, path protoreflect.FullName, format string, args ...any) {
+		changes = append(changes, BreakingChange{
+			Type:        changeType,
+			Path:        string(path),
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	newMessages := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	newEnums := make(map[protoreflect.FullName]protoreflect.EnumDescriptor)
+	walkProtobufTypes(newFile.Messages(), newFile.Enums(), newMessages, newEnums)
+	oldMessages := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	oldEnums := make(map[protoreflect.FullName]protoreflect.EnumDescriptor)
+	walkProtobufTypes(oldFile.Messages(), oldFile.Enums(), oldMessages, oldEnums)
+
+	for name, oldMessage := range oldMessages {
+		newMessage, exists := newMessages[name]
+		if !exists {
+			report(ChangeTypeTypeRemoved, name, "Message %q has been removed", name)
+			continue
+		}
+		checkProtobufMessage(oldMessage, newMessage, report)
+	}
+	for name, oldEnum := range oldEnums {
+		newEnum, exists := newEnums[name]
+		if !exists {
+			report(ChangeTypeTypeRemoved, name, "Enum %q has been removed", name)
+			continue
+		}
+		checkProtobufEnum(oldEnum, newEnum, report)
+	}
+
+	sortBreakingChanges(changes)
+	return changes
+}
+
+type protobufReportFunc func(changeType ChangeType, path protoreflect.FullName, format string, args ...any)
+
+func checkProtobufMessage(oldMessage, newMessage protoreflect.MessageDescriptor, report protobufReportFunc) {
+	oldFields, newFields := oldMessage.Fields(), newMessage.Fields()
+
+	for i := 0; i < oldFields.Len(); i++ {
+		oldField := oldFields.Get(i)
+		newField := newFields.ByNumber(oldField.Number())
+		if newField == nil {
+			if !newMessage.ReservedRanges().Has(oldField.Number()) {
+				report(ChangeTypeFieldRemoved, oldField.FullName(), "Field %q with number %d has been removed without reserving its number", oldField.Name(), oldField.Number())
+			}
+			continue
+		}
+
+		if oldField.Cardinality() != newField.Cardinality() &&
+			(oldField.Cardinality() == protoreflect.Repeated || newField.Cardinality() == protoreflect.Repeated) {
+			report(ChangeTypeTypeChanged, oldField.FullName(), "Field %q changed from %v to %v", oldField.Name(), oldField.Cardinality(), newField.Cardinality())
+			continue
+		}
+		if !isProtobufKindCompatible(oldField, newField) {
+			report(ChangeTypeTypeChanged, oldField.FullName(), "Type of field %q changed from %v to %v", oldField.Name(), protobufTypeName(oldField), protobufTypeName(newField))
+		}
+	}
+
+	for i := 0; i < newFields.Len(); i++ {
+		newField := newFields.Get(i)
+		if oldMessage.ReservedRanges().Has(newField.Number()) {
+			report(ChangeTypeReservedFieldReused, newField.FullName(), "Field %q uses the reserved number %d", newField.Name(), newField.Number())
+		} else if oldMessage.ReservedNames().Has(newField.Name()) {
+			report(ChangeTypeReservedFieldReused, newField.FullName(), "Field %q uses a reserved name", newField.Name())
+		}
+		if newField.Cardinality() == protoreflect.Required && oldFields.ByNumber(newField.Number()) == nil {
+			report(ChangeTypeRequiredFieldAdded, newField.FullName(), "Required field %q has been added", newField.Name())
+		}
+	}
+}
+
+func checkProtobufEnum(oldEnum, newEnum protoreflect.EnumDescriptor, report protobufReportFunc) {
+	oldValues, newValues := oldEnum.Values(), newEnum.Values()
+
+	for i := 0; i < oldValues.Len(); i++ {
+		oldValue := oldValues.Get(i)
+		if newValues.ByNumber(oldValue.Number()) == nil && !newEnum.ReservedRanges().Has(oldValue.Number()) {
+			report(ChangeTypeEnumSymbolRemoved, oldValue.FullName(), "Value %q with number %d has been removed from enum %q without reserving its number", oldValue.Name(), oldValue.Number(), oldEnum.Name())
+		}
+	}
+	for i := 0; i < newValues.Len(); i++ {
+		newValue := newValues.Get(i)
+		if oldEnum.ReservedRanges().Has(newValue.Number()) {
+			report(ChangeTypeReservedFieldReused, newValue.FullName(), "Enum value %q uses the reserved number %d", newValue.Name(), newValue.Number())
+		} else if oldEnum.ReservedNames().Has(newValue.Name()) {
+			report(ChangeTypeReservedFieldReused, newValue.FullName(), "Enum value %q uses a reserved name", newValue.Name())
+		}
+	}
+}
+
+// walkProtobufTypes indexes all messages and enums, including nested ones, by
+// their full name. Map entry messages are part of their field's type and
+// therefore skipped.
+func walkProtobufTypes(
+	messages protoreflect.MessageDescriptors,
+	enums protoreflect.EnumDescriptors,
+	messagesByName map[protoreflect.FullName]protoreflect.MessageDescriptor,
+	enumsByName map[protoreflect.FullName]protoreflect.EnumDescriptor,
+) {
+	for i := 0; i < enums.Len(); i++ {
+		enumsByName[enums.Get(i).FullName()] = enums.Get(i)
+	}
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		messagesByName[message.FullName()] = message
+		walkProtobufTypes(message.Messages(), message.Enums(), messagesByName, enumsByName)
+	}
+}
+
+// protobufWireGroups assigns the same group to kinds that share the wire
+// representation, so that values can be read as either kind.
+var protobufWireGroups = map[protoreflect.Kind]int{
+	protoreflect.Int32Kind:    1,
+	protoreflect.Uint32Kind:   1,
+	protoreflect.Int64Kind:    1,
+	protoreflect.Uint64Kind:   1,
+	protoreflect.BoolKind:     1,
+	protoreflect.EnumKind:     1,
+	protoreflect.Sint32Kind:   2,
+	protoreflect.Sint64Kind:   2,
+	protoreflect.Fixed32Kind:  3,
+	protoreflect.Sfixed32Kind: 3,
+	protoreflect.Fixed64Kind:  4,
+	protoreflect.Sfixed64Kind: 4,
+	protoreflect.StringKind:   5,
+	protoreflect.BytesKind:    5,
+	protoreflect.FloatKind:    6,
+	protoreflect.DoubleKind:   7,
+}
+
+func isProtobufKindCompatible(oldField, newField protoreflect.FieldDescriptor) bool {
+	if oldField.IsMap() != newField.IsMap() {
+		return false
+	}
+	if oldField.IsMap() {
+		return isProtobufKindCompatible(oldField.MapKey(), newField.MapKey()) &&
+			isProtobufKindCompatible(oldField.MapValue(), newField.MapValue())
+	}
+
+	oldKind, newKind := oldField.Kind(), newField.Kind()
+	switch {
+	case oldKind == protoreflect.MessageKind || oldKind == protoreflect.GroupKind:
+		return oldKind == newKind && oldField.Message().FullName() == newField.Message().FullName()
+	case oldKind == protoreflect.EnumKind && newKind == protoreflect.EnumKind:
+		return oldField.Enum().FullName() == newField.Enum().FullName()
+	case oldKind == newKind:
+		return true
+	}
+	oldGroup, oldExists := protobufWireGroups[oldKind]
+	newGroup, newExists := protobufWireGroups[newKind]
+	return oldExists && newExists && oldGroup == newGroup
+}
+
+func protobufTypeName(field protoreflect.FieldDescriptor) string {
+	switch {
+	case field.IsMap():
+		return fmt.Sprintf("map<%v, %v>", protobufTypeName(field.MapKey()), protobufTypeName(field.MapValue()))
+	case field.Message() != nil:
+		return string(field.Message().FullName())
+	case field.Enum() != nil:
+		return string(field.Enum().FullName())
+	}
+	return field.Kind().String()
+}
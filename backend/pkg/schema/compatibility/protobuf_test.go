@@ -0,0 +1,150 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+func mustCompileProtobuf(t *testing.T, schema string) Schema {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"schema.proto": schema}),
+		},
+	}
+	files, err := compiler.Compile(t.Context(), "schema.proto")
+	require.NoError(t, err)
+	return Schema{Protobuf: files[0]}
+}
+
+func TestCheckProtobuf(t *testing.T) {
+	oldSchema := mustCompileProtobuf(t, `
+syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int32 quantity = 2;
+  string note = 3;
+  Status status = 4;
+  reserved 10;
+  reserved "legacy";
+
+  message Item {
+    string sku = 1;
+  }
+  repeated Item items = 5;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+  STATUS_CLOSED = 2;
+}
+
+message Customer {
+  string name = 1;
+}
+`)
+
+	tt := []struct {
+		name     string
+		proposed string
+		expected []BreakingChange
+	}{
+		{
+			name: "compatible changes",
+			proposed: `
+syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int64 quantity = 2;
+  reserved 3;
+  Status status = 4;
+  reserved 10;
+  reserved "legacy";
+
+  message Item {
+    string sku = 1;
+    string name = 2;
+  }
+  repeated Item items = 5;
+  bytes raw = 6;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+  STATUS_CLOSED = 2;
+  STATUS_DELETED = 3;
+}
+
+message Customer {
+  string name = 1;
+}
+`,
+			expected: []BreakingChange{},
+		},
+		{
+			name: "breaking changes",
+			proposed: `
+syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  string quantity = 2;
+  Status status = 4;
+  string legacy = 10;
+
+  message Item {
+    string sku = 1;
+  }
+  Item items = 5;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+}
+`,
+			expected: []BreakingChange{
+				{Type: ChangeTypeTypeRemoved, Path: "shop.Customer"},
+				{Type: ChangeTypeTypeChanged, Path: "shop.Order.items"},
+				{Type: ChangeTypeReservedFieldReused, Path: "shop.Order.legacy"},
+				{Type: ChangeTypeFieldRemoved, Path: "shop.Order.note"},
+				{Type: ChangeTypeTypeChanged, Path: "shop.Order.quantity"},
+				{Type: ChangeTypeEnumSymbolRemoved, Path: "shop.STATUS_CLOSED"},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Check(sr.CompatFull, mustCompileProtobuf(t, tc.proposed), []VersionedSchema{{Version: 1, Schema: oldSchema}})
+			require.NoError(t, err)
+
+			actual := make([]BreakingChange, len(result.BreakingChanges))
+			for i, change := range result.BreakingChanges {
+				assert.Equal(t, 1, change.Version)
+				assert.NotEmpty(t, change.Description)
+				actual[i] = BreakingChange{Type: change.Type, Path: change.Path}
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
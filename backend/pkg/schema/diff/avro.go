@@ -0,0 +1,181 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/twmb/avro"
+)
+
+// Avro returns the differences between two Avro schemas. Named types are matched
+// by their full name, so that every record, enum and fixed type is compared
+// exactly once, regardless of how often it's referenced. Renamed types are
+// reported as removed and added.
+func Avro(oldSchema, newSchema *avro.Schema) []Change {
+	oldRoot, newRoot := oldSchema.Root(), newSchema.Root()
+	oldNames, newNames := make(map[string]*avro.SchemaNode), make(map[string]*avro.SchemaNode)
+	collectAvroNames(oldRoot, oldNames)
+	collectAvroNames(newRoot, newNames)
+
+	var c changes
+	// Unnamed roots, such as arrays or primitives, aren't covered by the named types
+	if !isAvroNamedType(oldRoot.Type) || !isAvroNamedType(newRoot.Type) {
+		c.addIfChanged(ChangeTypeTypeChanged, "", avroTypeName(oldRoot), avroTypeName(newRoot))
+	}
+
+	for name, oldNode := range oldNames {
+		newNode, exists := newNames[name]
+		if !exists {
+			c.add(ChangeTypeTypeRemoved, name, oldNode.Type, "")
+			continue
+		}
+		diffAvroNamedType(&c, name, oldNode, newNode)
+	}
+	for name, newNode := range newNames {
+		if _, exists := oldNames[name]; !exists {
+			c.add(ChangeTypeTypeAdded, name, "", newNode.Type)
+		}
+	}
+
+	return c.sorted()
+}
+
+func diffAvroNamedType(c *changes, path string, oldNode, newNode *avro.SchemaNode) {
+	c.addIfChanged(ChangeTypeDocChanged, path, oldNode.Doc, newNode.Doc)
+	if oldNode.Type != newNode.Type {
+		c.add(ChangeTypeTypeChanged, path, oldNode.Type, newNode.Type)
+		return
+	}
+
+	switch oldNode.Type {
+	case "record", "error":
+		diffAvroFields(c, path, oldNode.Fields, newNode.Fields)
+	case "enum":
+		for _, symbol := range oldNode.Symbols {
+			if !slices.Contains(newNode.Symbols, symbol) {
+				c.add(ChangeTypeEnumSymbolRemoved, path, symbol, "")
+			}
+		}
+		for _, symbol := range newNode.Symbols {
+			if !slices.Contains(oldNode.Symbols, symbol) {
+				c.add(ChangeTypeEnumSymbolAdded, path, "", symbol)
+			}
+		}
+		c.addIfChanged(ChangeTypeDefaultChanged, path, oldNode.EnumDefault, newNode.EnumDefault)
+	case "fixed":
+		c.addIfChanged(ChangeTypeTypeChanged, path, avroFixedTypeName(oldNode), avroFixedTypeName(newNode))
+	}
+}
+
+func diffAvroFields(c *changes, path string, oldFields, newFields []avro.SchemaField) {
+	for i := range oldFields {
+		oldField := &oldFields[i]
+		fieldPath := joinPath(path, oldField.Name)
+		idx := slices.IndexFunc(newFields, func(f avro.SchemaField) bool { return f.Name == oldField.Name })
+		if idx < 0 {
+			c.add(ChangeTypeFieldRemoved, fieldPath, avroTypeName(&oldField.Type), "")
+			continue
+		}
+		newField := &newFields[idx]
+		c.addIfChanged(ChangeTypeTypeChanged, fieldPath, avroTypeName(&oldField.Type), avroTypeName(&newField.Type))
+		c.addIfChanged(ChangeTypeDefaultChanged, fieldPath, avroDefault(oldField), avroDefault(newField))
+		c.addIfChanged(ChangeTypeDocChanged, fieldPath, oldField.Doc, newField.Doc)
+	}
+	for i := range newFields {
+		newField := &newFields[i]
+		if !slices.ContainsFunc(oldFields, func(f avro.SchemaField) bool { return f.Name == newField.Name }) {
+			c.add(ChangeTypeFieldAdded, joinPath(path, newField.Name), "", avroTypeName(&newField.Type))
+		}
+	}
+}
+
+// avroDefault renders the field's default value as JSON, or returns an empty
+// string if the field has no default.
+func avroDefault(field *avro.SchemaField) string {
+	if !field.HasDefault {
+		return ""
+	}
+	b, err := json.Marshal(field.Default)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// avroTypeName describes the type of a node. Named types are referenced by
+// their full name, because their definition is compared separately.
+func avroTypeName(node *avro.SchemaNode) string {
+	var name string
+	switch {
+	case isAvroNamedType(node.Type):
+		name = avroFullName(node)
+	case node.Type == "union":
+		branches := make([]string, len(node.Branches))
+		for i := range node.Branches {
+			branches[i] = avroTypeName(&node.Branches[i])
+		}
+		name = "[" + strings.Join(branches, ", ") + "]"
+	case node.Type == "array" && node.Items != nil:
+		name = "array<" + avroTypeName(node.Items) + ">"
+	case node.Type == "map" && node.Values != nil:
+		name = "map<" + avroTypeName(node.Values) + ">"
+	default:
+		name = node.Type
+	}
+	if node.LogicalType != "" && !isAvroNamedType(node.Type) {
+		name += "(" + node.LogicalType + ")"
+	}
+	return name
+}
+
+func avroFixedTypeName(node *avro.SchemaNode) string {
+	name := "fixed(" + strconv.Itoa(node.Size) + ")"
+	if node.LogicalType != "" {
+		name += " " + node.LogicalType
+	}
+	return name
+}
+
+func isAvroNamedType(t string) bool {
+	return t == "record" || t == "error" || t == "enum" || t == "fixed"
+}
+
+func avroFullName(node *avro.SchemaNode) string {
+	if node.Namespace == "" || strings.Contains(node.Name, ".") {
+		return node.Name
+	}
+	return node.Namespace + "." + node.Name
+}
+
+// collectAvroNames indexes all named types that are defined in the tree by their
+// full name.
+func collectAvroNames(node *avro.SchemaNode, names map[string]*avro.SchemaNode) {
+	if node == nil {
+		return
+	}
+	if isAvroNamedType(node.Type) {
+		if _, exists := names[avroFullName(node)]; exists {
+			return
+		}
+		names[avroFullName(node)] = node
+	}
+	for i := range node.Fields {
+		collectAvroNames(&node.Fields[i].Type, names)
+	}
+	for i := range node.Branches {
+		collectAvroNames(&node.Branches[i], names)
+	}
+	collectAvroNames(node.Items, names)
+	collectAvroNames(node.Values, names)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package diff computes semantic differences between two versions of an Avro,
+// Protobuf or JSON schema, such as added fields or changed types, as well as a
+// unified text diff.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ChangeType describes the kind of a change.
+type ChangeType string
+
+const (
+	// ChangeTypeFieldAdded is reported for fields and properties that have been added.
+	ChangeTypeFieldAdded ChangeType = "FIELD_ADDED"
+	// ChangeTypeFieldRemoved is reported for fields and properties that have been removed.
+	ChangeTypeFieldRemoved ChangeType = "FIELD_REMOVED"
+	// ChangeTypeFieldChanged is reported if a field attribute other than its type,
+	// default or documentation changed, such as a Protobuf field number.
+	ChangeTypeFieldChanged ChangeType = "FIELD_CHANGED"
+	// ChangeTypeTypeChanged is reported if the type of a field changed.
+	ChangeTypeTypeChanged ChangeType = "TYPE_CHANGED"
+	// ChangeTypeDefaultChanged is reported if a default value has been added,
+	// removed or changed.
+	ChangeTypeDefaultChanged ChangeType = "DEFAULT_CHANGED"
+	// ChangeTypeDocChanged is reported if the documentation changed.
+	ChangeTypeDocChanged ChangeType = "DOC_CHANGED"
+	// ChangeTypeTypeAdded is reported for named types, messages and enums that have been added.
+	ChangeTypeTypeAdded ChangeType = "TYPE_ADDED"
+	// ChangeTypeTypeRemoved is reported for named types, messages and enums that have been removed.
+	ChangeTypeTypeRemoved ChangeType = "TYPE_REMOVED"
+	// ChangeTypeEnumSymbolAdded is reported for enum symbols that have been added.
+	ChangeTypeEnumSymbolAdded ChangeType = "ENUM_SYMBOL_ADDED"
+	// ChangeTypeEnumSymbolRemoved is reported for enum symbols that have been removed.
+	ChangeTypeEnumSymbolRemoved ChangeType = "ENUM_SYMBOL_REMOVED"
+)
+
+// Change is a single semantic difference between two schemas.
+type Change struct {
+	Type ChangeType `json:"type"`
+	// Path is the location of the change. Avro and Protobuf paths start with the
+	// fully qualified name of the type, JSON schema paths are dot separated
+	// property names.
+	Path string `json:"path"`
+	// Old and New are the values before and after the change, if applicable.
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// changes collects changes.
+type changes []Change
+
+func (c *changes) add(changeType ChangeType, path, oldValue, newValue string) {
+	*c = append(*c, Change{Type: changeType, Path: path, Old: oldValue, New: newValue})
+}
+
+// addIfChanged adds a change if the old and new value differ.
+func (c *changes) addIfChanged(changeType ChangeType, path, oldValue, newValue string) {
+	if oldValue != newValue {
+		c.add(changeType, path, oldValue, newValue)
+	}
+}
+
+// sorted returns the changes sorted by path, so that the order does not depend on
+// map iteration. Changes of the same path keep the order in which they were found.
+func (c changes) sorted() []Change {
+	result := make([]Change, len(c))
+	copy(result, c)
+	slices.SortStableFunc(result, func(a, b Change) int { return strings.Compare(a.Path, b.Path) })
+	return result
+}
+
+// Unified returns a unified diff of the two schema texts. JSON documents, such as
+// Avro and JSON schemas, are indented first, because they are often registered
+// on a single line.
+func Unified(oldName, oldText, newName, newText string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(indentJSON(oldText)),
+		B:        difflib.SplitLines(indentJSON(newText)),
+		FromFile: oldName,
+		ToFile:   newName,
+		Context:  3,
+	})
+}
+
+func indentJSON(text string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(text), "", "  "); err != nil {
+		return text
+	}
+	buf.WriteByte('\n')
+	return buf.String()
+}
+
+// jsonString renders a value as compact JSON, or returns an empty string for nil.
+func jsonString(value any) string {
+	if value == nil {
+		return ""
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func joinPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/avro"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestAvro(t *testing.T) {
+	oldSchema, err := avro.Parse(`{
+		"type": "record", "name": "Order", "namespace": "shop",
+		"fields": [
+			{"name": "id", "type": "string"},
+			{"name": "amount", "type": "int", "doc": "Amount in cents"},
+			{"name": "note", "type": ["null", "string"], "default": null},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED", "DELETED"]}},
+			{"name": "legacy", "type": {"type": "record", "name": "Legacy", "fields": []}}
+		]
+	}`)
+	require.NoError(t, err)
+	newSchema, err := avro.Parse(`{
+		"type": "record", "name": "Order", "namespace": "shop",
+		"fields": [
+			{"name": "id", "type": "string"},
+			{"name": "amount", "type": "long", "doc": "Amount in cents"},
+			{"name": "note", "type": ["null", "string"], "default": null, "doc": "Free text"},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED", "CANCELLED"], "default": "OPEN"}},
+			{"name": "tags", "type": {"type": "array", "items": "string"}, "default": []}
+		]
+	}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeTypeRemoved, Path: "shop.Legacy", Old: "record"},
+		{Type: ChangeTypeTypeChanged, Path: "shop.Order.amount", Old: "int", New: "long"},
+		{Type: ChangeTypeFieldRemoved, Path: "shop.Order.legacy", Old: "shop.Legacy"},
+		{Type: ChangeTypeDocChanged, Path: "shop.Order.note", New: "Free text"},
+		{Type: ChangeTypeFieldAdded, Path: "shop.Order.tags", New: "array<string>"},
+		{Type: ChangeTypeEnumSymbolRemoved, Path: "shop.Status", Old: "DELETED"},
+		{Type: ChangeTypeEnumSymbolAdded, Path: "shop.Status", New: "CANCELLED"},
+		{Type: ChangeTypeDefaultChanged, Path: "shop.Status", New: "OPEN"},
+	}, Avro(oldSchema, newSchema))
+
+	t.Run("unchanged", func(t *testing.T) {
+		assert.Empty(t, Avro(oldSchema, oldSchema))
+	})
+
+	t.Run("unnamed root", func(t *testing.T) {
+		oldArray, err := avro.Parse(`{"type": "array", "items": "int"}`)
+		require.NoError(t, err)
+		newArray, err := avro.Parse(`{"type": "array", "items": "long"}`)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Type: ChangeTypeTypeChanged, Old: "array<int>", New: "array<long>"}}, Avro(oldArray, newArray))
+	})
+}
+
+func mustCompileProtobuf(t *testing.T, schema string) protoreflect.FileDescriptor {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"schema.proto": schema}),
+		},
+	}
+	files, err := compiler.Compile(t.Context(), "schema.proto")
+	require.NoError(t, err)
+	return files[0]
+}
+
+func TestProtobuf(t *testing.T) {
+	oldFile := mustCompileProtobuf(t, `
+syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int32 quantity = 2;
+  string note = 3;
+  repeated string tags = 4;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+  STATUS_DELETED = 2;
+}
+
+message Legacy {}
+`)
+	newFile := mustCompileProtobuf(t, `
+syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int64 quantity = 2;
+  string note = 5;
+  map<string, string> tags = 4;
+  optional Status status = 6;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+  STATUS_CLOSED = 3;
+}
+
+message Customer {}
+`)
+
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeTypeAdded, Path: "shop.Customer", New: "message"},
+		{Type: ChangeTypeTypeRemoved, Path: "shop.Legacy", Old: "message"},
+		{Type: ChangeTypeFieldChanged, Path: "shop.Order.note", Old: "3", New: "5"},
+		{Type: ChangeTypeTypeChanged, Path: "shop.Order.quantity", Old: "int32", New: "int64"},
+		{Type: ChangeTypeFieldAdded, Path: "shop.Order.status", New: "optional shop.Status = 6"},
+		{Type: ChangeTypeTypeChanged, Path: "shop.Order.tags", Old: "repeated string", New: "map<string, string>"},
+		{Type: ChangeTypeEnumSymbolRemoved, Path: "shop.Status", Old: "STATUS_DELETED = 2"},
+		{Type: ChangeTypeEnumSymbolAdded, Path: "shop.Status", New: "STATUS_CLOSED = 3"},
+	}, Protobuf(oldFile, newFile))
+}
+
+func mustDecodeJSON(t *testing.T, schema string) any {
+	t.Helper()
+	var decoded any
+	require.NoError(t, json.Unmarshal([]byte(schema), &decoded))
+	return decoded
+}
+
+func TestJSONSchema(t *testing.T) {
+	oldSchema := mustDecodeJSON(t, `{
+		"type": "object",
+		"required": ["id"],
+		"properties": {
+			"id": {"type": "string"},
+			"status": {"type": "string", "enum": ["open", "deleted"]},
+			"address": {"$ref": "#/definitions/Address"},
+			"legacy": {"type": "boolean"}
+		},
+		"definitions": {
+			"Address": {"type": "object", "properties": {"zip": {"type": "integer"}}}
+		}
+	}`)
+	newSchema := mustDecodeJSON(t, `{
+		"type": "object",
+		"required": ["id", "status"],
+		"properties": {
+			"id": {"type": "string", "description": "Order ID"},
+			"status": {"type": "string", "enum": ["open", "closed"], "default": "open"},
+			"address": {"$ref": "#/definitions/Address"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {"sku": {"type": "string"}}}}
+		},
+		"definitions": {
+			"Address": {"type": "object", "properties": {"zip": {"type": "string"}}}
+		}
+	}`)
+
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeTypeChanged, Path: "address.zip", Old: "integer", New: "string"},
+		{Type: ChangeTypeDocChanged, Path: "id", New: "Order ID"},
+		{Type: ChangeTypeFieldAdded, Path: "items", New: "array"},
+		{Type: ChangeTypeFieldRemoved, Path: "legacy", Old: "boolean"},
+		{Type: ChangeTypeFieldChanged, Path: "status", Old: "optional", New: "required"},
+		{Type: ChangeTypeDefaultChanged, Path: "status", New: `"open"`},
+		{Type: ChangeTypeEnumSymbolRemoved, Path: "status", Old: `"deleted"`},
+		{Type: ChangeTypeEnumSymbolAdded, Path: "status", New: `"closed"`},
+	}, JSONSchema(oldSchema, newSchema))
+
+	t.Run("recursive reference", func(t *testing.T) {
+		schema := mustDecodeJSON(t, `{"$ref": "#/definitions/Node", "definitions": {"Node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/definitions/Node"}}}}}}`)
+		assert.Empty(t, JSONSchema(schema, schema))
+	})
+}
+
+func TestUnified(t *testing.T) {
+	unified, err := Unified(
+		"orders-value v1", `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`,
+		"orders-value v2", `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"}]}`,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, `--- orders-value v1
++++ orders-value v2
+@@ -4,7 +4,7 @@
+   "fields": [
+     {
+       "name": "id",
+-      "type": "string"
++      "type": "long"
+     }
+   ]
+ }
+`, unified)
+
+	t.Run("identical", func(t *testing.T) {
+		unified, err := Unified("a", "syntax = \"proto3\";\n", "b", "syntax = \"proto3\";\n")
+		require.NoError(t, err)
+		assert.Empty(t, unified)
+	})
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package diff
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// JSONSchema returns the differences between two decoded JSON schema documents.
+// Properties are compared recursively, local references are resolved and other
+// references are compared by their URI.
+func JSONSchema(oldSchema, newSchema any) []Change {
+	d := &jsonSchemaDiffer{
+		oldRoot: oldSchema,
+		newRoot: newSchema,
+		seen:    make(map[[2]string]bool),
+	}
+	d.diff(oldSchema, newSchema, "", "#", "#")
+	return d.changes.sorted()
+}
+
+type jsonSchemaDiffer struct {
+	oldRoot any
+	newRoot any
+	// seen contains the pairs of compared JSON pointers to stop at recursive references.
+	seen    map[[2]string]bool
+	changes changes
+}
+
+func (d *jsonSchemaDiffer) diff(oldSchema, newSchema any, path, oldPointer, newPointer string) {
+	oldSchema, oldPointer = resolveJSONSchemaRef(d.oldRoot, oldSchema, oldPointer)
+	newSchema, newPointer = resolveJSONSchemaRef(d.newRoot, newSchema, newPointer)
+	pair := [2]string{oldPointer, newPointer}
+	if d.seen[pair] {
+		return
+	}
+	d.seen[pair] = true
+
+	oldMap, oldIsMap := oldSchema.(map[string]any)
+	newMap, newIsMap := newSchema.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		// Boolean schemas
+		d.changes.addIfChanged(ChangeTypeTypeChanged, path, jsonString(oldSchema), jsonString(newSchema))
+		return
+	}
+
+	d.changes.addIfChanged(ChangeTypeTypeChanged, path, jsonSchemaTypeName(oldMap), jsonSchemaTypeName(newMap))
+	d.changes.addIfChanged(ChangeTypeDefaultChanged, path, jsonString(oldMap["default"]), jsonString(newMap["default"]))
+	d.changes.addIfChanged(ChangeTypeDocChanged, path, jsonSchemaDoc(oldMap), jsonSchemaDoc(newMap))
+	d.diffEnum(oldMap, newMap, path)
+	d.diffProperties(oldMap, newMap, path, oldPointer, newPointer)
+
+	oldItems, oldHasItems := oldMap["items"]
+	newItems, newHasItems := newMap["items"]
+	if oldHasItems && newHasItems {
+		d.diff(oldItems, newItems, path+"[]", oldPointer+"/items", newPointer+"/items")
+	}
+}
+
+func (d *jsonSchemaDiffer) diffEnum(oldSchema, newSchema map[string]any, path string) {
+	oldEnum, _ := oldSchema["enum"].([]any)
+	newEnum, _ := newSchema["enum"].([]any)
+	for _, value := range oldEnum {
+		if !slices.ContainsFunc(newEnum, func(v any) bool { return reflect.DeepEqual(v, value) }) {
+			d.changes.add(ChangeTypeEnumSymbolRemoved, path, jsonString(value), "")
+		}
+	}
+	for _, value := range newEnum {
+		if !slices.ContainsFunc(oldEnum, func(v any) bool { return reflect.DeepEqual(v, value) }) {
+			d.changes.add(ChangeTypeEnumSymbolAdded, path, "", jsonString(value))
+		}
+	}
+}
+
+func (d *jsonSchemaDiffer) diffProperties(oldSchema, newSchema map[string]any, path, oldPointer, newPointer string) {
+	oldProperties, _ := oldSchema["properties"].(map[string]any)
+	newProperties, _ := newSchema["properties"].(map[string]any)
+	oldRequired, newRequired := jsonSchemaRequired(oldSchema), jsonSchemaRequired(newSchema)
+
+	for name, oldProperty := range oldProperties {
+		propertyPath := joinPath(path, name)
+		newProperty, exists := newProperties[name]
+		if !exists {
+			d.changes.add(ChangeTypeFieldRemoved, propertyPath, jsonSchemaPropertyTypeName(oldProperty), "")
+			continue
+		}
+		d.changes.addIfChanged(ChangeTypeFieldChanged, propertyPath,
+			jsonSchemaRequiredName(slices.Contains(oldRequired, name)), jsonSchemaRequiredName(slices.Contains(newRequired, name)))
+		d.diff(oldProperty, newProperty, propertyPath, oldPointer+"/properties/"+name, newPointer+"/properties/"+name)
+	}
+	for name, newProperty := range newProperties {
+		if _, exists := oldProperties[name]; !exists {
+			d.changes.add(ChangeTypeFieldAdded, joinPath(path, name), "", jsonSchemaPropertyTypeName(newProperty))
+		}
+	}
+}
+
+// resolveJSONSchemaRef follows local references, such as #/definitions/Address.
+func resolveJSONSchemaRef(root, schema any, pointer string) (any, string) {
+	for range 32 {
+		schemaMap, isMap := schema.(map[string]any)
+		if !isMap {
+			return schema, pointer
+		}
+		ref, isRef := schemaMap["$ref"].(string)
+		if !isRef || !strings.HasPrefix(ref, "#") {
+			return schema, pointer
+		}
+		resolved, found := jsonPointerLookup(root, strings.TrimPrefix(ref, "#"))
+		if !found {
+			return schema, pointer
+		}
+		schema, pointer = resolved, ref
+	}
+	return schema, pointer
+}
+
+func jsonPointerLookup(root any, pointer string) (any, bool) {
+	current := root
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		object, isObject := current.(map[string]any)
+		if !isObject {
+			return nil, false
+		}
+		var exists bool
+		if current, exists = object[token]; !exists {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// jsonSchemaTypeName describes the type of a schema. Remote references that
+// can't be resolved are described by their URI.
+func jsonSchemaTypeName(schema map[string]any) string {
+	if ref, isRef := schema["$ref"].(string); isRef {
+		return ref
+	}
+	switch t := schema["type"].(type) {
+	case string:
+		return t
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return strings.Join(types, "|")
+	}
+	return ""
+}
+
+func jsonSchemaPropertyTypeName(property any) string {
+	if schema, isMap := property.(map[string]any); isMap {
+		return jsonSchemaTypeName(schema)
+	}
+	return jsonString(property)
+}
+
+func jsonSchemaDoc(schema map[string]any) string {
+	title, _ := schema["title"].(string)
+	description, _ := schema["description"].(string)
+	return strings.TrimSpace(title + "\n" + description)
+}
+
+func jsonSchemaRequired(schema map[string]any) []string {
+	required, _ := schema["required"].([]any)
+	names := make([]string, 0, len(required))
+	for _, v := range required {
+		if name, ok := v.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func jsonSchemaRequiredName(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
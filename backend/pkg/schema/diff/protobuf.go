@@ -0,0 +1,181 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package diff
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Protobuf returns the differences between the message and enum types of two
+// Protobuf files. Messages and enums are matched by their full name, fields and
+// enum values by their name, so that a changed field number is reported as well.
+// Comments are not compared, because schemas are compiled without source info.
+func Protobuf(oldFile, newFile protoreflect.FileDescriptor) []Change {
+	oldMessages := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	oldEnums := make(map[protoreflect.FullName]protoreflect.EnumDescriptor)
+	walkProtobufTypes(oldFile.Messages(), oldFile.Enums(), oldMessages, oldEnums)
+	newMessages := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	newEnums := make(map[protoreflect.FullName]protoreflect.EnumDescriptor)
+	walkProtobufTypes(newFile.Messages(), newFile.Enums(), newMessages, newEnums)
+
+	var c changes
+	for name, oldMessage := range oldMessages {
+		newMessage, exists := newMessages[name]
+		if !exists {
+			c.add(ChangeTypeTypeRemoved, string(name), "message", "")
+			continue
+		}
+		diffProtobufMessage(&c, oldMessage, newMessage)
+	}
+	for name := range newMessages {
+		if _, exists := oldMessages[name]; !exists {
+			c.add(ChangeTypeTypeAdded, string(name), "", "message")
+		}
+	}
+
+	for name, oldEnum := range oldEnums {
+		newEnum, exists := newEnums[name]
+		if !exists {
+			c.add(ChangeTypeTypeRemoved, string(name), "enum", "")
+			continue
+		}
+		diffProtobufEnum(&c, oldEnum, newEnum)
+	}
+	for name := range newEnums {
+		if _, exists := oldEnums[name]; !exists {
+			c.add(ChangeTypeTypeAdded, string(name), "", "enum")
+		}
+	}
+
+	return c.sorted()
+}
+
+func diffProtobufMessage(c *changes, oldMessage, newMessage protoreflect.MessageDescriptor) {
+	oldFields, newFields := oldMessage.Fields(), newMessage.Fields()
+
+	for i := 0; i < oldFields.Len(); i++ {
+		oldField := oldFields.Get(i)
+		path := string(oldField.FullName())
+		newField := newFields.ByName(oldField.Name())
+		if newField == nil {
+			c.add(ChangeTypeFieldRemoved, path, protobufFieldSignature(oldField), "")
+			continue
+		}
+		c.addIfChanged(ChangeTypeFieldChanged, path, protobufFieldNumber(oldField), protobufFieldNumber(newField))
+		c.addIfChanged(ChangeTypeTypeChanged, path, protobufTypeName(oldField), protobufTypeName(newField))
+		c.addIfChanged(ChangeTypeDefaultChanged, path, protobufDefault(oldField), protobufDefault(newField))
+	}
+	for i := 0; i < newFields.Len(); i++ {
+		newField := newFields.Get(i)
+		if oldFields.ByName(newField.Name()) == nil {
+			c.add(ChangeTypeFieldAdded, string(newField.FullName()), "", protobufFieldSignature(newField))
+		}
+	}
+}
+
+func diffProtobufEnum(c *changes, oldEnum, newEnum protoreflect.EnumDescriptor) {
+	path := string(oldEnum.FullName())
+	oldValues, newValues := oldEnum.Values(), newEnum.Values()
+
+	for i := 0; i < oldValues.Len(); i++ {
+		oldValue := oldValues.Get(i)
+		newValue := newValues.ByName(oldValue.Name())
+		if newValue == nil {
+			c.add(ChangeTypeEnumSymbolRemoved, path, protobufEnumValue(oldValue), "")
+			continue
+		}
+		if oldValue.Number() != newValue.Number() {
+			c.add(ChangeTypeFieldChanged, path, protobufEnumValue(oldValue), protobufEnumValue(newValue))
+		}
+	}
+	for i := 0; i < newValues.Len(); i++ {
+		newValue := newValues.Get(i)
+		if oldValues.ByName(newValue.Name()) == nil {
+			c.add(ChangeTypeEnumSymbolAdded, path, "", protobufEnumValue(newValue))
+		}
+	}
+}
+
+// walkProtobufTypes indexes all messages and enums, including nested ones, by
+// their full name. Map entry messages are part of their field's type and
+// therefore skipped.
+func walkProtobufTypes(
+	messages protoreflect.MessageDescriptors,
+	enums protoreflect.EnumDescriptors,
+	messagesByName map[protoreflect.FullName]protoreflect.MessageDescriptor,
+	enumsByName map[protoreflect.FullName]protoreflect.EnumDescriptor,
+) {
+	for i := 0; i < enums.Len(); i++ {
+		enumsByName[enums.Get(i).FullName()] = enums.Get(i)
+	}
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		messagesByName[message.FullName()] = message
+		walkProtobufTypes(message.Messages(), message.Enums(), messagesByName, enumsByName)
+	}
+}
+
+// protobufTypeName describes the type of a field including its cardinality, for
+// example "repeated string" or "map<string, int64>".
+func protobufTypeName(field protoreflect.FieldDescriptor) string {
+	if field.IsMap() {
+		return fmt.Sprintf("map<%v, %v>", protobufKindName(field.MapKey()), protobufKindName(field.MapValue()))
+	}
+	kind := protobufKindName(field)
+	switch {
+	case field.Cardinality() == protoreflect.Repeated:
+		return "repeated " + kind
+	case field.Cardinality() == protoreflect.Required:
+		return "required " + kind
+	case field.HasOptionalKeyword():
+		return "optional " + kind
+	}
+	return kind
+}
+
+func protobufKindName(field protoreflect.FieldDescriptor) string {
+	switch {
+	case field.Message() != nil:
+		return string(field.Message().FullName())
+	case field.Enum() != nil:
+		return string(field.Enum().FullName())
+	}
+	return field.Kind().String()
+}
+
+func protobufFieldNumber(field protoreflect.FieldDescriptor) string {
+	return strconv.Itoa(int(field.Number()))
+}
+
+func protobufFieldSignature(field protoreflect.FieldDescriptor) string {
+	return fmt.Sprintf("%v = %d", protobufTypeName(field), field.Number())
+}
+
+// protobufDefault returns the explicit default value of a proto2 field, or an
+// empty string if the field has none.
+func protobufDefault(field protoreflect.FieldDescriptor) string {
+	if !field.HasDefault() {
+		return ""
+	}
+	if field.Enum() != nil {
+		return string(field.DefaultEnumValue().Name())
+	}
+	return fmt.Sprint(field.Default().Interface())
+}
+
+func protobufEnumValue(value protoreflect.EnumValueDescriptor) string {
+	return fmt.Sprintf("%v = %d", value.Name(), value.Number())
+}
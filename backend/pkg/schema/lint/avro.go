@@ -0,0 +1,112 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package lint
+
+import (
+	"strings"
+
+	"github.com/twmb/avro"
+)
+
+func (r *rule) lintAvro(subject string, schema *avro.Schema) []Violation {
+	root := schema.Root()
+	if r.name == RuleNamespacePrefix {
+		// Unnamed roots, such as arrays, don't have a namespace
+		if !isAvroNamedType(root.Type) {
+			return nil
+		}
+		name := avroFullName(root)
+		var namespace string
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			namespace = name[:i]
+		}
+		return r.checkNamespace(subject, name, "namespace", namespace)
+	}
+
+	var records []*avro.SchemaNode
+	collectAvroRecords(root, make(map[string]struct{}), &records)
+
+	var violations []Violation
+	for _, record := range records {
+		recordName := avroFullName(record)
+		if r.name == RuleAvroDocRequired && record.Doc == "" {
+			violations = append(violations, r.violation(recordName, "record %q has no doc", recordName))
+		}
+		for i := range record.Fields {
+			field := &record.Fields[i]
+			path := joinPath(recordName, field.Name)
+			switch r.name {
+			case RuleFieldNaming:
+				violations = append(violations, r.checkFieldName(path, field.Name)...)
+			case RuleAvroDocRequired:
+				if field.Doc == "" {
+					violations = append(violations, r.violation(path, "field %q has no doc", field.Name))
+				}
+			case RuleNoFloatMoney:
+				if t := avroFloatType(&field.Type); t != "" && r.pattern.MatchString(field.Name) {
+					violations = append(violations, r.violation(path,
+						"field %q looks like a monetary amount but uses %s, use the decimal logical type instead", field.Name, t))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// avroFloatType returns float or double if the node, or any branch of a union,
+// is a floating point type. Otherwise, it returns an empty string.
+func avroFloatType(node *avro.SchemaNode) string {
+	if node.Type == "float" || node.Type == "double" {
+		return node.Type
+	}
+	for i := range node.Branches {
+		if t := avroFloatType(&node.Branches[i]); t != "" {
+			return t
+		}
+	}
+	return ""
+}
+
+func isAvroNamedType(t string) bool {
+	return t == "record" || t == "error" || t == "enum" || t == "fixed"
+}
+
+func avroFullName(node *avro.SchemaNode) string {
+	if node.Namespace == "" || strings.Contains(node.Name, ".") {
+		return node.Name
+	}
+	return node.Namespace + "." + node.Name
+}
+
+// collectAvroRecords collects all records that are defined in the tree, each
+// one exactly once.
+func collectAvroRecords(node *avro.SchemaNode, seen map[string]struct{}, records *[]*avro.SchemaNode) {
+	if node == nil {
+		return
+	}
+	if isAvroNamedType(node.Type) {
+		name := avroFullName(node)
+		if _, exists := seen[name]; exists {
+			return
+		}
+		seen[name] = struct{}{}
+		if node.Type == "record" || node.Type == "error" {
+			*records = append(*records, node)
+		}
+	}
+	for i := range node.Fields {
+		collectAvroRecords(&node.Fields[i].Type, seen, records)
+	}
+	for i := range node.Branches {
+		collectAvroRecords(&node.Branches[i], seen, records)
+	}
+	collectAvroRecords(node.Items, seen, records)
+	collectAvroRecords(node.Values, seen, records)
+}
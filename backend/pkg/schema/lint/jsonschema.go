@@ -0,0 +1,64 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package lint
+
+import (
+	"maps"
+	"slices"
+)
+
+// lintJSONSchema checks the property names of a decoded JSON schema document.
+// References aren't followed, instead definitions are checked where they are
+// declared. Only FIELD_NAMING applies to JSON schemas.
+func (r *rule) lintJSONSchema(schema any) []Violation {
+	if r.name != RuleFieldNaming {
+		return nil
+	}
+	var violations []Violation
+	r.walkJSONSchema(schema, "", &violations)
+	return violations
+}
+
+func (r *rule) walkJSONSchema(schema any, path string, violations *[]Violation) {
+	schemaMap, isMap := schema.(map[string]any)
+	if !isMap {
+		return
+	}
+
+	properties, _ := schemaMap["properties"].(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		propertyPath := joinPath(path, name)
+		*violations = append(*violations, r.checkFieldName(propertyPath, name)...)
+		r.walkJSONSchema(properties[name], propertyPath, violations)
+	}
+
+	for _, keyword := range []string{"$defs", "definitions"} {
+		definitions, _ := schemaMap[keyword].(map[string]any)
+		for _, name := range slices.Sorted(maps.Keys(definitions)) {
+			r.walkJSONSchema(definitions[name], joinPath(joinPath(path, keyword), name), violations)
+		}
+	}
+
+	switch items := schemaMap["items"].(type) {
+	case map[string]any:
+		r.walkJSONSchema(items, path+"[]", violations)
+	case []any:
+		for _, item := range items {
+			r.walkJSONSchema(item, path+"[]", violations)
+		}
+	}
+	r.walkJSONSchema(schemaMap["additionalProperties"], path, violations)
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		subschemas, _ := schemaMap[keyword].([]any)
+		for _, subschema := range subschemas {
+			r.walkJSONSchema(subschema, path, violations)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package lint checks Avro, Protobuf and JSON schemas against configurable
+// governance rules, such as naming conventions or required documentation,
+// before they are registered in the schema registry.
+package lint
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/schema/compatibility"
+)
+
+// Severity is the severity of a rule violation.
+type Severity string
+
+const (
+	// SeverityError violations prevent the schema from being registered,
+	// unless they are overridden.
+	SeverityError Severity = "ERROR"
+	// SeverityWarning violations are reported, but don't prevent the schema
+	// from being registered.
+	SeverityWarning Severity = "WARNING"
+)
+
+// Names of the available rules.
+const (
+	// RuleFieldNaming requires all field and property names to follow a naming
+	// convention or to match a pattern.
+	RuleFieldNaming = "FIELD_NAMING"
+	// RuleAvroDocRequired requires a doc on all Avro records and their fields.
+	RuleAvroDocRequired = "AVRO_DOC_REQUIRED"
+	// RuleNamespacePrefix requires the namespace of the Avro root type or the
+	// Protobuf package to start with a prefix.
+	RuleNamespacePrefix = "NAMESPACE_PREFIX"
+	// RuleNoFloatMoney forbids float and double types for Avro and Protobuf
+	// fields whose name indicates a monetary amount.
+	RuleNoFloatMoney = "NO_FLOAT_MONEY"
+	// RuleProtobufPackageNaming requires a Protobuf package that matches a pattern,
+	// which defaults to lower_snake_case components.
+	RuleProtobufPackageNaming = "PROTOBUF_PACKAGE_NAMING"
+	// RuleProtobufReservedRemovedFields requires the numbers of Protobuf fields
+	// that have been removed since the previous version to be reserved.
+	RuleProtobufReservedRemovedFields = "PROTOBUF_RESERVED_REMOVED_FIELDS"
+)
+
+var (
+	namingConventions = map[string]*regexp.Regexp{
+		"snake_case": regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+		"camelCase":  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+		"PascalCase": regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	}
+	defaultMoneyPattern   = regexp.MustCompile(`(?i)(price|amount|cost|total|balance|fee|money|salary)`)
+	defaultPackagePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+)
+
+// Violation is a single rule violation.
+type Violation struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Path is the location of the violation, such as the fully qualified name
+	// of a field. It's empty if the violation applies to the whole schema.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// HasErrors returns true if any of the violations has severity ERROR.
+func HasErrors(violations []Violation) bool {
+	return slices.ContainsFunc(violations, func(v Violation) bool { return v.Severity == SeverityError })
+}
+
+// Linter checks schemas against the configured rules.
+type Linter struct {
+	rules []*rule
+}
+
+type rule struct {
+	name     string
+	severity Severity
+	// subjects is nil if the rule applies to all subjects.
+	subjects *regexp.Regexp
+	pattern  *regexp.Regexp
+	// patternName describes the pattern in violation messages.
+	patternName string
+	prefix      string
+}
+
+// NewLinter creates a linter for the configured rules.
+func NewLinter(cfg config.SchemaLint) (*Linter, error) {
+	rules := make([]*rule, 0, len(cfg.Rules))
+	for _, ruleCfg := range cfg.Rules {
+		r := &rule{
+			name:     ruleCfg.Rule,
+			severity: Severity(cmp.Or(ruleCfg.Severity, string(SeverityError))),
+			prefix:   strings.TrimSuffix(ruleCfg.Prefix, "."),
+		}
+		if ruleCfg.Subjects != "" {
+			subjects, err := regexp.Compile(ruleCfg.Subjects)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile subjects regex of rule %q: %w", ruleCfg.Rule, err)
+			}
+			r.subjects = subjects
+		}
+		if ruleCfg.Pattern != "" {
+			pattern, err := regexp.Compile(ruleCfg.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile pattern of rule %q: %w", ruleCfg.Rule, err)
+			}
+			r.pattern, r.patternName = pattern, ruleCfg.Pattern
+		}
+
+		switch ruleCfg.Rule {
+		case RuleFieldNaming:
+			if r.pattern == nil {
+				convention, exists := namingConventions[ruleCfg.Convention]
+				if !exists {
+					return nil, fmt.Errorf("rule %q requires a pattern or one of the conventions snake_case, camelCase or PascalCase, got %q", ruleCfg.Rule, ruleCfg.Convention)
+				}
+				r.pattern, r.patternName = convention, ruleCfg.Convention
+			}
+		case RuleNoFloatMoney:
+			if r.pattern == nil {
+				r.pattern, r.patternName = defaultMoneyPattern, defaultMoneyPattern.String()
+			}
+		case RuleProtobufPackageNaming:
+			if r.pattern == nil {
+				r.pattern, r.patternName = defaultPackagePattern, defaultPackagePattern.String()
+			}
+		case RuleNamespacePrefix:
+			if r.prefix == "" {
+				return nil, fmt.Errorf("rule %q requires a prefix", ruleCfg.Rule)
+			}
+		case RuleAvroDocRequired, RuleProtobufReservedRemovedFields:
+		default:
+			return nil, fmt.Errorf("unknown schema lint rule %q", ruleCfg.Rule)
+		}
+		rules = append(rules, r)
+	}
+	return &Linter{rules: rules}, nil
+}
+
+// RequiresPrevious returns true if a rule that applies to the subject compares
+// the schema with the previous version of the subject.
+func (l *Linter) RequiresPrevious(subject string) bool {
+	return slices.ContainsFunc(l.rules, func(r *rule) bool {
+		return r.name == RuleProtobufReservedRemovedFields && r.appliesTo(subject)
+	})
+}
+
+// Lint checks the schema of the given subject against all rules that apply to
+// the subject. Previous is the latest registered version of the subject, or
+// nil if there is none. Violations are sorted by path.
+func (l *Linter) Lint(subject string, schema compatibility.Schema, previous *compatibility.Schema) []Violation {
+	var violations []Violation
+	for _, r := range l.rules {
+		if !r.appliesTo(subject) {
+			continue
+		}
+		switch {
+		case schema.Avro != nil:
+			violations = append(violations, r.lintAvro(subject, schema.Avro)...)
+		case schema.Protobuf != nil:
+			var previousFile protoreflect.FileDescriptor
+			if previous != nil {
+				previousFile = previous.Protobuf
+			}
+			violations = append(violations, r.lintProtobuf(subject, schema.Protobuf, previousFile)...)
+		case schema.JSON != nil:
+			violations = append(violations, r.lintJSONSchema(schema.JSON)...)
+		}
+	}
+
+	slices.SortStableFunc(violations, func(a, b Violation) int { return strings.Compare(a.Path, b.Path) })
+	return violations
+}
+
+func (r *rule) appliesTo(subject string) bool {
+	return r.subjects == nil || r.subjects.MatchString(subject)
+}
+
+func (r *rule) violation(path, format string, args ...any) Violation {
+	return Violation{
+		Rule:     r.name,
+		Severity: r.severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// expectedPrefix returns the namespace prefix for the subject, with references
+// to capture groups of the subjects regex expanded.
+func (r *rule) expectedPrefix(subject string) string {
+	if r.subjects == nil {
+		return r.prefix
+	}
+	match := r.subjects.FindStringSubmatchIndex(subject)
+	return string(r.subjects.ExpandString(nil, r.prefix, subject, match))
+}
+
+// checkNamespace reports a violation if the namespace does not start with the
+// expected prefix.
+func (r *rule) checkNamespace(subject, path, kind, namespace string) []Violation {
+	prefix := r.expectedPrefix(subject)
+	if namespace == prefix || strings.HasPrefix(namespace, prefix+".") {
+		return nil
+	}
+	return []Violation{r.violation(path, "%s %q does not start with %q", kind, namespace, prefix)}
+}
+
+func (r *rule) checkFieldName(path, name string) []Violation {
+	if r.pattern.MatchString(name) {
+		return nil
+	}
+	return []Violation{r.violation(path, "field name %q does not match %s", name, r.patternName)}
+}
+
+func joinPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/avro"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/schema/compatibility"
+)
+
+func mustNewLinter(t *testing.T, rules ...config.SchemaLintRule) *Linter {
+	t.Helper()
+	linter, err := NewLinter(config.SchemaLint{Enabled: true, Rules: rules})
+	require.NoError(t, err)
+	return linter
+}
+
+func mustCompileProtobuf(t *testing.T, schema string) protoreflect.FileDescriptor {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"schema.proto": schema}),
+		},
+	}
+	files, err := compiler.Compile(t.Context(), "schema.proto")
+	require.NoError(t, err)
+	return files[0]
+}
+
+func TestNewLinter(t *testing.T) {
+	for _, rule := range []config.SchemaLintRule{
+		{Rule: "UNKNOWN"},
+		{Rule: RuleFieldNaming, Convention: "kebab-case"},
+		{Rule: RuleNamespacePrefix},
+		{Rule: RuleNoFloatMoney, Pattern: "("},
+	} {
+		_, err := NewLinter(config.SchemaLint{Enabled: true, Rules: []config.SchemaLintRule{rule}})
+		assert.Error(t, err, rule.Rule)
+	}
+}
+
+func TestLintAvro(t *testing.T) {
+	schema, err := avro.Parse(`{
+		"type": "record", "name": "Order", "namespace": "com.acme.orders", "doc": "An order.",
+		"fields": [
+			{"name": "order_id", "type": "string", "doc": "The ID."},
+			{"name": "totalPrice", "type": ["null", "double"]},
+			{"name": "customer", "type": {
+				"type": "record", "name": "Customer",
+				"fields": [{"name": "name", "type": "string", "doc": "The name."}]
+			}},
+			{"name": "previous", "type": ["null", "Customer"], "doc": "Referenced again."}
+		]
+	}`)
+	require.NoError(t, err)
+
+	linter := mustNewLinter(t,
+		config.SchemaLintRule{Rule: RuleFieldNaming, Convention: "snake_case"},
+		config.SchemaLintRule{Rule: RuleAvroDocRequired, Severity: "WARNING"},
+		config.SchemaLintRule{Rule: RuleNoFloatMoney},
+		config.SchemaLintRule{Rule: RuleNamespacePrefix, Subjects: `^(\w+)-value$`, Prefix: "com.acme.$1"},
+	)
+
+	violations := linter.Lint("orders-value", compatibility.Schema{Avro: schema}, nil)
+	assert.Equal(t, []Violation{
+		{Rule: RuleAvroDocRequired, Severity: SeverityWarning, Path: "com.acme.orders.Customer", Message: `record "com.acme.orders.Customer" has no doc`},
+		{Rule: RuleAvroDocRequired, Severity: SeverityWarning, Path: "com.acme.orders.Order.customer", Message: `field "customer" has no doc`},
+		{Rule: RuleFieldNaming, Severity: SeverityError, Path: "com.acme.orders.Order.totalPrice", Message: `field name "totalPrice" does not match snake_case`},
+		{Rule: RuleAvroDocRequired, Severity: SeverityWarning, Path: "com.acme.orders.Order.totalPrice", Message: `field "totalPrice" has no doc`},
+		{Rule: RuleNoFloatMoney, Severity: SeverityError, Path: "com.acme.orders.Order.totalPrice", Message: `field "totalPrice" looks like a monetary amount but uses double, use the decimal logical type instead`},
+	}, violations)
+	assert.True(t, HasErrors(violations))
+
+	violations = linter.Lint("payments-value", compatibility.Schema{Avro: schema}, nil)
+	assert.Contains(t, violations, Violation{
+		Rule: RuleNamespacePrefix, Severity: SeverityError, Path: "com.acme.orders.Order",
+		Message: `namespace "com.acme.orders" does not start with "com.acme.payments"`,
+	})
+}
+
+func TestLintProtobuf(t *testing.T) {
+	previous := mustCompileProtobuf(t, `
+syntax = "proto3";
+package Shop.V1;
+
+message Order {
+  string id = 1;
+  string note = 2;
+  string comment = 3;
+}
+`)
+	schema := mustCompileProtobuf(t, `
+syntax = "proto3";
+package Shop.V1;
+
+message Order {
+  reserved 2;
+  string id = 1;
+  double unitPrice = 4;
+  map<string, float> fees = 5;
+}
+`)
+
+	linter := mustNewLinter(t,
+		config.SchemaLintRule{Rule: RuleFieldNaming, Convention: "snake_case", Severity: "WARNING"},
+		config.SchemaLintRule{Rule: RuleNoFloatMoney},
+		config.SchemaLintRule{Rule: RuleProtobufPackageNaming},
+		config.SchemaLintRule{Rule: RuleProtobufReservedRemovedFields, Subjects: "^orders"},
+	)
+	assert.True(t, linter.RequiresPrevious("orders-value"))
+	assert.False(t, linter.RequiresPrevious("payments-value"))
+
+	violations := linter.Lint("orders-value", compatibility.Schema{Protobuf: schema}, &compatibility.Schema{Protobuf: previous})
+	assert.Equal(t, []Violation{
+		{Rule: RuleProtobufPackageNaming, Severity: SeverityError, Path: "Shop.V1", Message: `package "Shop.V1" does not match ^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`},
+		{Rule: RuleProtobufReservedRemovedFields, Severity: SeverityError, Path: "Shop.V1.Order.comment", Message: `field "comment" with number 3 has been removed without reserving its number`},
+		{Rule: RuleNoFloatMoney, Severity: SeverityError, Path: "Shop.V1.Order.fees", Message: `field "fees" looks like a monetary amount but uses float, use an integer amount of minor units or a decimal type instead`},
+		{Rule: RuleFieldNaming, Severity: SeverityWarning, Path: "Shop.V1.Order.unitPrice", Message: `field name "unitPrice" does not match snake_case`},
+		{Rule: RuleNoFloatMoney, Severity: SeverityError, Path: "Shop.V1.Order.unitPrice", Message: `field "unitPrice" looks like a monetary amount but uses double, use an integer amount of minor units or a decimal type instead`},
+	}, violations)
+
+	// Without a previous version there is nothing to compare removed fields with
+	violations = linter.Lint("orders-value", compatibility.Schema{Protobuf: schema}, nil)
+	assert.NotContains(t, violations, Violation{
+		Rule: RuleProtobufReservedRemovedFields, Severity: SeverityError, Path: "Shop.V1.Order.comment",
+		Message: `field "comment" with number 3 has been removed without reserving its number`,
+	})
+}
+
+func TestLintJSONSchema(t *testing.T) {
+	var schema any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"orderId": {"type": "string"},
+			"line_items": {"type": "array", "items": {"$ref": "#/$defs/LineItem"}}
+		},
+		"$defs": {
+			"LineItem": {"type": "object", "properties": {"sku": {"type": "string"}, "unit_price": {"type": "number"}}}
+		}
+	}`), &schema))
+
+	linter := mustNewLinter(t, config.SchemaLintRule{Rule: RuleFieldNaming, Convention: "camelCase"})
+	assert.Equal(t, []Violation{
+		{Rule: RuleFieldNaming, Severity: SeverityError, Path: "$defs.LineItem.unit_price", Message: `field name "unit_price" does not match camelCase`},
+		{Rule: RuleFieldNaming, Severity: SeverityError, Path: "line_items", Message: `field name "line_items" does not match camelCase`},
+	}, linter.Lint("orders-value", compatibility.Schema{JSON: schema}, nil))
+}
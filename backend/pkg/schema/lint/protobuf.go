@@ -0,0 +1,101 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package lint
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (r *rule) lintProtobuf(subject string, file, previous protoreflect.FileDescriptor) []Violation {
+	pkg := string(file.Package())
+	switch r.name {
+	case RuleNamespacePrefix:
+		return r.checkNamespace(subject, pkg, "package", pkg)
+	case RuleProtobufPackageNaming:
+		if pkg == "" {
+			return []Violation{r.violation("", "schema has no package")}
+		}
+		if !r.pattern.MatchString(pkg) {
+			return []Violation{r.violation(pkg, "package %q does not match %s", pkg, r.patternName)}
+		}
+		return nil
+	case RuleProtobufReservedRemovedFields:
+		if previous == nil {
+			return nil
+		}
+		return r.lintProtobufRemovedFields(file, previous)
+	}
+
+	var violations []Violation
+	for _, message := range protobufMessages(file.Messages(), nil) {
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			name := string(field.Name())
+			path := string(field.FullName())
+			switch r.name {
+			case RuleFieldNaming:
+				violations = append(violations, r.checkFieldName(path, name)...)
+			case RuleNoFloatMoney:
+				kind := field.Kind()
+				if field.IsMap() {
+					kind = field.MapValue().Kind()
+				}
+				if (kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind) && r.pattern.MatchString(name) {
+					violations = append(violations, r.violation(path,
+						"field %q looks like a monetary amount but uses %v, use an integer amount of minor units or a decimal type instead", name, kind))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// lintProtobufRemovedFields reports fields of the previous version that no
+// longer exist in a message, unless their number is reserved. Messages that
+// have been removed entirely are not checked.
+func (r *rule) lintProtobufRemovedFields(file, previous protoreflect.FileDescriptor) []Violation {
+	messagesByName := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+	for _, message := range protobufMessages(file.Messages(), nil) {
+		messagesByName[message.FullName()] = message
+	}
+
+	var violations []Violation
+	for _, previousMessage := range protobufMessages(previous.Messages(), nil) {
+		message, exists := messagesByName[previousMessage.FullName()]
+		if !exists {
+			continue
+		}
+		previousFields := previousMessage.Fields()
+		for i := 0; i < previousFields.Len(); i++ {
+			field := previousFields.Get(i)
+			if message.Fields().ByNumber(field.Number()) != nil || message.ReservedRanges().Has(field.Number()) {
+				continue
+			}
+			violations = append(violations, r.violation(string(field.FullName()),
+				"field %q with number %d has been removed without reserving its number", field.Name(), field.Number()))
+		}
+	}
+	return violations
+}
+
+// protobufMessages returns all messages, including nested ones. Map entry
+// messages are generated for map fields and therefore skipped.
+func protobufMessages(messages protoreflect.MessageDescriptors, all []protoreflect.MessageDescriptor) []protoreflect.MessageDescriptor {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		all = append(all, message)
+		all = protobufMessages(message.Messages(), all)
+	}
+	return all
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/redpanda-data/console/backend/pkg/proto/embed"
+)
+
+const (
+	protoImportSourceReferences = "schema references"
+	protoImportSourceStandard   = "standard imports"
+	protoImportNotFound         = "not found"
+)
+
+// ProtoImport describes an import of a Protobuf schema along with the name of
+// the source that resolved it.
+type ProtoImport struct {
+	Path   string `json:"path"`
+	Source string `json:"source"`
+}
+
+// ProtoImportSource resolves imports of Protobuf schemas that are not
+// registered as schema references, such as common types or internal protos
+// that are maintained in git.
+type ProtoImportSource struct {
+	// Name identifies the source in error messages, so that users can see
+	// which source resolved each import.
+	Name string
+
+	// FindFileByPath returns the file with the given import path or an error
+	// if the source does not contain it.
+	FindFileByPath func(ctx context.Context, path string) (protocompile.SearchResult, error)
+}
+
+// ClientOpt configures optional behavior of the CachedClient.
+type ClientOpt func(*CachedClient)
+
+// WithProtoImportSources sets the sources that are tried in the given order
+// for Protobuf imports that are not registered as schema references. By
+// default, only the embedded common types are used.
+func WithProtoImportSources(sources ...ProtoImportSource) ClientOpt {
+	return func(c *CachedClient) {
+		c.protoImportSources = sources
+	}
+}
+
+// EmbeddedProtoImportSource compiles the embedded .proto files, such as the
+// common types that Redpanda's schema registry includes, into file descriptors
+// and returns a source that resolves imports of these files. An error is
+// returned if there's an issue reading or compiling the embedded proto files.
+func EmbeddedProtoImportSource(ctx context.Context, name string) (ProtoImportSource, error) {
+	protoFilesFs, err := fs.Sub(embed.ProtobufStandardSchemas, "protobuf")
+	if err != nil {
+		return ProtoImportSource{}, fmt.Errorf("failed to load embedded proto files: %w", err)
+	}
+	protoFilepaths := make([]string, 0)
+
+	// Walk through the embedded FS to find .proto files
+	err = fs.WalkDir(protoFilesFs, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == ".proto" {
+			protoFilepaths = append(protoFilepaths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return ProtoImportSource{}, err
+	}
+
+	// Use protocompile.Compiler to compile the .proto files into descriptors
+	resolveFromEmbeddedProtosFn := protocompile.ResolverFunc(func(name string) (protocompile.SearchResult, error) {
+		data, err := fs.ReadFile(protoFilesFs, name)
+		if err != nil {
+			return protocompile.SearchResult{}, fmt.Errorf("file not found: %s", name)
+		}
+		return protocompile.SearchResult{Source: bytes.NewReader(data)}, nil
+	})
+	compiler := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(resolveFromEmbeddedProtosFn),
+		SourceInfoMode: protocompile.SourceInfoNone,
+	}
+
+	// Compile proto files and store them by filepath so that we can refer to them
+	// in the proto resolver
+	descriptors, err := compiler.Compile(ctx, protoFilepaths...)
+	if err != nil {
+		return ProtoImportSource{}, fmt.Errorf("failed to compile proto files: %w", err)
+	}
+
+	descriptorsByPath := make(map[string]protoreflect.FileDescriptor, len(protoFilepaths))
+	for _, desc := range descriptors {
+		descriptorsByPath[desc.Path()] = desc
+	}
+
+	return ProtoImportSource{
+		Name: name,
+		FindFileByPath: func(_ context.Context, path string) (protocompile.SearchResult, error) {
+			if desc, ok := descriptorsByPath[path]; ok {
+				return protocompile.SearchResult{Desc: desc}, nil
+			}
+			return protocompile.SearchResult{}, protoregistry.NotFound
+		},
+	}, nil
+}
+
+// protoImportResolver returns a resolver that looks up imports in the schema
+// references first, then in the standard imports and finally in the configured
+// import sources. The source of each resolved import is recorded in trace.
+func (c *CachedClient) protoImportResolver(ctx context.Context, references protocompile.Resolver, trace *protoImportTrace) protocompile.Resolver {
+	standardImports := protocompile.WithStandardImports(protocompile.ResolverFunc(func(string) (protocompile.SearchResult, error) {
+		return protocompile.SearchResult{}, protoregistry.NotFound
+	}))
+
+	return protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+		if res, err := references.FindFileByPath(path); err == nil {
+			trace.record(path, protoImportSourceReferences)
+			return res, nil
+		}
+		if res, err := standardImports.FindFileByPath(path); err == nil {
+			trace.record(path, protoImportSourceStandard)
+			return res, nil
+		}
+		for _, source := range c.protoImportSources {
+			if res, err := source.FindFileByPath(ctx, path); err == nil {
+				trace.record(path, source.Name)
+				return res, nil
+			}
+		}
+
+		trace.record(path, protoImportNotFound)
+		return protocompile.SearchResult{}, fmt.Errorf("file not found: %s", path)
+	})
+}
+
+// protoImportTrace records which source resolved each import of a schema. The
+// compiler may resolve imports concurrently.
+type protoImportTrace struct {
+	mu      sync.Mutex
+	sources map[string]string
+}
+
+func newProtoImportTrace() *protoImportTrace {
+	return &protoImportTrace{sources: make(map[string]string)}
+}
+
+func (t *protoImportTrace) record(path, source string) {
+	if path == mainProtoFilename {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sources[path] = source
+}
+
+// imports returns the recorded imports sorted by path.
+func (t *protoImportTrace) imports() []ProtoImport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	imports := make([]ProtoImport, 0, len(t.sources))
+	for _, path := range slices.Sorted(maps.Keys(t.sources)) {
+		imports = append(imports, ProtoImport{Path: path, Source: t.sources[path]})
+	}
+	return imports
+}
+
+// String lists the imports along with their sources, sorted by path.
+func (t *protoImportTrace) String() string {
+	imports := t.imports()
+	formatted := make([]string, len(imports))
+	for i, imp := range imports {
+		formatted[i] = imp.Path + " (" + imp.Source + ")"
+	}
+	return strings.Join(formatted, ", ")
+}
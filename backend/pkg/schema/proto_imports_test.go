@@ -0,0 +1,105 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/sr"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// mapProtoImportSource returns a source that serves the given files.
+func mapProtoImportSource(name string, files map[string]string) ProtoImportSource {
+	return ProtoImportSource{
+		Name: name,
+		FindFileByPath: func(_ context.Context, path string) (protocompile.SearchResult, error) {
+			content, ok := files[path]
+			if !ok {
+				return protocompile.SearchResult{}, protoregistry.NotFound
+			}
+			return protocompile.SearchResult{Source: strings.NewReader(content)}, nil
+		},
+	}
+}
+
+func TestCompileProtoSchemaWithImportSources(t *testing.T) {
+	embedded, err := EmbeddedProtoImportSource(t.Context(), "embedded")
+	require.NoError(t, err)
+	git := mapProtoImportSource("git", map[string]string{
+		"acme/common.proto": `syntax = "proto3"; package acme; message Id { string value = 1; }`,
+		// Shadowed by the embedded source, which is tried first
+		"google/type/money.proto": `syntax = "proto3"; package broken;`,
+	})
+
+	client, err := NewCachedClient(nil, func(context.Context) (string, error) { return "", nil },
+		WithProtoImportSources(embedded, git))
+	require.NoError(t, err)
+
+	t.Run("imports are resolved in order", func(t *testing.T) {
+		files, err := client.CompileProtoSchemaWithReferences(t.Context(), sr.Schema{
+			Type: sr.TypeProtobuf,
+			Schema: `syntax = "proto3";
+import "acme/common.proto";
+import "google/type/money.proto";
+import "google/protobuf/timestamp.proto";
+message Payment {
+  acme.Id id = 1;
+  google.type.Money amount = 2;
+  google.protobuf.Timestamp created_at = 3;
+}`,
+		}, make(map[string]string))
+		require.NoError(t, err)
+
+		payment := files.FindFileByPath(mainProtoFilename).Messages().ByName("Payment")
+		require.NotNil(t, payment)
+		assert.Equal(t, "google.type.Money", string(payment.Fields().ByName("amount").Message().FullName()))
+	})
+
+	t.Run("imports are returned with their source", func(t *testing.T) {
+		_, imports, err := client.CompileProtoSchemaWithImports(t.Context(), sr.Schema{
+			Type: sr.TypeProtobuf,
+			Schema: `syntax = "proto3";
+import "acme/common.proto";
+import "google/type/money.proto";
+import "google/protobuf/timestamp.proto";
+message Payment {
+  acme.Id id = 1;
+  google.type.Money amount = 2;
+  google.protobuf.Timestamp created_at = 3;
+}`,
+		}, make(map[string]string))
+		require.NoError(t, err)
+
+		// The compiler may resolve further files implicitly, e.g. descriptor.proto
+		assert.Subset(t, imports, []ProtoImport{
+			{Path: "acme/common.proto", Source: "git"},
+			{Path: "google/protobuf/timestamp.proto", Source: protoImportSourceStandard},
+			{Path: "google/type/money.proto", Source: "embedded"},
+		}, imports)
+	})
+
+	t.Run("error lists the source of each import", func(t *testing.T) {
+		_, err := client.CompileProtoSchemaWithReferences(t.Context(), sr.Schema{
+			Type: sr.TypeProtobuf,
+			Schema: `syntax = "proto3";
+import "acme/common.proto";
+import "acme/missing.proto";
+message Payment { acme.Id id = 1; }`,
+		}, make(map[string]string))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "acme/common.proto (git), acme/missing.proto (not found)")
+	})
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schemasample
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldGeneratorKind selects how the values of a field are generated.
+type FieldGeneratorKind string
+
+const (
+	// FieldGeneratorFirstName generates first names such as "Alice".
+	FieldGeneratorFirstName FieldGeneratorKind = "FIRST_NAME"
+	// FieldGeneratorLastName generates last names such as "Smith".
+	FieldGeneratorLastName FieldGeneratorKind = "LAST_NAME"
+	// FieldGeneratorFullName generates a first and last name separated by a space.
+	FieldGeneratorFullName FieldGeneratorKind = "FULL_NAME"
+	// FieldGeneratorEmail generates email addresses.
+	FieldGeneratorEmail FieldGeneratorKind = "EMAIL"
+	// FieldGeneratorUUID generates random (version 4) UUIDs.
+	FieldGeneratorUUID FieldGeneratorKind = "UUID"
+	// FieldGeneratorTimestamp generates timestamps between From and To. String
+	// fields receive RFC 3339 timestamps, numeric fields the unit of their
+	// logical type, which defaults to epoch milliseconds.
+	FieldGeneratorTimestamp FieldGeneratorKind = "TIMESTAMP"
+	// FieldGeneratorNumber generates numbers between Min and Max. Integer
+	// fields receive whole numbers.
+	FieldGeneratorNumber FieldGeneratorKind = "NUMBER"
+	// FieldGeneratorOneOf picks one of Values at random.
+	FieldGeneratorOneOf FieldGeneratorKind = "ONE_OF"
+)
+
+// FieldGenerator overrides how the values of a single field are generated.
+type FieldGenerator struct {
+	Kind FieldGeneratorKind `json:"kind"`
+	// From and To bound TIMESTAMP values. They default to the year before
+	// GeneratorOptions.Now.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// Min and Max bound NUMBER values.
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+	// Values are the candidates of ONE_OF. They must be valid JSON encodings of
+	// the field, e.g. wrapped union branches for Avro.
+	Values []any `json:"values"`
+}
+
+// GeneratorOptions configures a Generator.
+type GeneratorOptions struct {
+	// Seed of the random source. Generators with the same seed, options and
+	// schema generate the same records.
+	Seed int64
+	// Now is the reference time for default timestamp ranges. It defaults to
+	// the current time and must be set for reproducible timestamps.
+	Now time.Time
+	// Fields overrides the values of individual fields, keyed by their path
+	// from the root. Path elements are separated by dots; array items and map
+	// values are addressed by appending "[]", e.g. "items[].price".
+	Fields map[string]FieldGenerator
+}
+
+// maxGeneratorDepth limits how deep nested records and messages are generated.
+// Beyond it, optional values are left empty so that recursive types terminate.
+const maxGeneratorDepth = 4
+
+// Generator generates randomized records that are valid for a schema. Values
+// respect the schema's types, enums, formats, logical types and unions. A
+// Generator is not safe for concurrent use.
+type Generator struct {
+	rng    *rand.Rand
+	now    time.Time
+	fields map[string]FieldGenerator
+	next   func() ([]byte, error)
+}
+
+func newGenerator(opts GeneratorOptions) (*Generator, error) {
+	for path, field := range opts.Fields {
+		if err := field.validate(); err != nil {
+			return nil, fmt.Errorf("invalid generator for field %q: %w", path, err)
+		}
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	seed := uint64(opts.Seed)
+	return &Generator{
+		rng:    rand.New(rand.NewPCG(seed, seed)),
+		now:    now,
+		fields: opts.Fields,
+	}, nil
+}
+
+func (f *FieldGenerator) validate() error {
+	switch f.Kind {
+	case FieldGeneratorFirstName, FieldGeneratorLastName, FieldGeneratorFullName, FieldGeneratorEmail, FieldGeneratorUUID:
+	case FieldGeneratorTimestamp:
+		if !f.From.IsZero() && !f.To.IsZero() && f.To.Before(f.From) {
+			return errors.New("to must not be before from")
+		}
+	case FieldGeneratorNumber:
+		if f.Max < f.Min {
+			return errors.New("max must not be less than min")
+		}
+	case FieldGeneratorOneOf:
+		if len(f.Values) == 0 {
+			return errors.New("at least one value is required")
+		}
+	default:
+		return fmt.Errorf("unknown generator kind %q", f.Kind)
+	}
+	return nil
+}
+
+// Next generates the JSON encoding of the next record. For Avro schemas, this
+// is the Avro JSON encoding with wrapped union branches.
+func (g *Generator) Next() ([]byte, error) {
+	return g.next()
+}
+
+// valueKind describes the type a generated value must have, independent of
+// the schema format.
+type valueKind struct {
+	// base is one of the JSON Schema types string, integer, number or boolean,
+	// or "timestamp" for Protobuf's well-known Timestamp.
+	base string
+	// logical is the Avro logical type or JSON Schema format, if any.
+	logical string
+}
+
+// overrideValue generates a value of the given kind with a field generator.
+func (g *Generator) overrideValue(field FieldGenerator, kind valueKind) any {
+	switch field.Kind {
+	case FieldGeneratorFirstName:
+		return g.pick(firstNames)
+	case FieldGeneratorLastName:
+		return g.pick(lastNames)
+	case FieldGeneratorFullName:
+		return g.pick(firstNames) + " " + g.pick(lastNames)
+	case FieldGeneratorEmail:
+		return g.email()
+	case FieldGeneratorUUID:
+		return g.uuid()
+	case FieldGeneratorTimestamp:
+		return g.timestampValue(g.timeBetween(field.From, field.To), kind)
+	case FieldGeneratorNumber:
+		n := field.Min + g.rng.Float64()*(field.Max-field.Min)
+		if kind.base == jsonTypeInteger {
+			return int64(math.Round(n))
+		}
+		return math.Round(n*100) / 100
+	case FieldGeneratorOneOf:
+		return field.Values[g.rng.IntN(len(field.Values))]
+	}
+	return nil
+}
+
+// timestampValue encodes t for the given kind, e.g. as RFC 3339 string or as
+// a number in the unit of the logical type.
+func (g *Generator) timestampValue(t time.Time, kind valueKind) any {
+	t = t.UTC()
+	switch kind.base {
+	case jsonTypeString:
+		switch kind.logical {
+		case "date":
+			return t.Format(time.DateOnly)
+		case "time":
+			return t.Format("15:04:05Z07:00")
+		}
+		return t.Format(time.RFC3339)
+	case jsonTypeNumber:
+		return float64(t.UnixMilli())
+	}
+	switch kind.logical {
+	case "date":
+		return t.Unix() / int64(24*time.Hour/time.Second)
+	case "time-millis":
+		return t.Sub(t.Truncate(24 * time.Hour)).Milliseconds()
+	case "time-micros":
+		return t.Sub(t.Truncate(24 * time.Hour)).Microseconds()
+	case "timestamp-micros", "local-timestamp-micros":
+		return t.UnixMicro()
+	case "timestamp-nanos", "local-timestamp-nanos":
+		return t.UnixNano()
+	}
+	return t.UnixMilli()
+}
+
+// timeBetween returns a random time in [from, to). Unset bounds default to the
+// year before the reference time.
+func (g *Generator) timeBetween(from, to time.Time) time.Time {
+	if to.IsZero() {
+		to = g.now
+	}
+	if from.IsZero() {
+		from = to.AddDate(-1, 0, 0)
+	}
+	span := to.Sub(from)
+	if span <= 0 {
+		return from
+	}
+	return from.Add(time.Duration(g.rng.Int64N(int64(span))))
+}
+
+// randomTime returns a random time within the year before the reference time.
+func (g *Generator) randomTime() time.Time {
+	return g.timeBetween(time.Time{}, time.Time{})
+}
+
+func (g *Generator) pick(values []string) string {
+	return values[g.rng.IntN(len(values))]
+}
+
+func (g *Generator) email() string {
+	return strings.ToLower(g.pick(firstNames)+"."+g.pick(lastNames)) + "@" + g.pick(emailDomains)
+}
+
+func (g *Generator) uuid() string {
+	var b [16]byte
+	for i := range b {
+		b[i] = byte(g.rng.UintN(256))
+	}
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// words returns between minWords and maxWords random words separated by spaces.
+func (g *Generator) words(minWords, maxWords int) string {
+	n := minWords + g.rng.IntN(maxWords-minWords+1)
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = g.pick(loremWords)
+	}
+	return strings.Join(parts, " ")
+}
+
+// stringOfLength returns random words whose length is between minLength and
+// maxLength. A negative maxLength means unbounded.
+func (g *Generator) stringOfLength(minLength, maxLength int) string {
+	s := g.words(1, 3)
+	for len(s) < minLength {
+		s += " " + g.pick(loremWords)
+	}
+	if maxLength >= 0 && len(s) > maxLength {
+		s = strings.TrimSpace(s[:maxLength])
+		for len(s) < minLength {
+			s += "x"
+		}
+	}
+	return s
+}
+
+// decimal returns a random decimal number with the given precision and scale
+// as JSON number.
+func (g *Generator) decimal(precision, scale int) json.Number {
+	if precision <= 0 {
+		precision = 10
+	}
+	scale = min(max(scale, 0), precision)
+	// Limit the number of digits to stay within the precision of a float64
+	digits := min(precision, 12)
+	unscaled := g.rng.Int64N(int64(math.Pow10(digits)))
+	value := float64(unscaled) / math.Pow10(min(scale, digits))
+	return json.Number(strconv.FormatFloat(value, 'f', scale, 64))
+}
+
+var (
+	firstNames = []string{
+		"Alice", "Bob", "Carla", "David", "Elena", "Farid", "Grace", "Hiro", "Ines", "Jonas",
+		"Kira", "Liam", "Maya", "Noah", "Olga", "Pavel", "Quinn", "Rosa", "Sam", "Tara",
+	}
+	lastNames = []string{
+		"Anderson", "Becker", "Chen", "Dubois", "Evans", "Fischer", "Garcia", "Hansen", "Ito", "Johnson",
+		"Kowalski", "Lopez", "Meyer", "Nakamura", "Okafor", "Petrov", "Rossi", "Smith", "Tanaka", "Weber",
+	}
+	emailDomains = []string{"example.com", "example.org", "example.net"}
+	loremWords   = []string{
+		"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do",
+		"eiusmod", "tempor", "incididunt", "ut", "labore", "et", "dolore", "magna", "aliqua", "enim",
+	}
+)
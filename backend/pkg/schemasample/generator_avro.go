@@ -0,0 +1,267 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schemasample
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// NewAvroGenerator creates a generator for an Avro schema. Like Avro, it walks
+// the schema JSON, so named types must be defined within the schema itself.
+func NewAvroGenerator(schemaText string, opts GeneratorOptions) (*Generator, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(schemaText), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse avro schema JSON: %w", err)
+	}
+	g, err := newGenerator(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &avroGenerator{Generator: g, registry: map[string]any{}}
+	collectAvroNamed(raw, "", a.registry)
+	g.next = func() ([]byte, error) {
+		return json.Marshal(a.value(raw, "", "", 0))
+	}
+	return g, nil
+}
+
+type avroGenerator struct {
+	*Generator
+	registry map[string]any
+}
+
+// value generates the Avro JSON encoding of a random value for node.
+func (a *avroGenerator) value(node any, enclosingNS, path string, depth int) any {
+	if field, ok := a.fields[path]; ok && path != "" {
+		return a.override(node, enclosingNS, field)
+	}
+
+	switch v := node.(type) {
+	case string:
+		if isAvroPrimitive(v) {
+			return a.primitive(v)
+		}
+		ref, ok := avroLookup(v, enclosingNS, a.registry)
+		if !ok {
+			return ""
+		}
+		return a.value(ref, enclosingNS, path, depth)
+	case []any:
+		return a.union(v, enclosingNS, path, depth)
+	case map[string]any:
+		return a.object(v, enclosingNS, path, depth)
+	}
+	return nil
+}
+
+func (a *avroGenerator) primitive(t string) any {
+	switch t {
+	case avroTypeBool:
+		return a.rng.IntN(2) == 0
+	case avroTypeInt:
+		return a.rng.IntN(1000)
+	case avroTypeLong:
+		return a.rng.Int64N(1_000_000)
+	case avroTypeFloat, avroTypeDouble:
+		return math.Round(a.rng.Float64()*100_000) / 100
+	case avroTypeString:
+		return a.words(1, 3)
+	case avroTypeBytes:
+		return a.pick(loremWords)
+	}
+	return nil
+}
+
+// union picks a random branch. Beyond the maximum depth, null is preferred so
+// that recursive types terminate.
+func (a *avroGenerator) union(branches []any, enclosingNS, path string, depth int) any {
+	if len(branches) == 0 {
+		return nil
+	}
+	if depth >= maxGeneratorDepth {
+		for _, branch := range branches {
+			if s, ok := branch.(string); ok && s == avroTypeNull {
+				return nil
+			}
+		}
+	}
+	branch := branches[a.rng.IntN(len(branches))]
+	if s, ok := branch.(string); ok && s == avroTypeNull {
+		return nil
+	}
+	return map[string]any{
+		a.branchKey(branch, enclosingNS): a.value(branch, enclosingNS, path, depth),
+	}
+}
+
+// branchKey is like avroBranchKey, but resolves named references to their
+// full name.
+func (a *avroGenerator) branchKey(branch any, enclosingNS string) string {
+	if name, ok := branch.(string); ok && !isAvroPrimitive(name) && enclosingNS != "" {
+		if _, exists := a.registry[enclosingNS+"."+name]; exists {
+			return enclosingNS + "." + name
+		}
+	}
+	return avroBranchKey(branch, enclosingNS)
+}
+
+func (a *avroGenerator) object(v map[string]any, enclosingNS, path string, depth int) any {
+	t, isString := v["type"].(string)
+	if !isString {
+		// Nested type definitions such as {"type": {"type": "array", ...}}
+		return a.value(v["type"], enclosingNS, path, depth)
+	}
+
+	switch t {
+	case avroTypeRecord, "error":
+		return a.record(v, enclosingNS, path, depth)
+	case avroTypeEnum:
+		symbols, _ := v["symbols"].([]any)
+		if len(symbols) == 0 {
+			return ""
+		}
+		return symbols[a.rng.IntN(len(symbols))]
+	case avroTypeArray:
+		items := make([]any, a.collectionSize(depth))
+		for i := range items {
+			items[i] = a.value(v["items"], enclosingNS, path+"[]", depth+1)
+		}
+		return items
+	case avroTypeMap:
+		values := make(map[string]any)
+		for range a.collectionSize(depth) {
+			values[a.pick(loremWords)] = a.value(v["values"], enclosingNS, path+"[]", depth+1)
+		}
+		return values
+	case avroTypeFixed:
+		if stringField(v, "logicalType") == "decimal" {
+			return a.decimal(intField(v, "precision"), intField(v, "scale"))
+		}
+		return a.fixedString(intField(v, "size"))
+	}
+
+	switch lt := stringField(v, "logicalType"); lt {
+	case "decimal":
+		return a.decimal(intField(v, "precision"), intField(v, "scale"))
+	case "uuid":
+		return a.uuid()
+	case "date", "time-millis", "time-micros", "timestamp-millis", "timestamp-micros", "timestamp-nanos",
+		"local-timestamp-millis", "local-timestamp-micros", "local-timestamp-nanos":
+		return a.timestampValue(a.randomTime(), valueKind{base: jsonTypeInteger, logical: lt})
+	}
+	return a.value(t, enclosingNS, path, depth)
+}
+
+func (a *avroGenerator) record(v map[string]any, enclosingNS, path string, depth int) any {
+	ns := stringField(v, "namespace")
+	if ns == "" {
+		ns = enclosingNS
+	}
+	out := map[string]any{}
+	fields, _ := v["fields"].([]any)
+	for _, f := range fields {
+		fm, ok := f.(map[string]any)
+		if !ok {
+			continue
+		}
+		name := stringField(fm, "name")
+		out[name] = a.value(fm["type"], ns, joinFieldPath(path, name), depth+1)
+	}
+	return out
+}
+
+// override generates a value with a field generator. Unions use their first
+// non-null branch, unless the generator provides already encoded values.
+func (a *avroGenerator) override(node any, enclosingNS string, field FieldGenerator) any {
+	branches, isUnion := node.([]any)
+	if !isUnion {
+		return a.overrideValue(field, a.kind(node, enclosingNS))
+	}
+	if field.Kind == FieldGeneratorOneOf {
+		return a.overrideValue(field, valueKind{})
+	}
+	for _, branch := range branches {
+		if s, ok := branch.(string); ok && s == avroTypeNull {
+			continue
+		}
+		return map[string]any{a.branchKey(branch, enclosingNS): a.overrideValue(field, a.kind(branch, enclosingNS))}
+	}
+	return nil
+}
+
+// kind returns the kind of value an Avro type requires.
+func (a *avroGenerator) kind(node any, enclosingNS string) valueKind {
+	switch v := node.(type) {
+	case string:
+		switch v {
+		case avroTypeInt, avroTypeLong:
+			return valueKind{base: jsonTypeInteger}
+		case avroTypeFloat, avroTypeDouble:
+			return valueKind{base: jsonTypeNumber}
+		case avroTypeBool:
+			return valueKind{base: jsonTypeBool}
+		}
+		if ref, ok := avroLookup(v, enclosingNS, a.registry); ok {
+			return a.kind(ref, enclosingNS)
+		}
+	case map[string]any:
+		t, isString := v["type"].(string)
+		if !isString {
+			return a.kind(v["type"], enclosingNS)
+		}
+		kind := a.kind(t, enclosingNS)
+		kind.logical = stringField(v, "logicalType")
+		return kind
+	}
+	return valueKind{base: jsonTypeString}
+}
+
+func (a *avroGenerator) collectionSize(depth int) int {
+	if depth >= maxGeneratorDepth {
+		return 0
+	}
+	return 1 + a.rng.IntN(3)
+}
+
+func (a *avroGenerator) fixedString(size int) string {
+	var b strings.Builder
+	for range size {
+		b.WriteByte(byte('a' + a.rng.IntN(26)))
+	}
+	return b.String()
+}
+
+func isAvroPrimitive(t string) bool {
+	switch t {
+	case avroTypeNull, avroTypeBool, avroTypeInt, avroTypeLong, avroTypeFloat, avroTypeDouble, avroTypeBytes, avroTypeString:
+		return true
+	}
+	return false
+}
+
+// intField extracts a numeric field of a schema node, such as the size of a
+// fixed type, returning 0 when it's missing.
+func intField(m map[string]any, key string) int {
+	if v, ok := m[key].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
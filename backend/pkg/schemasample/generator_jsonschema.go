@@ -0,0 +1,294 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schemasample
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// NewJSONSchemaGenerator creates a generator for a JSON Schema document. It
+// supports the same subset as JSONSchema, plus the common string formats and
+// the bounds of strings, numbers and arrays. Optional properties are left out
+// at random.
+func NewJSONSchemaGenerator(schemaText string, opts GeneratorOptions) (*Generator, error) {
+	var root any
+	if err := json.Unmarshal([]byte(schemaText), &root); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON schema: %w", err)
+	}
+	g, err := newGenerator(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &jsonSchemaGenerator{Generator: g, root: root, defs: collectJSONDefs(root)}
+	g.next = func() ([]byte, error) {
+		return json.Marshal(j.value(root, "", 0))
+	}
+	return g, nil
+}
+
+type jsonSchemaGenerator struct {
+	*Generator
+	root any
+	defs map[string]any
+}
+
+func (j *jsonSchemaGenerator) value(node any, path string, depth int) any {
+	if field, ok := j.fields[path]; ok && path != "" {
+		return j.overrideValue(field, j.kind(node, depth))
+	}
+
+	m, ok := node.(map[string]any)
+	if !ok {
+		// Boolean schemas: true accepts any value, false none
+		if accepted, _ := node.(bool); accepted {
+			return j.words(1, 2)
+		}
+		return nil
+	}
+	if target, ok := j.resolveRef(m); ok {
+		if depth >= 2*maxGeneratorDepth {
+			return nil
+		}
+		return j.value(target, path, depth+1)
+	}
+	if c, has := m["const"]; has {
+		return c
+	}
+	if enum, _ := m["enum"].([]any); len(enum) > 0 {
+		return enum[j.rng.IntN(len(enum))]
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if alts, _ := m[key].([]any); len(alts) > 0 {
+			return j.value(alts[j.rng.IntN(len(alts))], path, depth)
+		}
+	}
+	if alts, _ := m["allOf"].([]any); len(alts) > 0 {
+		return j.allOf(m, alts, path, depth)
+	}
+
+	switch t := m["type"].(type) {
+	case string:
+		return j.typed(t, m, path, depth)
+	case []any:
+		var choices []string
+		for _, choice := range t {
+			if s, ok := choice.(string); ok && s != jsonTypeNull {
+				choices = append(choices, s)
+			}
+		}
+		if len(choices) == 0 {
+			return nil
+		}
+		return j.typed(j.pick(choices), m, path, depth)
+	}
+	if _, has := m["properties"]; has {
+		return j.typed(jsonTypeObject, m, path, depth)
+	}
+	if _, has := m["items"]; has {
+		return j.typed(jsonTypeArray, m, path, depth)
+	}
+	return j.words(1, 2)
+}
+
+// allOf merges the objects generated for all subschemas, including the
+// properties declared next to allOf.
+func (j *jsonSchemaGenerator) allOf(m map[string]any, alts []any, path string, depth int) any {
+	merged := map[string]any{}
+	if _, has := m["properties"]; has {
+		own, _ := j.typed(jsonTypeObject, m, path, depth).(map[string]any)
+		maps.Copy(merged, own)
+	}
+	for _, alt := range alts {
+		value := j.value(alt, path, depth)
+		object, isObject := value.(map[string]any)
+		if !isObject {
+			return value
+		}
+		maps.Copy(merged, object)
+	}
+	return merged
+}
+
+func (j *jsonSchemaGenerator) typed(t string, m map[string]any, path string, depth int) any {
+	switch t {
+	case jsonTypeObject:
+		return j.object(m, path, depth)
+	case jsonTypeArray:
+		return j.array(m, path, depth)
+	case jsonTypeString:
+		return j.string(m)
+	case jsonTypeInteger:
+		lo, hi := jsonSchemaRange(m, true)
+		return int64(lo) + j.rng.Int64N(int64(hi-lo)+1)
+	case jsonTypeNumber:
+		lo, hi := jsonSchemaRange(m, false)
+		return math.Round((lo+j.rng.Float64()*(hi-lo))*100) / 100
+	case jsonTypeBool:
+		return j.rng.IntN(2) == 0
+	}
+	return nil
+}
+
+func (j *jsonSchemaGenerator) object(m map[string]any, path string, depth int) any {
+	out := map[string]any{}
+	properties, _ := m["properties"].(map[string]any)
+	required, _ := m["required"].([]any)
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		isRequired := slices.Contains(required, any(name))
+		if !isRequired && (depth >= maxGeneratorDepth || j.rng.IntN(4) == 0) {
+			continue
+		}
+		out[name] = j.value(properties[name], joinFieldPath(path, name), depth+1)
+	}
+	return out
+}
+
+func (j *jsonSchemaGenerator) array(m map[string]any, path string, depth int) any {
+	// Tuples, either as prefixItems or as items array in older drafts
+	tuple, isTuple := m["prefixItems"].([]any)
+	if !isTuple {
+		tuple, isTuple = m["items"].([]any)
+	}
+	if isTuple {
+		out := make([]any, len(tuple))
+		for i, item := range tuple {
+			out[i] = j.value(item, path+"[]", depth+1)
+		}
+		return out
+	}
+
+	minItems, maxItems := 1, 3
+	if v, ok := m["minItems"].(float64); ok {
+		minItems = int(v)
+	} else if depth >= maxGeneratorDepth {
+		minItems = 0
+	}
+	if v, ok := m["maxItems"].(float64); ok {
+		maxItems = int(v)
+	}
+	maxItems = max(maxItems, minItems)
+	if depth >= maxGeneratorDepth {
+		maxItems = minItems
+	}
+
+	out := make([]any, minItems+j.rng.IntN(maxItems-minItems+1))
+	for i := range out {
+		out[i] = j.value(m["items"], path+"[]", depth+1)
+	}
+	return out
+}
+
+func (j *jsonSchemaGenerator) string(m map[string]any) any {
+	format := stringField(m, "format")
+	switch format {
+	case "email":
+		return j.email()
+	case "uuid":
+		return j.uuid()
+	case "date-time", "date", "time":
+		return j.timestampValue(j.randomTime(), valueKind{base: jsonTypeString, logical: format})
+	case "uri", "url":
+		return "https://" + j.pick(emailDomains) + "/" + j.pick(loremWords)
+	case "hostname":
+		return j.pick(loremWords) + "." + j.pick(emailDomains)
+	case "ipv4":
+		return fmt.Sprintf("10.%d.%d.%d", j.rng.IntN(256), j.rng.IntN(256), 1+j.rng.IntN(254))
+	}
+
+	minLength, maxLength := 0, -1
+	if v, ok := m["minLength"].(float64); ok {
+		minLength = int(v)
+	}
+	if v, ok := m["maxLength"].(float64); ok {
+		maxLength = int(v)
+	}
+	return j.stringOfLength(minLength, maxLength)
+}
+
+// kind returns the kind of value a JSON schema requires.
+func (j *jsonSchemaGenerator) kind(node any, depth int) valueKind {
+	m, ok := node.(map[string]any)
+	if !ok {
+		return valueKind{base: jsonTypeString}
+	}
+	if target, ok := j.resolveRef(m); ok && depth < 2*maxGeneratorDepth {
+		return j.kind(target, depth+1)
+	}
+	t, _ := m["type"].(string)
+	if types, ok := m["type"].([]any); ok {
+		for _, choice := range types {
+			if s, ok := choice.(string); ok && s != jsonTypeNull {
+				t = s
+				break
+			}
+		}
+	}
+	if t == "" {
+		t = jsonTypeString
+	}
+	return valueKind{base: t, logical: stringField(m, "format")}
+}
+
+// resolveRef looks up local references to the root and into $defs and
+// definitions.
+func (j *jsonSchemaGenerator) resolveRef(m map[string]any) (any, bool) {
+	ref, ok := m["$ref"].(string)
+	if !ok {
+		return nil, false
+	}
+	if ref == "#" {
+		return j.root, true
+	}
+	for _, prefix := range []string{"#/$defs/", "#/definitions/"} {
+		if key, found := strings.CutPrefix(ref, prefix); found {
+			target, exists := j.defs[jsonPointerUnescape(key)]
+			return target, exists
+		}
+	}
+	return nil, false
+}
+
+// jsonSchemaRange returns the inclusive bounds of a number or integer schema.
+// Missing bounds default to a range of 1000 next to the other bound.
+func jsonSchemaRange(m map[string]any, integer bool) (float64, float64) {
+	step := 0.01
+	if integer {
+		step = 1
+	}
+	lo, hasLo := m["minimum"].(float64)
+	if v, ok := m["exclusiveMinimum"].(float64); ok {
+		lo, hasLo = v+step, true
+	}
+	hi, hasHi := m["maximum"].(float64)
+	if v, ok := m["exclusiveMaximum"].(float64); ok {
+		hi, hasHi = v-step, true
+	}
+
+	switch {
+	case !hasLo && !hasHi:
+		lo, hi = 0, 1000
+	case !hasLo && hi >= 0:
+		lo = 0
+	case !hasLo:
+		lo = hi - 1000
+	case !hasHi:
+		hi = lo + 1000
+	}
+	if integer {
+		lo, hi = math.Ceil(lo), math.Floor(hi)
+	}
+	return lo, max(lo, hi)
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schemasample
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/protocompile/linker"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	protobufTimestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	protobufDurationName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+// NewProtobufGenerator creates a generator for the Protobuf message located at
+// indexPath inside rootFilename, see Protobuf. Records are encoded as Protobuf
+// JSON. Exactly one field of each oneof is set, and optional fields are left
+// out at random. Field paths use the field names of the schema.
+func NewProtobufGenerator(files linker.Files, rootFilename string, indexPath []int, opts GeneratorOptions) (*Generator, error) {
+	desc, err := protobufMessageDescriptor(files, rootFilename, indexPath)
+	if err != nil {
+		return nil, err
+	}
+	g, err := newGenerator(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	marshal := protojson.MarshalOptions{Resolver: files.AsResolver()}
+	g.next = func() ([]byte, error) {
+		msg := dynamicpb.NewMessage(desc)
+		if err := g.fillProtobufMessage(msg, "", 0); err != nil {
+			return nil, err
+		}
+		return marshal.Marshal(msg)
+	}
+	return g, nil
+}
+
+func (g *Generator) fillProtobufMessage(msg protoreflect.Message, path string, depth int) error {
+	desc := msg.Descriptor()
+	switch desc.FullName() {
+	case protobufTimestampName:
+		setProtobufTimestamp(msg, g.randomTime())
+		return nil
+	case protobufDurationName:
+		msg.Set(desc.Fields().ByName("seconds"), protoreflect.ValueOfInt64(g.rng.Int64N(3600)))
+		return nil
+	}
+
+	chosen := make(map[protoreflect.FullName]protoreflect.FieldNumber)
+	oneofs := desc.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		oneof := oneofs.Get(i)
+		if !oneof.IsSynthetic() {
+			chosen[oneof.FullName()] = oneof.Fields().Get(g.rng.IntN(oneof.Fields().Len())).Number()
+		}
+	}
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			if chosen[oneof.FullName()] != field.Number() {
+				continue
+			}
+		} else if field.HasPresence() && field.Cardinality() != protoreflect.Required &&
+			(depth >= maxGeneratorDepth || g.rng.IntN(4) == 0) {
+			continue
+		}
+
+		fieldPath := joinFieldPath(path, string(field.Name()))
+		switch {
+		case field.IsList():
+			list := msg.Mutable(field).List()
+			for range g.protobufCollectionSize(field, depth) {
+				value, ok, err := g.protobufValue(field, list.NewElement, fieldPath+"[]", depth)
+				if err != nil {
+					return err
+				}
+				if ok {
+					list.Append(value)
+				}
+			}
+		case field.IsMap():
+			entries := msg.Mutable(field).Map()
+			for range g.protobufCollectionSize(field.MapValue(), depth) {
+				key, _, err := g.protobufValue(field.MapKey(), nil, "", depth)
+				if err != nil {
+					return err
+				}
+				value, ok, err := g.protobufValue(field.MapValue(), entries.NewValue, fieldPath+"[]", depth)
+				if err != nil {
+					return err
+				}
+				if ok {
+					entries.Set(key.MapKey(), value)
+				}
+			}
+		default:
+			newField := func() protoreflect.Value { return msg.NewField(field) }
+			value, ok, err := g.protobufValue(field, newField, fieldPath, depth)
+			if err != nil {
+				return err
+			}
+			if ok {
+				msg.Set(field, value)
+			}
+		}
+	}
+	return nil
+}
+
+// protobufValue generates a single value of the field. newValue creates an
+// empty message for message fields. It returns false if no value should be
+// set, which is the case for messages beyond the maximum depth.
+func (g *Generator) protobufValue(
+	field protoreflect.FieldDescriptor,
+	newValue func() protoreflect.Value,
+	path string,
+	depth int,
+) (protoreflect.Value, bool, error) {
+	if override, ok := g.fields[path]; ok && path != "" {
+		return g.protobufOverride(field, newValue, override)
+	}
+
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if depth >= maxGeneratorDepth || !isGeneratableProtobufMessage(field.Message()) {
+			return protoreflect.Value{}, false, nil
+		}
+		value := newValue()
+		if err := g.fillProtobufMessage(value.Message(), path, depth+1); err != nil {
+			return protoreflect.Value{}, false, err
+		}
+		return value, true, nil
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(g.rng.IntN(values.Len())).Number()), true, nil
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(g.rng.IntN(2) == 0), true, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(g.rng.Int32N(1000)), true, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(g.rng.Int64N(1_000_000)), true, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(g.rng.Uint32N(1000)), true, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(g.rng.Uint64N(1_000_000)), true, nil
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(math.Round(g.rng.Float64()*100_000) / 100)), true, nil
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(math.Round(g.rng.Float64()*100_000) / 100), true, nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(g.words(1, 3)), true, nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(g.pick(loremWords))), true, nil
+	}
+	return protoreflect.Value{}, false, nil
+}
+
+// protobufOverride generates a value with a field generator and converts it to
+// the field's kind. Message fields only support timestamps.
+func (g *Generator) protobufOverride(
+	field protoreflect.FieldDescriptor,
+	newValue func() protoreflect.Value,
+	override FieldGenerator,
+) (protoreflect.Value, bool, error) {
+	if field.Message() != nil {
+		if field.Message().FullName() != protobufTimestampName || override.Kind != FieldGeneratorTimestamp {
+			return protoreflect.Value{}, false, fmt.Errorf("field %q of type %s only supports %s generators",
+				field.FullName(), field.Message().FullName(), FieldGeneratorTimestamp)
+		}
+		value := newValue()
+		setProtobufTimestamp(value.Message(), g.timeBetween(override.From, override.To))
+		return value, true, nil
+	}
+
+	kind := valueKind{base: jsonTypeString}
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		kind.base = jsonTypeBool
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		kind.base = jsonTypeNumber
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.EnumKind:
+	default:
+		kind.base = jsonTypeInteger
+	}
+	value, err := protobufScalar(field, g.overrideValue(override, kind))
+	if err != nil {
+		return protoreflect.Value{}, false, fmt.Errorf("invalid value for field %q: %w", field.FullName(), err)
+	}
+	return value, true, nil
+}
+
+// protobufScalar converts a generated or user-provided JSON value to a value of
+// the field's kind.
+func protobufScalar(field protoreflect.FieldDescriptor, v any) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		if s, ok := v.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+		return protoreflect.ValueOfString(fmt.Sprint(v)), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(fmt.Sprint(v))), nil
+	case protoreflect.BoolKind:
+		b, ok := v.(bool)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("expected a boolean, got %v", v)
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.EnumKind:
+		if name, ok := v.(string); ok {
+			enumValue := field.Enum().Values().ByName(protoreflect.Name(name))
+			if enumValue == nil {
+				return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", name)
+			}
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		n, err := toFloat64(v)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	}
+
+	n, err := toFloat64(v)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(n)), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(n)), nil
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(n)), nil
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(n), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %v", field.Kind())
+}
+
+func toFloat64(v any) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case int64:
+		return float64(n), nil
+	case int:
+		return float64(n), nil
+	case json.Number:
+		return n.Float64()
+	case string:
+		return strconv.ParseFloat(n, 64)
+	}
+	return 0, fmt.Errorf("expected a number, got %v", v)
+}
+
+func (g *Generator) protobufCollectionSize(field protoreflect.FieldDescriptor, depth int) int {
+	if field.Message() != nil && depth >= maxGeneratorDepth {
+		return 0
+	}
+	return 1 + g.rng.IntN(3)
+}
+
+// isGeneratableProtobufMessage returns false for well-known types whose JSON
+// encoding requires values that can't be derived from the schema.
+func isGeneratableProtobufMessage(desc protoreflect.MessageDescriptor) bool {
+	switch desc.FullName() {
+	case "google.protobuf.Any", "google.protobuf.Value", "google.protobuf.Struct", "google.protobuf.ListValue":
+		return false
+	}
+	return true
+}
+
+func setProtobufTimestamp(msg protoreflect.Message, t time.Time) {
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(t.Unix()))
+	msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(int32(t.Nanosecond())))
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schemasample_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/avro"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/redpanda-data/console/backend/pkg/schemasample"
+)
+
+// generate returns n records of the generator.
+func generate(t *testing.T, g *schemasample.Generator, n int) [][]byte {
+	t.Helper()
+	records := make([][]byte, n)
+	for i := range records {
+		record, err := g.Next()
+		require.NoError(t, err)
+		records[i] = record
+	}
+	return records
+}
+
+var generatorNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const generatorAvroSchema = `{
+	"type": "record", "name": "Order", "namespace": "shop",
+	"fields": [
+		{"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+		{"name": "customer", "type": "string"},
+		{"name": "email", "type": ["null", "string"]},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "PAID", "SHIPPED"]}},
+		{"name": "createdAt", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "total", "type": {"type": "bytes", "logicalType": "decimal", "precision": 9, "scale": 2}},
+		{"name": "checksum", "type": {"type": "fixed", "name": "Checksum", "size": 4}},
+		{"name": "lines", "type": {"type": "array", "items": {
+			"type": "record", "name": "Line",
+			"fields": [{"name": "sku", "type": "string"}, {"name": "quantity", "type": "int"}]
+		}}},
+		{"name": "attributes", "type": {"type": "map", "values": "double"}},
+		{"name": "previous", "type": ["null", "Order"]},
+		{"name": "payment", "type": [
+			{"type": "record", "name": "Card", "fields": [{"name": "last4", "type": "string"}]},
+			{"type": "record", "name": "Invoice", "fields": [{"name": "dueDays", "type": "int"}]}
+		]}
+	]
+}`
+
+func TestAvroGenerator(t *testing.T) {
+	schema, err := avro.Parse(generatorAvroSchema)
+	require.NoError(t, err)
+
+	opts := schemasample.GeneratorOptions{
+		Seed: 42,
+		Now:  generatorNow,
+		Fields: map[string]schemasample.FieldGenerator{
+			"customer":         {Kind: schemasample.FieldGeneratorFullName},
+			"email":            {Kind: schemasample.FieldGeneratorEmail},
+			"lines[].sku":      {Kind: schemasample.FieldGeneratorOneOf, Values: []any{"sku-1", "sku-2"}},
+			"lines[].quantity": {Kind: schemasample.FieldGeneratorNumber, Min: 1, Max: 5},
+		},
+	}
+	g, err := schemasample.NewAvroGenerator(generatorAvroSchema, opts)
+	require.NoError(t, err)
+	records := generate(t, g, 50)
+
+	for _, record := range records {
+		var native any
+		require.NoError(t, schema.DecodeJSON(record, &native), string(record))
+		_, err := schema.Encode(native)
+		require.NoError(t, err, string(record))
+
+		var decoded struct {
+			Customer string
+			Email    map[string]string
+			Lines    []struct {
+				SKU      string
+				Quantity int
+			}
+		}
+		require.NoError(t, json.Unmarshal(record, &decoded))
+		assert.Contains(t, decoded.Customer, " ")
+		assert.Contains(t, decoded.Email["string"], "@")
+		for _, line := range decoded.Lines {
+			assert.Contains(t, []string{"sku-1", "sku-2"}, line.SKU)
+			assert.True(t, line.Quantity >= 1 && line.Quantity <= 5)
+		}
+	}
+
+	// The same seed generates the same records, another seed different ones
+	g, err = schemasample.NewAvroGenerator(generatorAvroSchema, opts)
+	require.NoError(t, err)
+	assert.Equal(t, records, generate(t, g, 50))
+
+	opts.Seed = 7
+	g, err = schemasample.NewAvroGenerator(generatorAvroSchema, opts)
+	require.NoError(t, err)
+	assert.NotEqual(t, records, generate(t, g, 50))
+}
+
+func TestJSONSchemaGenerator(t *testing.T) {
+	schemaText := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id", "email", "createdAt", "tags", "score", "items"],
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"email": {"type": "string", "format": "email"},
+			"createdAt": {"type": "string", "format": "date-time"},
+			"birthday": {"type": "string", "format": "date"},
+			"nickname": {"type": ["string", "null"], "minLength": 3, "maxLength": 8},
+			"score": {"type": "integer", "minimum": 10, "exclusiveMaximum": 20},
+			"ratio": {"type": "number", "minimum": 0, "maximum": 1},
+			"tags": {"type": "array", "items": {"enum": ["a", "b", "c"]}, "minItems": 2, "maxItems": 4},
+			"items": {"type": "array", "items": {"$ref": "#/$defs/Item"}},
+			"contact": {"oneOf": [{"$ref": "#/$defs/Phone"}, {"type": "string", "format": "email"}]},
+			"child": {"$ref": "#"}
+		},
+		"$defs": {
+			"Item": {"type": "object", "required": ["price"], "properties": {"price": {"type": "number", "minimum": 0}}},
+			"Phone": {"type": "object", "required": ["number"], "properties": {"number": {"type": "string"}}}
+		}
+	}`
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat = true
+	require.NoError(t, compiler.AddResource("schema.json", strings.NewReader(schemaText)))
+	schema, err := compiler.Compile("schema.json")
+	require.NoError(t, err)
+
+	g, err := schemasample.NewJSONSchemaGenerator(schemaText, schemasample.GeneratorOptions{
+		Seed: 1,
+		Now:  generatorNow,
+		Fields: map[string]schemasample.FieldGenerator{
+			"createdAt":     {Kind: schemasample.FieldGeneratorTimestamp, From: generatorNow.Add(-time.Hour), To: generatorNow},
+			"items[].price": {Kind: schemasample.FieldGeneratorNumber, Min: 5, Max: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, record := range generate(t, g, 50) {
+		var decoded any
+		require.NoError(t, json.Unmarshal(record, &decoded))
+		require.NoError(t, schema.Validate(decoded), string(record))
+
+		createdAt, err := time.Parse(time.RFC3339, decoded.(map[string]any)["createdAt"].(string))
+		require.NoError(t, err)
+		assert.False(t, createdAt.Before(generatorNow.Add(-time.Hour)))
+		for _, item := range decoded.(map[string]any)["items"].([]any) {
+			price := item.(map[string]any)["price"].(float64)
+			assert.True(t, price >= 5 && price <= 10)
+		}
+	}
+}
+
+func TestProtobufGenerator(t *testing.T) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"order.proto": `
+syntax = "proto3";
+package shop;
+
+import "google/protobuf/timestamp.proto";
+
+message Order {
+  string id = 1;
+  Status status = 2;
+  google.protobuf.Timestamp created_at = 3;
+  repeated Line lines = 4;
+  map<string, double> attributes = 5;
+  optional string note = 6;
+  oneof payment {
+    string card = 7;
+    int64 invoice = 8;
+  }
+  Order previous = 9;
+  bytes checksum = 10;
+}
+
+message Line {
+  string sku = 1;
+  uint32 quantity = 2;
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OPEN = 1;
+}
+`}),
+		}),
+	}
+	files, err := compiler.Compile(t.Context(), "order.proto")
+	require.NoError(t, err)
+
+	from := generatorNow.Add(-24 * time.Hour)
+	g, err := schemasample.NewProtobufGenerator(files, "order.proto", nil, schemasample.GeneratorOptions{
+		Seed: 3,
+		Now:  generatorNow,
+		Fields: map[string]schemasample.FieldGenerator{
+			"id":               {Kind: schemasample.FieldGeneratorUUID},
+			"status":           {Kind: schemasample.FieldGeneratorOneOf, Values: []any{"STATUS_OPEN"}},
+			"created_at":       {Kind: schemasample.FieldGeneratorTimestamp, From: from, To: generatorNow},
+			"lines[].quantity": {Kind: schemasample.FieldGeneratorNumber, Min: 1, Max: 3},
+		},
+	})
+	require.NoError(t, err)
+
+	desc := files[0].Messages().ByName("Order")
+	for _, record := range generate(t, g, 50) {
+		msg := dynamicpb.NewMessage(desc)
+		require.NoError(t, protojson.Unmarshal(record, msg), string(record))
+
+		var decoded struct {
+			ID        string    `json:"id"`
+			Status    string    `json:"status"`
+			CreatedAt time.Time `json:"createdAt"`
+			Lines     []struct {
+				Quantity int `json:"quantity"`
+			} `json:"lines"`
+		}
+		require.NoError(t, json.NewDecoder(bytes.NewReader(record)).Decode(&decoded))
+		assert.Len(t, decoded.ID, 36)
+		assert.Equal(t, "STATUS_OPEN", decoded.Status)
+		assert.True(t, decoded.CreatedAt.IsZero() || !decoded.CreatedAt.Before(from))
+		for _, line := range decoded.Lines {
+			assert.True(t, line.Quantity >= 1 && line.Quantity <= 3)
+		}
+	}
+}
+
+func TestGeneratorRejectsInvalidFieldGenerators(t *testing.T) {
+	for _, field := range []schemasample.FieldGenerator{
+		{Kind: "PHONE"},
+		{Kind: schemasample.FieldGeneratorNumber, Min: 2, Max: 1},
+		{Kind: schemasample.FieldGeneratorOneOf},
+	} {
+		_, err := schemasample.NewAvroGenerator(`"string"`, schemasample.GeneratorOptions{
+			Fields: map[string]schemasample.FieldGenerator{"name": field},
+		})
+		assert.Error(t, err, field.Kind)
+	}
+}
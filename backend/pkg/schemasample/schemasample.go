@@ -83,6 +83,24 @@ func JSONSchema(schemaText string) ([]byte, error) {
 // located at indexPath inside rootFilename's nested message tree. An empty
 // indexPath selects the first top-level message.
 func Protobuf(files linker.Files, rootFilename string, indexPath []int) ([]byte, error) {
+	desc, err := protobufMessageDescriptor(files, rootFilename, indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := dynamicpb.NewMessage(desc)
+	return protojson.MarshalOptions{
+		EmitDefaultValues: true,
+		Multiline:         true,
+		Indent:            "  ",
+		Resolver:          files.AsResolver(),
+	}.Marshal(msg)
+}
+
+// protobufMessageDescriptor returns the descriptor of the message located at
+// indexPath inside rootFilename. An empty indexPath selects the first
+// top-level message.
+func protobufMessageDescriptor(files linker.Files, rootFilename string, indexPath []int) (protoreflect.MessageDescriptor, error) {
 	if files == nil {
 		return nil, errors.New("nil proto files")
 	}
@@ -95,18 +113,7 @@ func Protobuf(files linker.Files, rootFilename string, indexPath []int) ([]byte,
 	if len(path) == 0 {
 		path = []int{0}
 	}
-	desc, err := DescriptorByIndexPath(rootFile.Messages(), path)
-	if err != nil {
-		return nil, err
-	}
-
-	msg := dynamicpb.NewMessage(desc)
-	return protojson.MarshalOptions{
-		EmitDefaultValues: true,
-		Multiline:         true,
-		Indent:            "  ",
-		Resolver:          files.AsResolver(),
-	}.Marshal(msg)
+	return DescriptorByIndexPath(rootFile.Messages(), path)
 }
 
 // DescriptorByIndexPath walks msgs by successive nested-message indices,
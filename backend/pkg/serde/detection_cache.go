@@ -0,0 +1,158 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ prometheus.Collector = (*DetectionCache)(nil)
+
+// DetectionCache remembers which serde has deserialized the keys and values of a
+// topic, so that it can be tried first for subsequent records instead of trying
+// every serde in order. Payloads that use the schema registry wire format are
+// remembered per schema ID.
+//
+// Only serdes that identify a payload reliably are remembered. Catch-all serdes
+// such as text or binary would otherwise take precedence over more specific
+// serdes for later records.
+type DetectionCache struct {
+	maxEntries int
+
+	mu      sync.RWMutex
+	entries map[detectionCacheKey]PayloadEncoding
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+
+	hitsDesc    *prometheus.Desc
+	missesDesc  *prometheus.Desc
+	entriesDesc *prometheus.Desc
+}
+
+type detectionCacheKey struct {
+	topic       string
+	payloadType PayloadType
+	// schemaID is -1 if the payload does not use the schema registry wire format.
+	schemaID int64
+}
+
+// DetectionCacheStats are the counters of a DetectionCache.
+type DetectionCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// NewDetectionCache creates a cache that remembers up to maxEntries topic, payload
+// type and schema ID combinations. Metrics are exported in the given namespace.
+func NewDetectionCache(maxEntries int, metricsNamespace string) *DetectionCache {
+	return &DetectionCache{
+		maxEntries: maxEntries,
+		entries:    make(map[detectionCacheKey]PayloadEncoding),
+		hitsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "serde_detection_cache", "hits_total"),
+			"Total number of payloads that have been deserialized by the remembered serde",
+			nil, nil),
+		missesDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "serde_detection_cache", "misses_total"),
+			"Total number of payloads that required trying all serdes",
+			nil, nil),
+		entriesDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "serde_detection_cache", "entries"),
+			"Number of remembered topic, payload type and schema ID combinations",
+			nil, nil),
+	}
+}
+
+// Stats returns the current hit and miss counters.
+func (c *DetectionCache) Stats() DetectionCacheStats {
+	c.mu.RLock()
+	entries := len(c.entries)
+	c.mu.RUnlock()
+
+	return DetectionCacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: entries,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *DetectionCache) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hitsDesc
+	ch <- c.missesDesc
+	ch <- c.entriesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *DetectionCache) Collect(ch chan<- prometheus.Metric) {
+	stats := c.Stats()
+	ch <- prometheus.MustNewConstMetric(c.hitsDesc, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.missesDesc, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.entriesDesc, prometheus.GaugeValue, float64(stats.Entries))
+}
+
+func newDetectionCacheKey(topic string, payloadType PayloadType, payload []byte) detectionCacheKey {
+	schemaID := int64(-1)
+	if len(payload) >= 5 && payload[0] == 0 {
+		schemaID = int64(binary.BigEndian.Uint32(payload[1:5]))
+	}
+	return detectionCacheKey{topic: topic, payloadType: payloadType, schemaID: schemaID}
+}
+
+func (c *DetectionCache) get(key detectionCacheKey) (PayloadEncoding, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	encoding, exists := c.entries[key]
+	return encoding, exists
+}
+
+func (c *DetectionCache) set(key detectionCacheKey, encoding PayloadEncoding) {
+	if !isDetectionCacheable(encoding) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, exists := c.entries[key]
+	if exists && current == encoding {
+		return
+	}
+	if !exists && len(c.entries) >= c.maxEntries {
+		// Evict an arbitrary entry, it will be detected again if it is still in use
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = encoding
+}
+
+// isDetectionCacheable returns false for serdes that succeed for almost any payload
+// or depend on the individual record, like the null serde.
+func isDetectionCacheable(encoding PayloadEncoding) bool {
+	switch encoding {
+	case PayloadEncodingNull,
+		PayloadEncodingText,
+		PayloadEncodingUtf8WithControlChars,
+		PayloadEncodingUint,
+		PayloadEncodingBinary:
+		return false
+	default:
+		return true
+	}
+}
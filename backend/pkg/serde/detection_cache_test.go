@@ -0,0 +1,100 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// countingSerde counts the deserialization attempts of the wrapped serde.
+type countingSerde struct {
+	Serde
+	attempts int
+}
+
+func (c *countingSerde) DeserializePayload(ctx context.Context, record *kgo.Record, payloadType PayloadType) (*RecordPayload, error) {
+	c.attempts++
+	return c.Serde.DeserializePayload(ctx, record, payloadType)
+}
+
+func newTestDetectionCacheService(maxEntries int) (*Service, map[PayloadEncoding]*countingSerde) {
+	serdes := []Serde{NullSerde{}, JSONSerde{}, UTF8Serde{}, TextSerde{}, BinarySerde{}}
+	counting := make(map[PayloadEncoding]*countingSerde, len(serdes))
+	svc := &Service{DetectionCache: NewDetectionCache(maxEntries, "console")}
+	for _, serde := range serdes {
+		c := &countingSerde{Serde: serde}
+		counting[serde.Name()] = c
+		svc.SerDes = append(svc.SerDes, c)
+	}
+	return svc, counting
+}
+
+func TestDetectionCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("remembered serde is tried first", func(t *testing.T) {
+		svc, counting := newTestDetectionCacheService(10)
+		for range 3 {
+			rec := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders", Value: []byte(`{"id":1}`)}, DeserializationOptions{})
+			assert.Equal(t, PayloadEncodingJSON, rec.Value.Encoding)
+		}
+
+		// The key is empty and thus always deserialized by the null serde, which is not remembered
+		assert.Equal(t, 4, counting[PayloadEncodingNull].attempts)
+		assert.Equal(t, 3, counting[PayloadEncodingJSON].attempts)
+		stats := svc.DetectionCache.Stats()
+		assert.Equal(t, uint64(2), stats.Hits)
+		assert.Equal(t, uint64(4), stats.Misses)
+		assert.Equal(t, 1, stats.Entries)
+	})
+
+	t.Run("falls back to all serdes on failure", func(t *testing.T) {
+		svc, _ := newTestDetectionCacheService(10)
+		svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders", Value: []byte(`{"id":1}`)}, DeserializationOptions{})
+
+		tombstone := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders"}, DeserializationOptions{})
+		assert.Equal(t, PayloadEncodingNull, tombstone.Value.Encoding)
+		text := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders", Value: []byte("hello")}, DeserializationOptions{})
+		assert.Equal(t, PayloadEncodingText, text.Value.Encoding)
+
+		// Catch-all serdes do not replace the remembered serde
+		encoding, exists := svc.DetectionCache.get(newDetectionCacheKey("orders", PayloadTypeValue, []byte(`{}`)))
+		require.True(t, exists)
+		assert.Equal(t, PayloadEncodingJSON, encoding)
+	})
+
+	t.Run("troubleshooting tries all serdes", func(t *testing.T) {
+		svc, counting := newTestDetectionCacheService(10)
+		for range 2 {
+			rec := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders", Value: []byte(`{"id":1}`)}, DeserializationOptions{Troubleshoot: true})
+			assert.Len(t, rec.Value.Troubleshooting, 1)
+		}
+		assert.Equal(t, 4, counting[PayloadEncodingNull].attempts)
+		assert.Equal(t, DetectionCacheStats{}, svc.DetectionCache.Stats())
+	})
+
+	t.Run("entries are bounded", func(t *testing.T) {
+		svc, _ := newTestDetectionCacheService(1)
+		svc.DeserializeRecord(ctx, &kgo.Record{Topic: "orders", Value: []byte(`{"id":1}`)}, DeserializationOptions{})
+		svc.DeserializeRecord(ctx, &kgo.Record{Topic: "payments", Value: []byte(`{"id":1}`)}, DeserializationOptions{})
+		assert.Equal(t, 1, svc.DetectionCache.Stats().Entries)
+	})
+}
+
+func TestNewDetectionCacheKey(t *testing.T) {
+	assert.Equal(t, int64(-1), newDetectionCacheKey("orders", PayloadTypeValue, []byte(`{"id":1}`)).schemaID)
+	assert.Equal(t, int64(-1), newDetectionCacheKey("orders", PayloadTypeValue, []byte{0, 0, 1}).schemaID)
+	assert.Equal(t, int64(258), newDetectionCacheKey("orders", PayloadTypeValue, []byte{0, 0, 0, 1, 2, 10}).schemaID)
+}
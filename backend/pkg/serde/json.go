@@ -16,13 +16,23 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/schema"
 )
 
 var _ Serde = (*JSONSerde)(nil)
 
 // JSONSerde represents the serde for dealing with JSON types.
-type JSONSerde struct{}
+type JSONSerde struct {
+	// Validation configures whether payloads are validated against the latest
+	// JSON schema of the topic's subject. Validation requires a schema client.
+	Validation   config.SerdeJSONValidation
+	schemaClient schema.Client
+}
 
 // Name returns the name of the serde payload encoding.
 func (JSONSerde) Name() PayloadEncoding {
@@ -30,7 +40,7 @@ func (JSONSerde) Name() PayloadEncoding {
 }
 
 // DeserializePayload deserializes the kafka record to our internal record payload representation.
-func (JSONSerde) DeserializePayload(_ context.Context, record *kgo.Record, payloadType PayloadType) (*RecordPayload, error) {
+func (d JSONSerde) DeserializePayload(ctx context.Context, record *kgo.Record, payloadType PayloadType) (*RecordPayload, error) {
 	payload := payloadFromRecord(record, payloadType)
 
 	obj, err := jsonDeserializePayload(payload)
@@ -38,11 +48,16 @@ func (JSONSerde) DeserializePayload(_ context.Context, record *kgo.Record, paylo
 		return &RecordPayload{}, err
 	}
 
-	return &RecordPayload{
+	rp := &RecordPayload{
 		NormalizedPayload:   payload,
 		DeserializedPayload: obj,
 		Encoding:            PayloadEncodingJSON,
-	}, nil
+	}
+	if d.isValidationEnabled(record.Topic) {
+		rp.SchemaValidation = d.validate(ctx, topicSubject(record.Topic, payloadType), obj)
+	}
+
+	return rp, nil
 }
 
 // SerializeObject serializes data into binary format ready for writing to Kafka as a record.
@@ -102,3 +117,89 @@ func jsonDeserializePayload(payload []byte) (any, error) {
 
 	return obj, nil
 }
+
+func (d JSONSerde) isValidationEnabled(topic string) bool {
+	if !d.Validation.Enabled || d.schemaClient == nil {
+		return false
+	}
+
+	if d.Validation.TopicName.Regexp != nil {
+		return d.Validation.TopicName.MatchString(topic)
+	}
+
+	name := d.Validation.TopicName.String()
+	return name == "" || name == topic
+}
+
+// validate validates the deserialized payload against the latest schema of the
+// given subject. It returns nil if the subject has no JSON schema or if the
+// schema could not be loaded, so that such payloads are not reported as invalid.
+func (d JSONSerde) validate(ctx context.Context, subject string, obj any) *SchemaValidation {
+	// Version -1 refers to the latest version of the subject
+	subjectSchema, err := d.schemaClient.SchemaByVersion(ctx, subject, -1)
+	if err != nil || subjectSchema.Type != sr.TypeJSON {
+		return nil
+	}
+
+	jsonSchema, err := d.schemaClient.JSONSchemaByID(ctx, subjectSchema.ID)
+	if err != nil {
+		return nil
+	}
+
+	validation := &SchemaValidation{
+		Subject:  subject,
+		Version:  subjectSchema.Version,
+		SchemaID: subjectSchema.ID,
+		Valid:    true,
+	}
+	if err := jsonSchema.Validate(obj); err != nil {
+		validation.Valid = false
+		validation.Errors = jsonSchemaValidationErrors(err)
+	}
+
+	return validation
+}
+
+// maxSchemaValidationErrors limits the number of reported validation errors per
+// payload, so that a payload with many invalid values doesn't flood the response.
+const maxSchemaValidationErrors = 10
+
+// jsonSchemaValidationErrors flattens a validation error into the messages of
+// its leaf causes, which point to the actual invalid values.
+func jsonSchemaValidationErrors(err error) []string {
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []string{err.Error()}
+	}
+
+	var messages []string
+	var collect func(e *jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(messages) >= maxSchemaValidationErrors {
+			return
+		}
+		if len(e.Causes) == 0 {
+			location := e.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			messages = append(messages, location+": "+e.Message)
+			return
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+
+	return messages
+}
+
+// topicSubject returns the subject of the topic's key or value schema as named
+// by the default topic name strategy.
+func topicSubject(topic string, payloadType PayloadType) string {
+	if payloadType == PayloadTypeKey {
+		return topic + "-key"
+	}
+	return topic + "-value"
+}
@@ -10,11 +10,18 @@
 package serde
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/schema"
 )
 
 func TestJsonSerde_DeserializePayload(t *testing.T) {
@@ -90,6 +97,83 @@ func TestJsonSerde_DeserializePayload(t *testing.T) {
 	}
 }
 
+// topicSchemaClient serves the latest subject versions from a map. All other
+// methods of the interface are not implemented.
+type topicSchemaClient struct {
+	schema.Client
+	subjects map[string]string
+}
+
+func (c topicSchemaClient) SchemaByVersion(_ context.Context, subject string, _ int) (sr.SubjectSchema, error) {
+	text, ok := c.subjects[subject]
+	if !ok {
+		return sr.SubjectSchema{}, errors.New("subject not found")
+	}
+	return sr.SubjectSchema{
+		Subject: subject,
+		Version: 2,
+		ID:      len(subject),
+		Schema:  sr.Schema{Schema: text, Type: sr.TypeJSON},
+	}, nil
+}
+
+func (c topicSchemaClient) JSONSchemaByID(_ context.Context, id int) (*jsonschema.Schema, error) {
+	for subject, text := range c.subjects {
+		if len(subject) == id {
+			return jsonschema.CompileString(subject, text)
+		}
+	}
+	return nil, errors.New("schema not found")
+}
+
+func TestJsonSerde_DeserializePayloadWithValidation(t *testing.T) {
+	client := topicSchemaClient{subjects: map[string]string{
+		"orders-value": `{
+			"type": "object",
+			"required": ["id"],
+			"properties": {"id": {"type": "string"}, "amount": {"type": "number", "minimum": 0}}
+		}`,
+	}}
+	serde := JSONSerde{Validation: config.SerdeJSONValidation{Enabled: true}, schemaClient: client}
+
+	t.Run("valid payload", func(t *testing.T) {
+		record := &kgo.Record{Topic: "orders", Value: []byte(`{"id": "a", "amount": 3}`)}
+		payload, err := serde.DeserializePayload(t.Context(), record, PayloadTypeValue)
+		require.NoError(t, err)
+		require.NotNil(t, payload.SchemaValidation)
+		assert.Equal(t, SchemaValidation{Subject: "orders-value", Version: 2, SchemaID: 12, Valid: true}, *payload.SchemaValidation)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		record := &kgo.Record{Topic: "orders", Value: []byte(`{"amount": -1}`)}
+		payload, err := serde.DeserializePayload(t.Context(), record, PayloadTypeValue)
+		require.NoError(t, err)
+		require.NotNil(t, payload.SchemaValidation)
+		assert.False(t, payload.SchemaValidation.Valid)
+		require.Len(t, payload.SchemaValidation.Errors, 2)
+		assert.Contains(t, payload.SchemaValidation.Errors, "/amount: must be >= 0 but found -1")
+		assert.Contains(t, payload.SchemaValidation.Errors, "/: missing properties: 'id'")
+	})
+
+	t.Run("subject without schema", func(t *testing.T) {
+		record := &kgo.Record{Topic: "orders", Key: []byte(`{"id": 1}`)}
+		payload, err := serde.DeserializePayload(t.Context(), record, PayloadTypeKey)
+		require.NoError(t, err)
+		assert.Nil(t, payload.SchemaValidation)
+	})
+
+	t.Run("topic not selected", func(t *testing.T) {
+		var topicName config.RegexpOrLiteral
+		require.NoError(t, topicName.UnmarshalText([]byte("payments")))
+		serde := JSONSerde{Validation: config.SerdeJSONValidation{Enabled: true, TopicName: topicName}, schemaClient: client}
+
+		record := &kgo.Record{Topic: "orders", Value: []byte(`{"amount": -1}`)}
+		payload, err := serde.DeserializePayload(t.Context(), record, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Nil(t, payload.SchemaValidation)
+	})
+}
+
 func TestJsonSerde_SerializeObject(t *testing.T) {
 	serde := JSONSerde{}
 
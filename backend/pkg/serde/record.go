@@ -64,6 +64,11 @@ type RecordPayload struct {
 	// sent to the requester if it has been requested.
 	Troubleshooting []TroubleshootingReport `json:"troubleshooting,omitempty"`
 
+	// SchemaValidation is the result of validating the payload against the
+	// latest schema of the topic's subject. It is only set by serdes that
+	// validate payloads without a schema ID, if that has been configured.
+	SchemaValidation *SchemaValidation `json:"schemaValidation,omitempty"`
+
 	// ExtraMetadata are key/value pairs that can be added by the Serde.
 	// They will always be shown in the frontend when provided, therefore
 	// we should not return too much extra information to avoid information
@@ -71,6 +76,19 @@ type RecordPayload struct {
 	ExtraMetadata map[string]string `json:"extraMetadata,omitempty"`
 }
 
+// SchemaValidation reports whether a payload is valid according to the latest
+// schema version of a subject.
+type SchemaValidation struct {
+	Subject  string `json:"subject"`
+	Version  int    `json:"version"`
+	SchemaID int    `json:"schemaId"`
+	Valid    bool   `json:"valid"`
+
+	// Errors describe why the payload is invalid. Each error is prefixed
+	// with the JSON pointer to the invalid value.
+	Errors []string `json:"errors,omitempty"`
+}
+
 // RecordHeader defines the schema for a single header that can be attached
 // to a Kafka record. Each Kafka record may have none or many record headers.
 type RecordHeader struct {
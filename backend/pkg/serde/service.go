@@ -33,6 +33,10 @@ type BSRClient interface {
 // a record.
 type Service struct {
 	SerDes []Serde
+
+	// DetectionCache remembers the serde that succeeded per topic. It is nil if
+	// every serde shall be tried for each payload.
+	DetectionCache *DetectionCache
 }
 
 // NewService creates the new serde service.
@@ -80,6 +84,16 @@ func NewService(
 	}, nil
 }
 
+// EnableJSONValidation validates plain JSON payloads against the latest JSON
+// schema of the topic's subject, see config.SerdeJSONValidation.
+func (s *Service) EnableJSONValidation(cachedSchemaClient schema.Client, cfg config.SerdeJSONValidation) {
+	for i, serde := range s.SerDes {
+		if _, ok := serde.(JSONSerde); ok {
+			s.SerDes[i] = JSONSerde{Validation: cfg, schemaClient: cachedSchemaClient}
+		}
+	}
+}
+
 // DeserializeRecord tries to deserialize a Kafka record into a struct that
 // can be processed by the Frontend.
 func (s *Service) DeserializeRecord(ctx context.Context, record *kgo.Record, opts DeserializationOptions) *Record {
@@ -113,8 +127,6 @@ func (s *Service) DeserializeRecord(ctx context.Context, record *kgo.Record, opt
 func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, payloadType PayloadType, opts *DeserializationOptions) *RecordPayload {
 	payload := payloadFromRecord(record, payloadType)
 
-	troubleshooting := make([]TroubleshootingReport, 0, len(s.SerDes))
-
 	serdeEncoding := opts.KeyEncoding
 	if payloadType == PayloadTypeValue {
 		serdeEncoding = opts.ValueEncoding
@@ -123,8 +135,27 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 	// When deserializing, clients can optionally specify the desired encoding
 	doSpecificEncoding := serdeEncoding != PayloadEncodingUnspecified && serdeEncoding != ""
 
+	// Try the serde that deserialized previous payloads of this topic first. The
+	// cache is skipped if troubleshooting is requested, because the report is
+	// expected to cover all serdes that have been tried before the matching one.
+	useDetectionCache := s.DetectionCache != nil && !doSpecificEncoding && !opts.Troubleshoot
+	var cacheKey detectionCacheKey
+	if useDetectionCache {
+		cacheKey = newDetectionCacheKey(record.Topic, payloadType, payload)
+		if encoding, exists := s.DetectionCache.get(cacheKey); exists {
+			if serde := s.serdeByName(encoding); serde != nil {
+				if rp, err := serde.DeserializePayload(ctx, record, payloadType); err == nil {
+					s.DetectionCache.hits.Add(1)
+					return finalizeRecordPayload(rp, payload, opts, nil, false)
+				}
+			}
+		}
+		s.DetectionCache.misses.Add(1)
+	}
+
 	// Try all registered SerDes in the order they were registered
 	var rp *RecordPayload
+	var troubleshooting []TroubleshootingReport
 	for _, serde := range s.SerDes {
 		if doSpecificEncoding {
 			if serdeEncoding != serde.Name() {
@@ -136,6 +167,9 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 		rp, err = serde.DeserializePayload(ctx, record, payloadType)
 		if err == nil {
 			// found the matching serde
+			if useDetectionCache {
+				s.DetectionCache.set(cacheKey, serde.Name())
+			}
 			break
 		}
 
@@ -150,6 +184,12 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 		})
 	}
 
+	specificEncodingFailed := doSpecificEncoding && len(troubleshooting) > 0
+	return finalizeRecordPayload(rp, payload, opts, troubleshooting, specificEncodingFailed)
+}
+
+// finalizeRecordPayload sets the payload metadata on the deserialized payload.
+func finalizeRecordPayload(rp *RecordPayload, payload []byte, opts *DeserializationOptions, troubleshooting []TroubleshootingReport, specificEncodingFailed bool) *RecordPayload {
 	rp.PayloadSizeBytes = len(payload)
 	rp.IsPayloadNull = payload == nil
 
@@ -162,7 +202,6 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 		rp.NormalizedPayload = nil
 	}
 
-	specificEncodingFailed := doSpecificEncoding && len(troubleshooting) > 0
 	if opts.Troubleshoot || rp.Encoding == PayloadEncodingBinary || specificEncodingFailed {
 		rp.Troubleshooting = troubleshooting
 	}
@@ -170,6 +209,16 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 	return rp
 }
 
+// serdeByName returns the registered serde with the given encoding or nil.
+func (s *Service) serdeByName(encoding PayloadEncoding) Serde {
+	for _, serde := range s.SerDes {
+		if serde.Name() == encoding {
+			return serde
+		}
+	}
+	return nil
+}
+
 // DeserializationOptions that can be provided by the requester to influence
 // the deserialization.
 type DeserializationOptions struct {